@@ -7,7 +7,8 @@ Server {
 	Port = 2289
 
 	# Enables the CORS in REST, useful for browser support
-	# you should probably disable this if using a proxy
+	# you should probably disable this if using a proxy. Without it, event
+	# streams over WebSockets can only be opened from the same origin.
 	UseCORS = true
 
 	# The location of the private and public keys of the server; used to establish
@@ -21,6 +22,17 @@ Server {
 	# - DatabaseFlatfile: This backend stores files in a flatfile directory layout, but stores metadata in the database.
 	StorageBackend = "PureFlatfile"
 
+	# How Legato should deliver events to clients streaming them. There are two backends:
+	#
+	# - Integrated: Events are kept in memory and only reach clients connected to this instance.
+	# - Postgres: Events are relayed through the database with LISTEN/NOTIFY, so multiple instances sharing a database reach each other's clients.
+	#
+	# The events kept for clients resuming their streams are kept by each instance, so with
+	# Postgres a client reconnecting to another instance is told to resync instead. Clients
+	# of an instance that lost its connection to the database are told to resync too, since
+	# the events sent in the meantime never reached it.
+	PubSubBackend = "Integrated"
+
 	# The starting point for IDs of messages, channels, guilds, etc.
 	SnowflakeStart = 0
 
@@ -76,6 +88,155 @@ Server {
 			Duration = 172800000000000
 		}
 
+		# Presence-related policies
+		Presence {
+			# How long a user stays online after their last event stream closes
+			# in nanoseconds, so that reconnecting doesn't flap their status.
+			# The default is 1 minute.
+			IdleTimeout = 60000000000
+
+			# How long an instance can go without checking in before the event
+			# streams it counted are forgotten, taking their users offline if they
+			# aren't connected elsewhere. Instances check in three times as often.
+			# The default is 90 seconds.
+			InstanceTimeout = 90000000000
+		}
+
+		# Heartbeats sent on event streams to find clients that went away
+		# without closing their stream
+		Heartbeat {
+			# How often heartbeats are sent in nanoseconds. The default is 30 seconds.
+			Interval = 30000000000
+
+			# How many heartbeats can go unacknowledged before the stream is closed.
+			# Clients streaming with Server-Sent Events can't send anything on their
+			# stream, so they acknowledge heartbeats by posting the ID their stream
+			# starts with to /_harmony/events/ack instead. Those requests have to reach
+			# the instance serving the stream.
+			MaxMissed = 3
+		}
+
+		# Thread-related policies
+		Threads {
+			# How long a thread can go without new messages before it's archived
+			# in nanoseconds. The default is 24 hours.
+			ArchiveAfter = 86400000000000
+
+			# How often threads are checked for archival in nanoseconds.
+			# The default is 1 minute.
+			CheckInterval = 60000000000
+		}
+
+		# Policies for messages scheduled to be sent later
+		ScheduledMessages {
+			# How many messages a user can have scheduled in a single guild
+			MaximumPending = 50
+
+			# How often due messages are looked for in nanoseconds.
+			# The default is 10 seconds.
+			CheckInterval = 10000000000
+
+			# How many due messages are claimed from the database at once
+			BatchSize = 100
+
+			# How long an instance has to send a due message before another
+			# instance can take it over, in nanoseconds. The default is 5 minutes.
+			LeaseDuration = 300000000000
+
+			# How long to wait before retrying a message that couldn't be sent
+			# because of a temporary error in nanoseconds. Errors that say how long
+			# to wait are waited on instead. The default is 1 minute.
+			RetryDelay = 60000000000
+
+			# How many times sending a message is attempted before it's dropped
+			MaximumAttempts = 5
+		}
+
+		# Limitations on the edit history kept for messages. Revisions past
+		# either limit are dropped; 0 keeps them forever.
+		MessageHistory {
+			# How many previous versions are kept for a single message
+			MaximumRevisions = 50
+
+			# How long previous versions are kept in nanoseconds
+			MaximumAge = 0
+		}
+
+		# Policies for deleted messages
+		DeletedMessages {
+			# How long deleted messages are kept before they're purged from the
+			# database in nanoseconds. The default is 30 days.
+			GracePeriod = 2592000000000000
+		}
+
+		# Policies for guild message retention
+		Retention {
+			# How often messages past the retention period of their guild, and
+			# deleted messages past their grace period, are looked for in
+			# nanoseconds. The default is 1 minute.
+			CheckInterval = 60000000000
+
+			# How many of those messages are removed from the database at once
+			BatchSize = 500
+		}
+
+		# Automatic moderation policies
+		Automod {
+			# How many automod rules a single guild can have
+			MaximumRules = 50
+
+			# The longest timeout a rule can give in nanoseconds.
+			# The default is 28 days.
+			MaximumTimeout = 2419200000000000
+		}
+
+		# Slow mode-related policies
+		SlowMode {
+			# The longest interval a channel can require between messages of a
+			# user in nanoseconds. The default is 6 hours.
+			MaximumInterval = 21600000000000
+		}
+
+		# Previews generated for links in messages
+		LinkPreviews {
+			# Whether or not links in messages get previews
+			Enabled = true
+
+			# How many links of a single message get previews
+			MaximumLinks = 5
+
+			# The largest page in bytes that will be read for a preview
+			MaximumSize = 1048576
+
+			# How long fetching a page can take in nanoseconds.
+			# The default is 5 seconds.
+			Timeout = 5000000000
+
+			# How many previews are kept in memory
+			CacheSize = 4096
+
+			# How long previews are kept in memory in nanoseconds. Stored preview
+			# images no message shows are deleted once they've gone unused for
+			# as long. The default is 1 hour.
+			CacheDuration = 3600000000000
+
+			# How long a link that couldn't be previewed isn't fetched again in
+			# nanoseconds. The default is 10 minutes.
+			FailureCacheDuration = 600000000000
+
+			# The largest preview image in bytes that will be stored. Preview
+			# images are stored by Legato, so clients never fetch them from
+			# the linked site.
+			MaximumImageSize = 8388608
+
+			# How many previews are generated at once
+			Workers = 4
+
+			# How many messages can wait for previews; messages arriving
+			# once it's full don't get previews
+			QueueLength = 256
+		}
+
 		# Limitations on how many items the in-memory caches of Legato can hold.
 		# When Legato runs into the item ceiling, least recently used items are
 		# removed from memory and must be loaded from the database when referenced
@@ -94,6 +255,9 @@ Server {
 			Messages {
 				# The maximum amount of messages a client can request at once
 				MaximumGetAmount = 50
+
+				# The maximum amount of messages a client can delete at once
+				MaximumBulkDeleteAmount = 500
 			}
 		}
 
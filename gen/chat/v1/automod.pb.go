@@ -0,0 +1,1515 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: chat/v1/automod.proto
+
+package v1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// AutomodTrigger is what a rule looks for in messages
+type AutomodTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*AutomodTrigger_Regex_
+	//	*AutomodTrigger_Words_
+	//	*AutomodTrigger_Links_
+	//	*AutomodTrigger_Mentions_
+	//	*AutomodTrigger_Attachments_
+	Kind isAutomodTrigger_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *AutomodTrigger) Reset() {
+	*x = AutomodTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodTrigger) ProtoMessage() {}
+
+func (x *AutomodTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodTrigger.ProtoReflect.Descriptor instead.
+func (*AutomodTrigger) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *AutomodTrigger) GetKind() isAutomodTrigger_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *AutomodTrigger) GetRegex() *AutomodTrigger_Regex {
+	if x, ok := x.GetKind().(*AutomodTrigger_Regex_); ok {
+		return x.Regex
+	}
+	return nil
+}
+
+func (x *AutomodTrigger) GetWords() *AutomodTrigger_Words {
+	if x, ok := x.GetKind().(*AutomodTrigger_Words_); ok {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *AutomodTrigger) GetLinks() *AutomodTrigger_Links {
+	if x, ok := x.GetKind().(*AutomodTrigger_Links_); ok {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *AutomodTrigger) GetMentions() *AutomodTrigger_Mentions {
+	if x, ok := x.GetKind().(*AutomodTrigger_Mentions_); ok {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *AutomodTrigger) GetAttachments() *AutomodTrigger_Attachments {
+	if x, ok := x.GetKind().(*AutomodTrigger_Attachments_); ok {
+		return x.Attachments
+	}
+	return nil
+}
+
+type isAutomodTrigger_Kind interface {
+	isAutomodTrigger_Kind()
+}
+
+type AutomodTrigger_Regex_ struct {
+	Regex *AutomodTrigger_Regex `protobuf:"bytes,1,opt,name=regex,proto3,oneof"`
+}
+
+type AutomodTrigger_Words_ struct {
+	Words *AutomodTrigger_Words `protobuf:"bytes,2,opt,name=words,proto3,oneof"`
+}
+
+type AutomodTrigger_Links_ struct {
+	Links *AutomodTrigger_Links `protobuf:"bytes,3,opt,name=links,proto3,oneof"`
+}
+
+type AutomodTrigger_Mentions_ struct {
+	Mentions *AutomodTrigger_Mentions `protobuf:"bytes,4,opt,name=mentions,proto3,oneof"`
+}
+
+type AutomodTrigger_Attachments_ struct {
+	Attachments *AutomodTrigger_Attachments `protobuf:"bytes,5,opt,name=attachments,proto3,oneof"`
+}
+
+func (*AutomodTrigger_Regex_) isAutomodTrigger_Kind() {}
+
+func (*AutomodTrigger_Words_) isAutomodTrigger_Kind() {}
+
+func (*AutomodTrigger_Links_) isAutomodTrigger_Kind() {}
+
+func (*AutomodTrigger_Mentions_) isAutomodTrigger_Kind() {}
+
+func (*AutomodTrigger_Attachments_) isAutomodTrigger_Kind() {}
+
+// AutomodAction is what happens to messages matching a rule
+type AutomodAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*AutomodAction_Block_
+	//	*AutomodAction_Delete_
+	//	*AutomodAction_Flag_
+	//	*AutomodAction_Timeout_
+	Kind isAutomodAction_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *AutomodAction) Reset() {
+	*x = AutomodAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodAction) ProtoMessage() {}
+
+func (x *AutomodAction) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodAction.ProtoReflect.Descriptor instead.
+func (*AutomodAction) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *AutomodAction) GetKind() isAutomodAction_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *AutomodAction) GetBlock() *AutomodAction_Block {
+	if x, ok := x.GetKind().(*AutomodAction_Block_); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *AutomodAction) GetDelete() *AutomodAction_Delete {
+	if x, ok := x.GetKind().(*AutomodAction_Delete_); ok {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *AutomodAction) GetFlag() *AutomodAction_Flag {
+	if x, ok := x.GetKind().(*AutomodAction_Flag_); ok {
+		return x.Flag
+	}
+	return nil
+}
+
+func (x *AutomodAction) GetTimeout() *AutomodAction_Timeout {
+	if x, ok := x.GetKind().(*AutomodAction_Timeout_); ok {
+		return x.Timeout
+	}
+	return nil
+}
+
+type isAutomodAction_Kind interface {
+	isAutomodAction_Kind()
+}
+
+type AutomodAction_Block_ struct {
+	Block *AutomodAction_Block `protobuf:"bytes,1,opt,name=block,proto3,oneof"`
+}
+
+type AutomodAction_Delete_ struct {
+	Delete *AutomodAction_Delete `protobuf:"bytes,2,opt,name=delete,proto3,oneof"`
+}
+
+type AutomodAction_Flag_ struct {
+	Flag *AutomodAction_Flag `protobuf:"bytes,3,opt,name=flag,proto3,oneof"`
+}
+
+type AutomodAction_Timeout_ struct {
+	Timeout *AutomodAction_Timeout `protobuf:"bytes,4,opt,name=timeout,proto3,oneof"`
+}
+
+func (*AutomodAction_Block_) isAutomodAction_Kind() {}
+
+func (*AutomodAction_Delete_) isAutomodAction_Kind() {}
+
+func (*AutomodAction_Flag_) isAutomodAction_Kind() {}
+
+func (*AutomodAction_Timeout_) isAutomodAction_Kind() {}
+
+type AutomodRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId  uint64           `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name    string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool             `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Trigger *AutomodTrigger  `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Actions []*AutomodAction `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Members with any of these roles are not affected by the rule. The
+	// guild owner never is.
+	ExemptRoles []uint64 `protobuf:"varint,6,rep,packed,name=exempt_roles,json=exemptRoles,proto3" json:"exempt_roles,omitempty"`
+}
+
+func (x *AutomodRule) Reset() {
+	*x = AutomodRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodRule) ProtoMessage() {}
+
+func (x *AutomodRule) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodRule.ProtoReflect.Descriptor instead.
+func (*AutomodRule) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AutomodRule) GetRuleId() uint64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *AutomodRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AutomodRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AutomodRule) GetTrigger() *AutomodTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *AutomodRule) GetActions() []*AutomodAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *AutomodRule) GetExemptRoles() []uint64 {
+	if x != nil {
+		return x.ExemptRoles
+	}
+	return nil
+}
+
+// The rule_id of the rule is ignored, a new one is picked
+type CreateAutomodRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId uint64       `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Rule    *AutomodRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *CreateAutomodRuleRequest) Reset() {
+	*x = CreateAutomodRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAutomodRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAutomodRuleRequest) ProtoMessage() {}
+
+func (x *CreateAutomodRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAutomodRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAutomodRuleRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAutomodRuleRequest) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *CreateAutomodRuleRequest) GetRule() *AutomodRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateAutomodRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId uint64 `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+}
+
+func (x *CreateAutomodRuleResponse) Reset() {
+	*x = CreateAutomodRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAutomodRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAutomodRuleResponse) ProtoMessage() {}
+
+func (x *CreateAutomodRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAutomodRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAutomodRuleResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAutomodRuleResponse) GetRuleId() uint64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+// Replaces the rule with the rule_id of the given rule
+type UpdateAutomodRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId uint64       `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Rule    *AutomodRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *UpdateAutomodRuleRequest) Reset() {
+	*x = UpdateAutomodRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAutomodRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAutomodRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAutomodRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAutomodRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAutomodRuleRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAutomodRuleRequest) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *UpdateAutomodRuleRequest) GetRule() *AutomodRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteAutomodRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	RuleId  uint64 `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+}
+
+func (x *DeleteAutomodRuleRequest) Reset() {
+	*x = DeleteAutomodRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAutomodRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAutomodRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAutomodRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAutomodRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAutomodRuleRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAutomodRuleRequest) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *DeleteAutomodRuleRequest) GetRuleId() uint64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type GetAutomodRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+}
+
+func (x *GetAutomodRulesRequest) Reset() {
+	*x = GetAutomodRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAutomodRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAutomodRulesRequest) ProtoMessage() {}
+
+func (x *GetAutomodRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAutomodRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetAutomodRulesRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAutomodRulesRequest) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+type GetAutomodRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*AutomodRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *GetAutomodRulesResponse) Reset() {
+	*x = GetAutomodRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAutomodRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAutomodRulesResponse) ProtoMessage() {}
+
+func (x *GetAutomodRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAutomodRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetAutomodRulesResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAutomodRulesResponse) GetRules() []*AutomodRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Matches messages whose content matches any of the patterns, in RE2
+// syntax
+type AutomodTrigger_Regex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+}
+
+func (x *AutomodTrigger_Regex) Reset() {
+	*x = AutomodTrigger_Regex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodTrigger_Regex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodTrigger_Regex) ProtoMessage() {}
+
+func (x *AutomodTrigger_Regex) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodTrigger_Regex.ProtoReflect.Descriptor instead.
+func (*AutomodTrigger_Regex) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AutomodTrigger_Regex) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+// Matches messages containing any of the words, ignoring case
+type AutomodTrigger_Words struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Words []string `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+}
+
+func (x *AutomodTrigger_Words) Reset() {
+	*x = AutomodTrigger_Words{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodTrigger_Words) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodTrigger_Words) ProtoMessage() {}
+
+func (x *AutomodTrigger_Words) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodTrigger_Words.ProtoReflect.Descriptor instead.
+func (*AutomodTrigger_Words) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *AutomodTrigger_Words) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+// Matches messages linking to a domain that isn't allowed, subdomains of
+// allowed domains are allowed too. With invites_only set only invite
+// links match, which are harmony:// links and links to an /invite/ path.
+type AutomodTrigger_Links struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowedDomains []string `protobuf:"bytes,1,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	InvitesOnly    bool     `protobuf:"varint,2,opt,name=invites_only,json=invitesOnly,proto3" json:"invites_only,omitempty"`
+}
+
+func (x *AutomodTrigger_Links) Reset() {
+	*x = AutomodTrigger_Links{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodTrigger_Links) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodTrigger_Links) ProtoMessage() {}
+
+func (x *AutomodTrigger_Links) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodTrigger_Links.ProtoReflect.Descriptor instead.
+func (*AutomodTrigger_Links) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *AutomodTrigger_Links) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *AutomodTrigger_Links) GetInvitesOnly() bool {
+	if x != nil {
+		return x.InvitesOnly
+	}
+	return false
+}
+
+// Matches messages mentioning more than maximum users and roles, with
+// @everyone counting as one mention
+type AutomodTrigger_Mentions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maximum uint32 `protobuf:"varint,1,opt,name=maximum,proto3" json:"maximum,omitempty"`
+}
+
+func (x *AutomodTrigger_Mentions) Reset() {
+	*x = AutomodTrigger_Mentions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodTrigger_Mentions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodTrigger_Mentions) ProtoMessage() {}
+
+func (x *AutomodTrigger_Mentions) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodTrigger_Mentions.ProtoReflect.Descriptor instead.
+func (*AutomodTrigger_Mentions) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *AutomodTrigger_Mentions) GetMaximum() uint32 {
+	if x != nil {
+		return x.Maximum
+	}
+	return 0
+}
+
+// Matches messages with an attachment of a blocked content type. A type
+// like "video/*" blocks a whole category.
+type AutomodTrigger_Attachments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockedTypes []string `protobuf:"bytes,1,rep,name=blocked_types,json=blockedTypes,proto3" json:"blocked_types,omitempty"`
+}
+
+func (x *AutomodTrigger_Attachments) Reset() {
+	*x = AutomodTrigger_Attachments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodTrigger_Attachments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodTrigger_Attachments) ProtoMessage() {}
+
+func (x *AutomodTrigger_Attachments) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodTrigger_Attachments.ProtoReflect.Descriptor instead.
+func (*AutomodTrigger_Attachments) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *AutomodTrigger_Attachments) GetBlockedTypes() []string {
+	if x != nil {
+		return x.BlockedTypes
+	}
+	return nil
+}
+
+// Refuses to send the message
+type AutomodAction_Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AutomodAction_Block) Reset() {
+	*x = AutomodAction_Block{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodAction_Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodAction_Block) ProtoMessage() {}
+
+func (x *AutomodAction_Block) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodAction_Block.ProtoReflect.Descriptor instead.
+func (*AutomodAction_Block) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Deletes the message right after it was sent
+type AutomodAction_Delete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AutomodAction_Delete) Reset() {
+	*x = AutomodAction_Delete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodAction_Delete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodAction_Delete) ProtoMessage() {}
+
+func (x *AutomodAction_Delete) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodAction_Delete.ProtoReflect.Descriptor instead.
+func (*AutomodAction_Delete) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{1, 1}
+}
+
+// Posts a report of the message in a moderator channel
+type AutomodAction_Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *AutomodAction_Flag) Reset() {
+	*x = AutomodAction_Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodAction_Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodAction_Flag) ProtoMessage() {}
+
+func (x *AutomodAction_Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodAction_Flag.ProtoReflect.Descriptor instead.
+func (*AutomodAction_Flag) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *AutomodAction_Flag) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+// Stops the author from sending messages in the guild for a number of
+// seconds
+type AutomodAction_Timeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration uint64 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *AutomodAction_Timeout) Reset() {
+	*x = AutomodAction_Timeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_automod_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomodAction_Timeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodAction_Timeout) ProtoMessage() {}
+
+func (x *AutomodAction_Timeout) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_automod_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodAction_Timeout.ProtoReflect.Descriptor instead.
+func (*AutomodAction_Timeout) Descriptor() ([]byte, []int) {
+	return file_chat_v1_automod_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *AutomodAction_Timeout) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+var File_chat_v1_automod_proto protoreflect.FileDescriptor
+
+var file_chat_v1_automod_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x6f,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x22, 0xe6, 0x04, 0x0a, 0x0e, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x67, 0x65, 0x78, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x3e, 0x0a, 0x05,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x57, 0x6f,
+	0x72, 0x64, 0x73, 0x48, 0x00, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x47, 0x0a, 0x08,
+	0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x23, 0x0a, 0x05, 0x52, 0x65, 0x67, 0x65, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x1a, 0x1d, 0x0a, 0x05,
+	0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x53, 0x0a, 0x05, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
+	0x1a, 0x24, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x1a, 0x32, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x22, 0xfe, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x40, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x04, 0x66, 0x6c, 0x61,
+	0x67, 0x12, 0x43, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a,
+	0x08, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x29, 0x0a, 0x04, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x1a, 0x25, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x0b, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x19, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x72, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x22, 0x56, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f,
+	0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chat_v1_automod_proto_rawDescOnce sync.Once
+	file_chat_v1_automod_proto_rawDescData = file_chat_v1_automod_proto_rawDesc
+)
+
+func file_chat_v1_automod_proto_rawDescGZIP() []byte {
+	file_chat_v1_automod_proto_rawDescOnce.Do(func() {
+		file_chat_v1_automod_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_v1_automod_proto_rawDescData)
+	})
+	return file_chat_v1_automod_proto_rawDescData
+}
+
+var file_chat_v1_automod_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_chat_v1_automod_proto_goTypes = []interface{}{
+	(*AutomodTrigger)(nil),             // 0: protocol.chat.v1.AutomodTrigger
+	(*AutomodAction)(nil),              // 1: protocol.chat.v1.AutomodAction
+	(*AutomodRule)(nil),                // 2: protocol.chat.v1.AutomodRule
+	(*CreateAutomodRuleRequest)(nil),   // 3: protocol.chat.v1.CreateAutomodRuleRequest
+	(*CreateAutomodRuleResponse)(nil),  // 4: protocol.chat.v1.CreateAutomodRuleResponse
+	(*UpdateAutomodRuleRequest)(nil),   // 5: protocol.chat.v1.UpdateAutomodRuleRequest
+	(*DeleteAutomodRuleRequest)(nil),   // 6: protocol.chat.v1.DeleteAutomodRuleRequest
+	(*GetAutomodRulesRequest)(nil),     // 7: protocol.chat.v1.GetAutomodRulesRequest
+	(*GetAutomodRulesResponse)(nil),    // 8: protocol.chat.v1.GetAutomodRulesResponse
+	(*AutomodTrigger_Regex)(nil),       // 9: protocol.chat.v1.AutomodTrigger.Regex
+	(*AutomodTrigger_Words)(nil),       // 10: protocol.chat.v1.AutomodTrigger.Words
+	(*AutomodTrigger_Links)(nil),       // 11: protocol.chat.v1.AutomodTrigger.Links
+	(*AutomodTrigger_Mentions)(nil),    // 12: protocol.chat.v1.AutomodTrigger.Mentions
+	(*AutomodTrigger_Attachments)(nil), // 13: protocol.chat.v1.AutomodTrigger.Attachments
+	(*AutomodAction_Block)(nil),        // 14: protocol.chat.v1.AutomodAction.Block
+	(*AutomodAction_Delete)(nil),       // 15: protocol.chat.v1.AutomodAction.Delete
+	(*AutomodAction_Flag)(nil),         // 16: protocol.chat.v1.AutomodAction.Flag
+	(*AutomodAction_Timeout)(nil),      // 17: protocol.chat.v1.AutomodAction.Timeout
+}
+var file_chat_v1_automod_proto_depIdxs = []int32{
+	9,  // 0: protocol.chat.v1.AutomodTrigger.regex:type_name -> protocol.chat.v1.AutomodTrigger.Regex
+	10, // 1: protocol.chat.v1.AutomodTrigger.words:type_name -> protocol.chat.v1.AutomodTrigger.Words
+	11, // 2: protocol.chat.v1.AutomodTrigger.links:type_name -> protocol.chat.v1.AutomodTrigger.Links
+	12, // 3: protocol.chat.v1.AutomodTrigger.mentions:type_name -> protocol.chat.v1.AutomodTrigger.Mentions
+	13, // 4: protocol.chat.v1.AutomodTrigger.attachments:type_name -> protocol.chat.v1.AutomodTrigger.Attachments
+	14, // 5: protocol.chat.v1.AutomodAction.block:type_name -> protocol.chat.v1.AutomodAction.Block
+	15, // 6: protocol.chat.v1.AutomodAction.delete:type_name -> protocol.chat.v1.AutomodAction.Delete
+	16, // 7: protocol.chat.v1.AutomodAction.flag:type_name -> protocol.chat.v1.AutomodAction.Flag
+	17, // 8: protocol.chat.v1.AutomodAction.timeout:type_name -> protocol.chat.v1.AutomodAction.Timeout
+	0,  // 9: protocol.chat.v1.AutomodRule.trigger:type_name -> protocol.chat.v1.AutomodTrigger
+	1,  // 10: protocol.chat.v1.AutomodRule.actions:type_name -> protocol.chat.v1.AutomodAction
+	2,  // 11: protocol.chat.v1.CreateAutomodRuleRequest.rule:type_name -> protocol.chat.v1.AutomodRule
+	2,  // 12: protocol.chat.v1.UpdateAutomodRuleRequest.rule:type_name -> protocol.chat.v1.AutomodRule
+	2,  // 13: protocol.chat.v1.GetAutomodRulesResponse.rules:type_name -> protocol.chat.v1.AutomodRule
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_chat_v1_automod_proto_init() }
+func file_chat_v1_automod_proto_init() {
+	if File_chat_v1_automod_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chat_v1_automod_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAutomodRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAutomodRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAutomodRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAutomodRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAutomodRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAutomodRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodTrigger_Regex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodTrigger_Words); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodTrigger_Links); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodTrigger_Mentions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodTrigger_Attachments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodAction_Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodAction_Delete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodAction_Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_automod_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomodAction_Timeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_chat_v1_automod_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*AutomodTrigger_Regex_)(nil),
+		(*AutomodTrigger_Words_)(nil),
+		(*AutomodTrigger_Links_)(nil),
+		(*AutomodTrigger_Mentions_)(nil),
+		(*AutomodTrigger_Attachments_)(nil),
+	}
+	file_chat_v1_automod_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*AutomodAction_Block_)(nil),
+		(*AutomodAction_Delete_)(nil),
+		(*AutomodAction_Flag_)(nil),
+		(*AutomodAction_Timeout_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_chat_v1_automod_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_chat_v1_automod_proto_goTypes,
+		DependencyIndexes: file_chat_v1_automod_proto_depIdxs,
+		MessageInfos:      file_chat_v1_automod_proto_msgTypes,
+	}.Build()
+	File_chat_v1_automod_proto = out.File
+	file_chat_v1_automod_proto_rawDesc = nil
+	file_chat_v1_automod_proto_goTypes = nil
+	file_chat_v1_automod_proto_depIdxs = nil
+}
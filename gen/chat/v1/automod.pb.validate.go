@@ -0,0 +1,1439 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: chat/v1/automod.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// define the regex for a UUID once up-front
+var _automod_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on AutomodTrigger with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AutomodTrigger) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	switch m.Kind.(type) {
+
+	case *AutomodTrigger_Regex_:
+
+		if v, ok := interface{}(m.GetRegex()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodTriggerValidationError{
+					field:  "Regex",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AutomodTrigger_Words_:
+
+		if v, ok := interface{}(m.GetWords()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodTriggerValidationError{
+					field:  "Words",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AutomodTrigger_Links_:
+
+		if v, ok := interface{}(m.GetLinks()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodTriggerValidationError{
+					field:  "Links",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AutomodTrigger_Mentions_:
+
+		if v, ok := interface{}(m.GetMentions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodTriggerValidationError{
+					field:  "Mentions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AutomodTrigger_Attachments_:
+
+		if v, ok := interface{}(m.GetAttachments()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodTriggerValidationError{
+					field:  "Attachments",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AutomodTriggerValidationError is the validation error returned by
+// AutomodTrigger.Validate if the designated constraints aren't met.
+type AutomodTriggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodTriggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodTriggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodTriggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodTriggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodTriggerValidationError) ErrorName() string { return "AutomodTriggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AutomodTriggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodTrigger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodTriggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodTriggerValidationError{}
+
+// Validate checks the field values on AutomodAction with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AutomodAction) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	switch m.Kind.(type) {
+
+	case *AutomodAction_Block_:
+
+		if v, ok := interface{}(m.GetBlock()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodActionValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AutomodAction_Delete_:
+
+		if v, ok := interface{}(m.GetDelete()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodActionValidationError{
+					field:  "Delete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AutomodAction_Flag_:
+
+		if v, ok := interface{}(m.GetFlag()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodActionValidationError{
+					field:  "Flag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AutomodAction_Timeout_:
+
+		if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodActionValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AutomodActionValidationError is the validation error returned by
+// AutomodAction.Validate if the designated constraints aren't met.
+type AutomodActionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodActionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodActionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodActionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodActionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodActionValidationError) ErrorName() string { return "AutomodActionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AutomodActionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodAction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodActionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodActionValidationError{}
+
+// Validate checks the field values on AutomodRule with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AutomodRule) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RuleId
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	if v, ok := interface{}(m.GetTrigger()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AutomodRuleValidationError{
+				field:  "Trigger",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutomodRuleValidationError{
+					field:  fmt.Sprintf("Actions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AutomodRuleValidationError is the validation error returned by
+// AutomodRule.Validate if the designated constraints aren't met.
+type AutomodRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodRuleValidationError) ErrorName() string { return "AutomodRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AutomodRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodRuleValidationError{}
+
+// Validate checks the field values on CreateAutomodRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAutomodRuleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAutomodRuleRequestValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateAutomodRuleRequestValidationError is the validation error returned by
+// CreateAutomodRuleRequest.Validate if the designated constraints aren't met.
+type CreateAutomodRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAutomodRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAutomodRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAutomodRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAutomodRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAutomodRuleRequestValidationError) ErrorName() string {
+	return "CreateAutomodRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAutomodRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAutomodRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAutomodRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAutomodRuleRequestValidationError{}
+
+// Validate checks the field values on CreateAutomodRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAutomodRuleResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RuleId
+
+	return nil
+}
+
+// CreateAutomodRuleResponseValidationError is the validation error returned by
+// CreateAutomodRuleResponse.Validate if the designated constraints aren't met.
+type CreateAutomodRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAutomodRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAutomodRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAutomodRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAutomodRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAutomodRuleResponseValidationError) ErrorName() string {
+	return "CreateAutomodRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAutomodRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAutomodRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAutomodRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAutomodRuleResponseValidationError{}
+
+// Validate checks the field values on UpdateAutomodRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateAutomodRuleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAutomodRuleRequestValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateAutomodRuleRequestValidationError is the validation error returned by
+// UpdateAutomodRuleRequest.Validate if the designated constraints aren't met.
+type UpdateAutomodRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAutomodRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAutomodRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAutomodRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAutomodRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAutomodRuleRequestValidationError) ErrorName() string {
+	return "UpdateAutomodRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAutomodRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAutomodRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAutomodRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAutomodRuleRequestValidationError{}
+
+// Validate checks the field values on DeleteAutomodRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteAutomodRuleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for RuleId
+
+	return nil
+}
+
+// DeleteAutomodRuleRequestValidationError is the validation error returned by
+// DeleteAutomodRuleRequest.Validate if the designated constraints aren't met.
+type DeleteAutomodRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAutomodRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAutomodRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAutomodRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAutomodRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAutomodRuleRequestValidationError) ErrorName() string {
+	return "DeleteAutomodRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAutomodRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAutomodRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAutomodRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAutomodRuleRequestValidationError{}
+
+// Validate checks the field values on GetAutomodRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetAutomodRulesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	return nil
+}
+
+// GetAutomodRulesRequestValidationError is the validation error returned by
+// GetAutomodRulesRequest.Validate if the designated constraints aren't met.
+type GetAutomodRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAutomodRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAutomodRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAutomodRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAutomodRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAutomodRulesRequestValidationError) ErrorName() string {
+	return "GetAutomodRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAutomodRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAutomodRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAutomodRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAutomodRulesRequestValidationError{}
+
+// Validate checks the field values on GetAutomodRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetAutomodRulesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAutomodRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetAutomodRulesResponseValidationError is the validation error returned by
+// GetAutomodRulesResponse.Validate if the designated constraints aren't met.
+type GetAutomodRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAutomodRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAutomodRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAutomodRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAutomodRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAutomodRulesResponseValidationError) ErrorName() string {
+	return "GetAutomodRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAutomodRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAutomodRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAutomodRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAutomodRulesResponseValidationError{}
+
+// Validate checks the field values on AutomodTrigger_Regex with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodTrigger_Regex) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AutomodTrigger_RegexValidationError is the validation error returned by
+// AutomodTrigger_Regex.Validate if the designated constraints aren't met.
+type AutomodTrigger_RegexValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodTrigger_RegexValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodTrigger_RegexValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodTrigger_RegexValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodTrigger_RegexValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodTrigger_RegexValidationError) ErrorName() string {
+	return "AutomodTrigger_RegexValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodTrigger_RegexValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodTrigger_Regex.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodTrigger_RegexValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodTrigger_RegexValidationError{}
+
+// Validate checks the field values on AutomodTrigger_Words with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodTrigger_Words) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AutomodTrigger_WordsValidationError is the validation error returned by
+// AutomodTrigger_Words.Validate if the designated constraints aren't met.
+type AutomodTrigger_WordsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodTrigger_WordsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodTrigger_WordsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodTrigger_WordsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodTrigger_WordsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodTrigger_WordsValidationError) ErrorName() string {
+	return "AutomodTrigger_WordsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodTrigger_WordsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodTrigger_Words.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodTrigger_WordsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodTrigger_WordsValidationError{}
+
+// Validate checks the field values on AutomodTrigger_Links with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodTrigger_Links) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for InvitesOnly
+
+	return nil
+}
+
+// AutomodTrigger_LinksValidationError is the validation error returned by
+// AutomodTrigger_Links.Validate if the designated constraints aren't met.
+type AutomodTrigger_LinksValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodTrigger_LinksValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodTrigger_LinksValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodTrigger_LinksValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodTrigger_LinksValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodTrigger_LinksValidationError) ErrorName() string {
+	return "AutomodTrigger_LinksValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodTrigger_LinksValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodTrigger_Links.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodTrigger_LinksValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodTrigger_LinksValidationError{}
+
+// Validate checks the field values on AutomodTrigger_Mentions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodTrigger_Mentions) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Maximum
+
+	return nil
+}
+
+// AutomodTrigger_MentionsValidationError is the validation error returned by
+// AutomodTrigger_Mentions.Validate if the designated constraints aren't met.
+type AutomodTrigger_MentionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodTrigger_MentionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodTrigger_MentionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodTrigger_MentionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodTrigger_MentionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodTrigger_MentionsValidationError) ErrorName() string {
+	return "AutomodTrigger_MentionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodTrigger_MentionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodTrigger_Mentions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodTrigger_MentionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodTrigger_MentionsValidationError{}
+
+// Validate checks the field values on AutomodTrigger_Attachments with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodTrigger_Attachments) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AutomodTrigger_AttachmentsValidationError is the validation error returned
+// by AutomodTrigger_Attachments.Validate if the designated constraints aren't met.
+type AutomodTrigger_AttachmentsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodTrigger_AttachmentsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodTrigger_AttachmentsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodTrigger_AttachmentsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodTrigger_AttachmentsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodTrigger_AttachmentsValidationError) ErrorName() string {
+	return "AutomodTrigger_AttachmentsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodTrigger_AttachmentsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodTrigger_Attachments.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodTrigger_AttachmentsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodTrigger_AttachmentsValidationError{}
+
+// Validate checks the field values on AutomodAction_Block with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodAction_Block) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AutomodAction_BlockValidationError is the validation error returned by
+// AutomodAction_Block.Validate if the designated constraints aren't met.
+type AutomodAction_BlockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodAction_BlockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodAction_BlockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodAction_BlockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodAction_BlockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodAction_BlockValidationError) ErrorName() string {
+	return "AutomodAction_BlockValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodAction_BlockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodAction_Block.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodAction_BlockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodAction_BlockValidationError{}
+
+// Validate checks the field values on AutomodAction_Delete with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodAction_Delete) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AutomodAction_DeleteValidationError is the validation error returned by
+// AutomodAction_Delete.Validate if the designated constraints aren't met.
+type AutomodAction_DeleteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodAction_DeleteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodAction_DeleteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodAction_DeleteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodAction_DeleteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodAction_DeleteValidationError) ErrorName() string {
+	return "AutomodAction_DeleteValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodAction_DeleteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodAction_Delete.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodAction_DeleteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodAction_DeleteValidationError{}
+
+// Validate checks the field values on AutomodAction_Flag with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodAction_Flag) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ChannelId
+
+	return nil
+}
+
+// AutomodAction_FlagValidationError is the validation error returned by
+// AutomodAction_Flag.Validate if the designated constraints aren't met.
+type AutomodAction_FlagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodAction_FlagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodAction_FlagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodAction_FlagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodAction_FlagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodAction_FlagValidationError) ErrorName() string {
+	return "AutomodAction_FlagValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodAction_FlagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodAction_Flag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodAction_FlagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodAction_FlagValidationError{}
+
+// Validate checks the field values on AutomodAction_Timeout with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AutomodAction_Timeout) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Duration
+
+	return nil
+}
+
+// AutomodAction_TimeoutValidationError is the validation error returned by
+// AutomodAction_Timeout.Validate if the designated constraints aren't met.
+type AutomodAction_TimeoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutomodAction_TimeoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutomodAction_TimeoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutomodAction_TimeoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutomodAction_TimeoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutomodAction_TimeoutValidationError) ErrorName() string {
+	return "AutomodAction_TimeoutValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutomodAction_TimeoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutomodAction_Timeout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutomodAction_TimeoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutomodAction_TimeoutValidationError{}
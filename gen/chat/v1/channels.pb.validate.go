@@ -554,6 +554,154 @@ var _ interface {
 	ErrorName() string
 } = DeleteChannelRequestValidationError{}
 
+// Validate checks the field values on SetChannelRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetChannelRetentionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MaxAge
+
+	// no validation rules for Inherit
+
+	return nil
+}
+
+// SetChannelRetentionRequestValidationError is the validation error returned
+// by SetChannelRetentionRequest.Validate if the designated constraints aren't met.
+type SetChannelRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetChannelRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetChannelRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetChannelRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetChannelRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetChannelRetentionRequestValidationError) ErrorName() string {
+	return "SetChannelRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetChannelRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetChannelRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetChannelRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetChannelRetentionRequestValidationError{}
+
+// Validate checks the field values on SetChannelSlowModeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetChannelSlowModeRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for Interval
+
+	return nil
+}
+
+// SetChannelSlowModeRequestValidationError is the validation error returned by
+// SetChannelSlowModeRequest.Validate if the designated constraints aren't met.
+type SetChannelSlowModeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetChannelSlowModeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetChannelSlowModeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetChannelSlowModeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetChannelSlowModeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetChannelSlowModeRequestValidationError) ErrorName() string {
+	return "SetChannelSlowModeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetChannelSlowModeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetChannelSlowModeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetChannelSlowModeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetChannelSlowModeRequestValidationError{}
+
 // Validate checks the field values on GetGuildChannelsResponse_Channel with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
@@ -570,6 +718,8 @@ func (m *GetGuildChannelsResponse_Channel) Validate() error {
 
 	// no validation rules for Kind
 
+	// no validation rules for SlowMode
+
 	return nil
 }
 
@@ -49,277 +49,455 @@ var file_chat_v1_chat_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72,
 	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
 	0x63, 0x68, 0x61, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa2, 0x21, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x28, 0x2e,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xad,
+	0x37, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x24, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c,
-	0x64, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x70,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
-	0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x18,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x47,
-	0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x75, 0x69, 0x6c, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x75,
-	0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x21, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x29,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x68, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d,
+	0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x13,
+	0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54,
+	0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f,
-	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x45,
-	0x6d, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x45, 0x6d, 0x6f, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x12,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d,
+	0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5b,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x70,
+	0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53,
-	0x0a, 0x0e, 0x41, 0x64, 0x64, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x61, 0x63, 0x6b,
-	0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x61,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69,
-	0x6c, 0x64, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x13, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x63, 0x6b,
-	0x12, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x46,
-	0x72, 0x6f, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x6c, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x6c, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x55, 0x0a, 0x0f, 0x44, 0x65, 0x71, 0x75, 0x69, 0x70, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x71, 0x75, 0x69, 0x70, 0x45, 0x6d, 0x6f, 0x74,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75,
-	0x69, 0x6c, 0x64, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
-	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
-	0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x23, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x70,
+	0x5b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x53, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x61, 0x63,
+	0x6b, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x50,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x41, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x12,
+	0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c,
-	0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x12,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x53,
-	0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x0f, 0x44, 0x65, 0x71, 0x75, 0x69, 0x70, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x71, 0x75, 0x69, 0x70, 0x45, 0x6d, 0x6f, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x23, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a,
+	0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x2e,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c,
-	0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5f, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65,
-	0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x75,
-	0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x55, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x47, 0x75, 0x69, 0x6c, 0x64,
-	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x47, 0x75,
-	0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x54, 0x79, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6d,
+	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x55, 0x0a, 0x0f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c,
 	0x65, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x4d, 0x6f, 0x76,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x28, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x50, 0x0a,
-	0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x0f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x50, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x72, 0x6d, 0x6f,
-	0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x33,
+	0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x72,
+	0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x74,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_chat_v1_chat_proto_goTypes = []interface{}{
@@ -336,162 +514,264 @@ var file_chat_v1_chat_proto_goTypes = []interface{}{
 	(*GetGuildChannelsRequest)(nil),          // 10: protocol.chat.v1.GetGuildChannelsRequest
 	(*GetChannelMessagesRequest)(nil),        // 11: protocol.chat.v1.GetChannelMessagesRequest
 	(*GetMessageRequest)(nil),                // 12: protocol.chat.v1.GetMessageRequest
-	(*GetEmotePacksRequest)(nil),             // 13: protocol.chat.v1.GetEmotePacksRequest
-	(*GetEmotePackEmotesRequest)(nil),        // 14: protocol.chat.v1.GetEmotePackEmotesRequest
-	(*UpdateGuildNameRequest)(nil),           // 15: protocol.chat.v1.UpdateGuildNameRequest
-	(*UpdateChannelNameRequest)(nil),         // 16: protocol.chat.v1.UpdateChannelNameRequest
-	(*UpdateChannelOrderRequest)(nil),        // 17: protocol.chat.v1.UpdateChannelOrderRequest
-	(*UpdateMessageRequest)(nil),             // 18: protocol.chat.v1.UpdateMessageRequest
-	(*AddEmoteToPackRequest)(nil),            // 19: protocol.chat.v1.AddEmoteToPackRequest
-	(*DeleteGuildRequest)(nil),               // 20: protocol.chat.v1.DeleteGuildRequest
-	(*DeleteInviteRequest)(nil),              // 21: protocol.chat.v1.DeleteInviteRequest
-	(*DeleteChannelRequest)(nil),             // 22: protocol.chat.v1.DeleteChannelRequest
-	(*DeleteMessageRequest)(nil),             // 23: protocol.chat.v1.DeleteMessageRequest
-	(*DeleteEmoteFromPackRequest)(nil),       // 24: protocol.chat.v1.DeleteEmoteFromPackRequest
-	(*DeleteEmotePackRequest)(nil),           // 25: protocol.chat.v1.DeleteEmotePackRequest
-	(*DequipEmotePackRequest)(nil),           // 26: protocol.chat.v1.DequipEmotePackRequest
-	(*JoinGuildRequest)(nil),                 // 27: protocol.chat.v1.JoinGuildRequest
-	(*LeaveGuildRequest)(nil),                // 28: protocol.chat.v1.LeaveGuildRequest
-	(*TriggerActionRequest)(nil),             // 29: protocol.chat.v1.TriggerActionRequest
-	(*SendMessageRequest)(nil),               // 30: protocol.chat.v1.SendMessageRequest
-	(*QueryPermissionsRequest)(nil),          // 31: protocol.chat.v1.QueryPermissionsRequest
-	(*SetPermissionsRequest)(nil),            // 32: protocol.chat.v1.SetPermissionsRequest
-	(*GetPermissionsRequest)(nil),            // 33: protocol.chat.v1.GetPermissionsRequest
-	(*MoveRoleRequest)(nil),                  // 34: protocol.chat.v1.MoveRoleRequest
-	(*GetGuildRolesRequest)(nil),             // 35: protocol.chat.v1.GetGuildRolesRequest
-	(*AddGuildRoleRequest)(nil),              // 36: protocol.chat.v1.AddGuildRoleRequest
-	(*ModifyGuildRoleRequest)(nil),           // 37: protocol.chat.v1.ModifyGuildRoleRequest
-	(*DeleteGuildRoleRequest)(nil),           // 38: protocol.chat.v1.DeleteGuildRoleRequest
-	(*ManageUserRolesRequest)(nil),           // 39: protocol.chat.v1.ManageUserRolesRequest
-	(*GetUserRolesRequest)(nil),              // 40: protocol.chat.v1.GetUserRolesRequest
-	(*StreamEventsRequest)(nil),              // 41: protocol.chat.v1.StreamEventsRequest
-	(*GetUserRequest)(nil),                   // 42: protocol.chat.v1.GetUserRequest
-	(*GetUserMetadataRequest)(nil),           // 43: protocol.chat.v1.GetUserMetadataRequest
-	(*ProfileUpdateRequest)(nil),             // 44: protocol.chat.v1.ProfileUpdateRequest
-	(*CreateGuildResponse)(nil),              // 45: protocol.chat.v1.CreateGuildResponse
-	(*CreateInviteResponse)(nil),             // 46: protocol.chat.v1.CreateInviteResponse
-	(*CreateChannelResponse)(nil),            // 47: protocol.chat.v1.CreateChannelResponse
-	(*CreateEmotePackResponse)(nil),          // 48: protocol.chat.v1.CreateEmotePackResponse
-	(*GetGuildListResponse)(nil),             // 49: protocol.chat.v1.GetGuildListResponse
-	(*AddGuildToGuildListResponse)(nil),      // 50: protocol.chat.v1.AddGuildToGuildListResponse
-	(*RemoveGuildFromGuildListResponse)(nil), // 51: protocol.chat.v1.RemoveGuildFromGuildListResponse
-	(*GetGuildResponse)(nil),                 // 52: protocol.chat.v1.GetGuildResponse
-	(*GetGuildInvitesResponse)(nil),          // 53: protocol.chat.v1.GetGuildInvitesResponse
-	(*GetGuildMembersResponse)(nil),          // 54: protocol.chat.v1.GetGuildMembersResponse
-	(*GetGuildChannelsResponse)(nil),         // 55: protocol.chat.v1.GetGuildChannelsResponse
-	(*GetChannelMessagesResponse)(nil),       // 56: protocol.chat.v1.GetChannelMessagesResponse
-	(*GetMessageResponse)(nil),               // 57: protocol.chat.v1.GetMessageResponse
-	(*GetEmotePacksResponse)(nil),            // 58: protocol.chat.v1.GetEmotePacksResponse
-	(*GetEmotePackEmotesResponse)(nil),       // 59: protocol.chat.v1.GetEmotePackEmotesResponse
-	(*empty.Empty)(nil),                      // 60: google.protobuf.Empty
-	(*JoinGuildResponse)(nil),                // 61: protocol.chat.v1.JoinGuildResponse
-	(*SendMessageResponse)(nil),              // 62: protocol.chat.v1.SendMessageResponse
-	(*QueryPermissionsResponse)(nil),         // 63: protocol.chat.v1.QueryPermissionsResponse
-	(*GetPermissionsResponse)(nil),           // 64: protocol.chat.v1.GetPermissionsResponse
-	(*MoveRoleResponse)(nil),                 // 65: protocol.chat.v1.MoveRoleResponse
-	(*GetGuildRolesResponse)(nil),            // 66: protocol.chat.v1.GetGuildRolesResponse
-	(*AddGuildRoleResponse)(nil),             // 67: protocol.chat.v1.AddGuildRoleResponse
-	(*GetUserRolesResponse)(nil),             // 68: protocol.chat.v1.GetUserRolesResponse
-	(*Event)(nil),                            // 69: protocol.chat.v1.Event
-	(*GetUserResponse)(nil),                  // 70: protocol.chat.v1.GetUserResponse
-	(*GetUserMetadataResponse)(nil),          // 71: protocol.chat.v1.GetUserMetadataResponse
+	(*SearchMessagesRequest)(nil),            // 13: protocol.chat.v1.SearchMessagesRequest
+	(*GetMentionsRequest)(nil),               // 14: protocol.chat.v1.GetMentionsRequest
+	(*GetThreadMessagesRequest)(nil),         // 15: protocol.chat.v1.GetThreadMessagesRequest
+	(*GetActiveThreadsRequest)(nil),          // 16: protocol.chat.v1.GetActiveThreadsRequest
+	(*GetMessageHistoryRequest)(nil),         // 17: protocol.chat.v1.GetMessageHistoryRequest
+	(*GetPinnedMessagesRequest)(nil),         // 18: protocol.chat.v1.GetPinnedMessagesRequest
+	(*GetEmotePacksRequest)(nil),             // 19: protocol.chat.v1.GetEmotePacksRequest
+	(*GetEmotePackEmotesRequest)(nil),        // 20: protocol.chat.v1.GetEmotePackEmotesRequest
+	(*UpdateGuildNameRequest)(nil),           // 21: protocol.chat.v1.UpdateGuildNameRequest
+	(*SetGuildRetentionRequest)(nil),         // 22: protocol.chat.v1.SetGuildRetentionRequest
+	(*SetChannelRetentionRequest)(nil),       // 23: protocol.chat.v1.SetChannelRetentionRequest
+	(*GetRetentionRequest)(nil),              // 24: protocol.chat.v1.GetRetentionRequest
+	(*UpdateChannelNameRequest)(nil),         // 25: protocol.chat.v1.UpdateChannelNameRequest
+	(*SetChannelSlowModeRequest)(nil),        // 26: protocol.chat.v1.SetChannelSlowModeRequest
+	(*UpdateChannelOrderRequest)(nil),        // 27: protocol.chat.v1.UpdateChannelOrderRequest
+	(*UpdateMessageRequest)(nil),             // 28: protocol.chat.v1.UpdateMessageRequest
+	(*AddEmoteToPackRequest)(nil),            // 29: protocol.chat.v1.AddEmoteToPackRequest
+	(*DeleteGuildRequest)(nil),               // 30: protocol.chat.v1.DeleteGuildRequest
+	(*DeleteInviteRequest)(nil),              // 31: protocol.chat.v1.DeleteInviteRequest
+	(*DeleteChannelRequest)(nil),             // 32: protocol.chat.v1.DeleteChannelRequest
+	(*DeleteMessageRequest)(nil),             // 33: protocol.chat.v1.DeleteMessageRequest
+	(*RestoreMessageRequest)(nil),            // 34: protocol.chat.v1.RestoreMessageRequest
+	(*GetDeletedMessagesRequest)(nil),        // 35: protocol.chat.v1.GetDeletedMessagesRequest
+	(*BulkDeleteMessagesRequest)(nil),        // 36: protocol.chat.v1.BulkDeleteMessagesRequest
+	(*AckChannelRequest)(nil),                // 37: protocol.chat.v1.AckChannelRequest
+	(*GetReadStatesRequest)(nil),             // 38: protocol.chat.v1.GetReadStatesRequest
+	(*PinMessageRequest)(nil),                // 39: protocol.chat.v1.PinMessageRequest
+	(*UnpinMessageRequest)(nil),              // 40: protocol.chat.v1.UnpinMessageRequest
+	(*AddReactionRequest)(nil),               // 41: protocol.chat.v1.AddReactionRequest
+	(*RemoveReactionRequest)(nil),            // 42: protocol.chat.v1.RemoveReactionRequest
+	(*DeleteEmoteFromPackRequest)(nil),       // 43: protocol.chat.v1.DeleteEmoteFromPackRequest
+	(*DeleteEmotePackRequest)(nil),           // 44: protocol.chat.v1.DeleteEmotePackRequest
+	(*DequipEmotePackRequest)(nil),           // 45: protocol.chat.v1.DequipEmotePackRequest
+	(*JoinGuildRequest)(nil),                 // 46: protocol.chat.v1.JoinGuildRequest
+	(*LeaveGuildRequest)(nil),                // 47: protocol.chat.v1.LeaveGuildRequest
+	(*TriggerActionRequest)(nil),             // 48: protocol.chat.v1.TriggerActionRequest
+	(*SendMessageRequest)(nil),               // 49: protocol.chat.v1.SendMessageRequest
+	(*ScheduleMessageRequest)(nil),           // 50: protocol.chat.v1.ScheduleMessageRequest
+	(*GetScheduledMessagesRequest)(nil),      // 51: protocol.chat.v1.GetScheduledMessagesRequest
+	(*UpdateScheduledMessageRequest)(nil),    // 52: protocol.chat.v1.UpdateScheduledMessageRequest
+	(*CancelScheduledMessageRequest)(nil),    // 53: protocol.chat.v1.CancelScheduledMessageRequest
+	(*CreateThreadRequest)(nil),              // 54: protocol.chat.v1.CreateThreadRequest
+	(*TypingRequest)(nil),                    // 55: protocol.chat.v1.TypingRequest
+	(*QueryPermissionsRequest)(nil),          // 56: protocol.chat.v1.QueryPermissionsRequest
+	(*SetPermissionsRequest)(nil),            // 57: protocol.chat.v1.SetPermissionsRequest
+	(*GetPermissionsRequest)(nil),            // 58: protocol.chat.v1.GetPermissionsRequest
+	(*CreateAutomodRuleRequest)(nil),         // 59: protocol.chat.v1.CreateAutomodRuleRequest
+	(*UpdateAutomodRuleRequest)(nil),         // 60: protocol.chat.v1.UpdateAutomodRuleRequest
+	(*DeleteAutomodRuleRequest)(nil),         // 61: protocol.chat.v1.DeleteAutomodRuleRequest
+	(*GetAutomodRulesRequest)(nil),           // 62: protocol.chat.v1.GetAutomodRulesRequest
+	(*MoveRoleRequest)(nil),                  // 63: protocol.chat.v1.MoveRoleRequest
+	(*GetGuildRolesRequest)(nil),             // 64: protocol.chat.v1.GetGuildRolesRequest
+	(*AddGuildRoleRequest)(nil),              // 65: protocol.chat.v1.AddGuildRoleRequest
+	(*ModifyGuildRoleRequest)(nil),           // 66: protocol.chat.v1.ModifyGuildRoleRequest
+	(*DeleteGuildRoleRequest)(nil),           // 67: protocol.chat.v1.DeleteGuildRoleRequest
+	(*ManageUserRolesRequest)(nil),           // 68: protocol.chat.v1.ManageUserRolesRequest
+	(*GetUserRolesRequest)(nil),              // 69: protocol.chat.v1.GetUserRolesRequest
+	(*StreamEventsRequest)(nil),              // 70: protocol.chat.v1.StreamEventsRequest
+	(*GetUserRequest)(nil),                   // 71: protocol.chat.v1.GetUserRequest
+	(*GetUserMetadataRequest)(nil),           // 72: protocol.chat.v1.GetUserMetadataRequest
+	(*ProfileUpdateRequest)(nil),             // 73: protocol.chat.v1.ProfileUpdateRequest
+	(*CreateGuildResponse)(nil),              // 74: protocol.chat.v1.CreateGuildResponse
+	(*CreateInviteResponse)(nil),             // 75: protocol.chat.v1.CreateInviteResponse
+	(*CreateChannelResponse)(nil),            // 76: protocol.chat.v1.CreateChannelResponse
+	(*CreateEmotePackResponse)(nil),          // 77: protocol.chat.v1.CreateEmotePackResponse
+	(*GetGuildListResponse)(nil),             // 78: protocol.chat.v1.GetGuildListResponse
+	(*AddGuildToGuildListResponse)(nil),      // 79: protocol.chat.v1.AddGuildToGuildListResponse
+	(*RemoveGuildFromGuildListResponse)(nil), // 80: protocol.chat.v1.RemoveGuildFromGuildListResponse
+	(*GetGuildResponse)(nil),                 // 81: protocol.chat.v1.GetGuildResponse
+	(*GetGuildInvitesResponse)(nil),          // 82: protocol.chat.v1.GetGuildInvitesResponse
+	(*GetGuildMembersResponse)(nil),          // 83: protocol.chat.v1.GetGuildMembersResponse
+	(*GetGuildChannelsResponse)(nil),         // 84: protocol.chat.v1.GetGuildChannelsResponse
+	(*GetChannelMessagesResponse)(nil),       // 85: protocol.chat.v1.GetChannelMessagesResponse
+	(*GetMessageResponse)(nil),               // 86: protocol.chat.v1.GetMessageResponse
+	(*SearchMessagesResponse)(nil),           // 87: protocol.chat.v1.SearchMessagesResponse
+	(*GetMentionsResponse)(nil),              // 88: protocol.chat.v1.GetMentionsResponse
+	(*GetThreadMessagesResponse)(nil),        // 89: protocol.chat.v1.GetThreadMessagesResponse
+	(*GetActiveThreadsResponse)(nil),         // 90: protocol.chat.v1.GetActiveThreadsResponse
+	(*GetMessageHistoryResponse)(nil),        // 91: protocol.chat.v1.GetMessageHistoryResponse
+	(*GetPinnedMessagesResponse)(nil),        // 92: protocol.chat.v1.GetPinnedMessagesResponse
+	(*GetEmotePacksResponse)(nil),            // 93: protocol.chat.v1.GetEmotePacksResponse
+	(*GetEmotePackEmotesResponse)(nil),       // 94: protocol.chat.v1.GetEmotePackEmotesResponse
+	(*empty.Empty)(nil),                      // 95: google.protobuf.Empty
+	(*GetRetentionResponse)(nil),             // 96: protocol.chat.v1.GetRetentionResponse
+	(*GetDeletedMessagesResponse)(nil),       // 97: protocol.chat.v1.GetDeletedMessagesResponse
+	(*BulkDeleteMessagesResponse)(nil),       // 98: protocol.chat.v1.BulkDeleteMessagesResponse
+	(*GetReadStatesResponse)(nil),            // 99: protocol.chat.v1.GetReadStatesResponse
+	(*JoinGuildResponse)(nil),                // 100: protocol.chat.v1.JoinGuildResponse
+	(*SendMessageResponse)(nil),              // 101: protocol.chat.v1.SendMessageResponse
+	(*ScheduleMessageResponse)(nil),          // 102: protocol.chat.v1.ScheduleMessageResponse
+	(*GetScheduledMessagesResponse)(nil),     // 103: protocol.chat.v1.GetScheduledMessagesResponse
+	(*CreateThreadResponse)(nil),             // 104: protocol.chat.v1.CreateThreadResponse
+	(*QueryPermissionsResponse)(nil),         // 105: protocol.chat.v1.QueryPermissionsResponse
+	(*GetPermissionsResponse)(nil),           // 106: protocol.chat.v1.GetPermissionsResponse
+	(*CreateAutomodRuleResponse)(nil),        // 107: protocol.chat.v1.CreateAutomodRuleResponse
+	(*GetAutomodRulesResponse)(nil),          // 108: protocol.chat.v1.GetAutomodRulesResponse
+	(*MoveRoleResponse)(nil),                 // 109: protocol.chat.v1.MoveRoleResponse
+	(*GetGuildRolesResponse)(nil),            // 110: protocol.chat.v1.GetGuildRolesResponse
+	(*AddGuildRoleResponse)(nil),             // 111: protocol.chat.v1.AddGuildRoleResponse
+	(*GetUserRolesResponse)(nil),             // 112: protocol.chat.v1.GetUserRolesResponse
+	(*Event)(nil),                            // 113: protocol.chat.v1.Event
+	(*GetUserResponse)(nil),                  // 114: protocol.chat.v1.GetUserResponse
+	(*GetUserMetadataResponse)(nil),          // 115: protocol.chat.v1.GetUserMetadataResponse
 }
 var file_chat_v1_chat_proto_depIdxs = []int32{
-	0,  // 0: protocol.chat.v1.ChatService.CreateGuild:input_type -> protocol.chat.v1.CreateGuildRequest
-	1,  // 1: protocol.chat.v1.ChatService.CreateInvite:input_type -> protocol.chat.v1.CreateInviteRequest
-	2,  // 2: protocol.chat.v1.ChatService.CreateChannel:input_type -> protocol.chat.v1.CreateChannelRequest
-	3,  // 3: protocol.chat.v1.ChatService.CreateEmotePack:input_type -> protocol.chat.v1.CreateEmotePackRequest
-	4,  // 4: protocol.chat.v1.ChatService.GetGuildList:input_type -> protocol.chat.v1.GetGuildListRequest
-	5,  // 5: protocol.chat.v1.ChatService.AddGuildToGuildList:input_type -> protocol.chat.v1.AddGuildToGuildListRequest
-	6,  // 6: protocol.chat.v1.ChatService.RemoveGuildFromGuildList:input_type -> protocol.chat.v1.RemoveGuildFromGuildListRequest
-	7,  // 7: protocol.chat.v1.ChatService.GetGuild:input_type -> protocol.chat.v1.GetGuildRequest
-	8,  // 8: protocol.chat.v1.ChatService.GetGuildInvites:input_type -> protocol.chat.v1.GetGuildInvitesRequest
-	9,  // 9: protocol.chat.v1.ChatService.GetGuildMembers:input_type -> protocol.chat.v1.GetGuildMembersRequest
-	10, // 10: protocol.chat.v1.ChatService.GetGuildChannels:input_type -> protocol.chat.v1.GetGuildChannelsRequest
-	11, // 11: protocol.chat.v1.ChatService.GetChannelMessages:input_type -> protocol.chat.v1.GetChannelMessagesRequest
-	12, // 12: protocol.chat.v1.ChatService.GetMessage:input_type -> protocol.chat.v1.GetMessageRequest
-	13, // 13: protocol.chat.v1.ChatService.GetEmotePacks:input_type -> protocol.chat.v1.GetEmotePacksRequest
-	14, // 14: protocol.chat.v1.ChatService.GetEmotePackEmotes:input_type -> protocol.chat.v1.GetEmotePackEmotesRequest
-	15, // 15: protocol.chat.v1.ChatService.UpdateGuildName:input_type -> protocol.chat.v1.UpdateGuildNameRequest
-	16, // 16: protocol.chat.v1.ChatService.UpdateChannelName:input_type -> protocol.chat.v1.UpdateChannelNameRequest
-	17, // 17: protocol.chat.v1.ChatService.UpdateChannelOrder:input_type -> protocol.chat.v1.UpdateChannelOrderRequest
-	18, // 18: protocol.chat.v1.ChatService.UpdateMessage:input_type -> protocol.chat.v1.UpdateMessageRequest
-	19, // 19: protocol.chat.v1.ChatService.AddEmoteToPack:input_type -> protocol.chat.v1.AddEmoteToPackRequest
-	20, // 20: protocol.chat.v1.ChatService.DeleteGuild:input_type -> protocol.chat.v1.DeleteGuildRequest
-	21, // 21: protocol.chat.v1.ChatService.DeleteInvite:input_type -> protocol.chat.v1.DeleteInviteRequest
-	22, // 22: protocol.chat.v1.ChatService.DeleteChannel:input_type -> protocol.chat.v1.DeleteChannelRequest
-	23, // 23: protocol.chat.v1.ChatService.DeleteMessage:input_type -> protocol.chat.v1.DeleteMessageRequest
-	24, // 24: protocol.chat.v1.ChatService.DeleteEmoteFromPack:input_type -> protocol.chat.v1.DeleteEmoteFromPackRequest
-	25, // 25: protocol.chat.v1.ChatService.DeleteEmotePack:input_type -> protocol.chat.v1.DeleteEmotePackRequest
-	26, // 26: protocol.chat.v1.ChatService.DequipEmotePack:input_type -> protocol.chat.v1.DequipEmotePackRequest
-	27, // 27: protocol.chat.v1.ChatService.JoinGuild:input_type -> protocol.chat.v1.JoinGuildRequest
-	28, // 28: protocol.chat.v1.ChatService.LeaveGuild:input_type -> protocol.chat.v1.LeaveGuildRequest
-	29, // 29: protocol.chat.v1.ChatService.TriggerAction:input_type -> protocol.chat.v1.TriggerActionRequest
-	30, // 30: protocol.chat.v1.ChatService.SendMessage:input_type -> protocol.chat.v1.SendMessageRequest
-	31, // 31: protocol.chat.v1.ChatService.QueryHasPermission:input_type -> protocol.chat.v1.QueryPermissionsRequest
-	32, // 32: protocol.chat.v1.ChatService.SetPermissions:input_type -> protocol.chat.v1.SetPermissionsRequest
-	33, // 33: protocol.chat.v1.ChatService.GetPermissions:input_type -> protocol.chat.v1.GetPermissionsRequest
-	34, // 34: protocol.chat.v1.ChatService.MoveRole:input_type -> protocol.chat.v1.MoveRoleRequest
-	35, // 35: protocol.chat.v1.ChatService.GetGuildRoles:input_type -> protocol.chat.v1.GetGuildRolesRequest
-	36, // 36: protocol.chat.v1.ChatService.AddGuildRole:input_type -> protocol.chat.v1.AddGuildRoleRequest
-	37, // 37: protocol.chat.v1.ChatService.ModifyGuildRole:input_type -> protocol.chat.v1.ModifyGuildRoleRequest
-	38, // 38: protocol.chat.v1.ChatService.DeleteGuildRole:input_type -> protocol.chat.v1.DeleteGuildRoleRequest
-	39, // 39: protocol.chat.v1.ChatService.ManageUserRoles:input_type -> protocol.chat.v1.ManageUserRolesRequest
-	40, // 40: protocol.chat.v1.ChatService.GetUserRoles:input_type -> protocol.chat.v1.GetUserRolesRequest
-	41, // 41: protocol.chat.v1.ChatService.StreamEvents:input_type -> protocol.chat.v1.StreamEventsRequest
-	42, // 42: protocol.chat.v1.ChatService.GetUser:input_type -> protocol.chat.v1.GetUserRequest
-	43, // 43: protocol.chat.v1.ChatService.GetUserMetadata:input_type -> protocol.chat.v1.GetUserMetadataRequest
-	44, // 44: protocol.chat.v1.ChatService.ProfileUpdate:input_type -> protocol.chat.v1.ProfileUpdateRequest
-	45, // 45: protocol.chat.v1.ChatService.CreateGuild:output_type -> protocol.chat.v1.CreateGuildResponse
-	46, // 46: protocol.chat.v1.ChatService.CreateInvite:output_type -> protocol.chat.v1.CreateInviteResponse
-	47, // 47: protocol.chat.v1.ChatService.CreateChannel:output_type -> protocol.chat.v1.CreateChannelResponse
-	48, // 48: protocol.chat.v1.ChatService.CreateEmotePack:output_type -> protocol.chat.v1.CreateEmotePackResponse
-	49, // 49: protocol.chat.v1.ChatService.GetGuildList:output_type -> protocol.chat.v1.GetGuildListResponse
-	50, // 50: protocol.chat.v1.ChatService.AddGuildToGuildList:output_type -> protocol.chat.v1.AddGuildToGuildListResponse
-	51, // 51: protocol.chat.v1.ChatService.RemoveGuildFromGuildList:output_type -> protocol.chat.v1.RemoveGuildFromGuildListResponse
-	52, // 52: protocol.chat.v1.ChatService.GetGuild:output_type -> protocol.chat.v1.GetGuildResponse
-	53, // 53: protocol.chat.v1.ChatService.GetGuildInvites:output_type -> protocol.chat.v1.GetGuildInvitesResponse
-	54, // 54: protocol.chat.v1.ChatService.GetGuildMembers:output_type -> protocol.chat.v1.GetGuildMembersResponse
-	55, // 55: protocol.chat.v1.ChatService.GetGuildChannels:output_type -> protocol.chat.v1.GetGuildChannelsResponse
-	56, // 56: protocol.chat.v1.ChatService.GetChannelMessages:output_type -> protocol.chat.v1.GetChannelMessagesResponse
-	57, // 57: protocol.chat.v1.ChatService.GetMessage:output_type -> protocol.chat.v1.GetMessageResponse
-	58, // 58: protocol.chat.v1.ChatService.GetEmotePacks:output_type -> protocol.chat.v1.GetEmotePacksResponse
-	59, // 59: protocol.chat.v1.ChatService.GetEmotePackEmotes:output_type -> protocol.chat.v1.GetEmotePackEmotesResponse
-	60, // 60: protocol.chat.v1.ChatService.UpdateGuildName:output_type -> google.protobuf.Empty
-	60, // 61: protocol.chat.v1.ChatService.UpdateChannelName:output_type -> google.protobuf.Empty
-	60, // 62: protocol.chat.v1.ChatService.UpdateChannelOrder:output_type -> google.protobuf.Empty
-	60, // 63: protocol.chat.v1.ChatService.UpdateMessage:output_type -> google.protobuf.Empty
-	60, // 64: protocol.chat.v1.ChatService.AddEmoteToPack:output_type -> google.protobuf.Empty
-	60, // 65: protocol.chat.v1.ChatService.DeleteGuild:output_type -> google.protobuf.Empty
-	60, // 66: protocol.chat.v1.ChatService.DeleteInvite:output_type -> google.protobuf.Empty
-	60, // 67: protocol.chat.v1.ChatService.DeleteChannel:output_type -> google.protobuf.Empty
-	60, // 68: protocol.chat.v1.ChatService.DeleteMessage:output_type -> google.protobuf.Empty
-	60, // 69: protocol.chat.v1.ChatService.DeleteEmoteFromPack:output_type -> google.protobuf.Empty
-	60, // 70: protocol.chat.v1.ChatService.DeleteEmotePack:output_type -> google.protobuf.Empty
-	60, // 71: protocol.chat.v1.ChatService.DequipEmotePack:output_type -> google.protobuf.Empty
-	61, // 72: protocol.chat.v1.ChatService.JoinGuild:output_type -> protocol.chat.v1.JoinGuildResponse
-	60, // 73: protocol.chat.v1.ChatService.LeaveGuild:output_type -> google.protobuf.Empty
-	60, // 74: protocol.chat.v1.ChatService.TriggerAction:output_type -> google.protobuf.Empty
-	62, // 75: protocol.chat.v1.ChatService.SendMessage:output_type -> protocol.chat.v1.SendMessageResponse
-	63, // 76: protocol.chat.v1.ChatService.QueryHasPermission:output_type -> protocol.chat.v1.QueryPermissionsResponse
-	60, // 77: protocol.chat.v1.ChatService.SetPermissions:output_type -> google.protobuf.Empty
-	64, // 78: protocol.chat.v1.ChatService.GetPermissions:output_type -> protocol.chat.v1.GetPermissionsResponse
-	65, // 79: protocol.chat.v1.ChatService.MoveRole:output_type -> protocol.chat.v1.MoveRoleResponse
-	66, // 80: protocol.chat.v1.ChatService.GetGuildRoles:output_type -> protocol.chat.v1.GetGuildRolesResponse
-	67, // 81: protocol.chat.v1.ChatService.AddGuildRole:output_type -> protocol.chat.v1.AddGuildRoleResponse
-	60, // 82: protocol.chat.v1.ChatService.ModifyGuildRole:output_type -> google.protobuf.Empty
-	60, // 83: protocol.chat.v1.ChatService.DeleteGuildRole:output_type -> google.protobuf.Empty
-	60, // 84: protocol.chat.v1.ChatService.ManageUserRoles:output_type -> google.protobuf.Empty
-	68, // 85: protocol.chat.v1.ChatService.GetUserRoles:output_type -> protocol.chat.v1.GetUserRolesResponse
-	69, // 86: protocol.chat.v1.ChatService.StreamEvents:output_type -> protocol.chat.v1.Event
-	70, // 87: protocol.chat.v1.ChatService.GetUser:output_type -> protocol.chat.v1.GetUserResponse
-	71, // 88: protocol.chat.v1.ChatService.GetUserMetadata:output_type -> protocol.chat.v1.GetUserMetadataResponse
-	60, // 89: protocol.chat.v1.ChatService.ProfileUpdate:output_type -> google.protobuf.Empty
-	45, // [45:90] is the sub-list for method output_type
-	0,  // [0:45] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: protocol.chat.v1.ChatService.CreateGuild:input_type -> protocol.chat.v1.CreateGuildRequest
+	1,   // 1: protocol.chat.v1.ChatService.CreateInvite:input_type -> protocol.chat.v1.CreateInviteRequest
+	2,   // 2: protocol.chat.v1.ChatService.CreateChannel:input_type -> protocol.chat.v1.CreateChannelRequest
+	3,   // 3: protocol.chat.v1.ChatService.CreateEmotePack:input_type -> protocol.chat.v1.CreateEmotePackRequest
+	4,   // 4: protocol.chat.v1.ChatService.GetGuildList:input_type -> protocol.chat.v1.GetGuildListRequest
+	5,   // 5: protocol.chat.v1.ChatService.AddGuildToGuildList:input_type -> protocol.chat.v1.AddGuildToGuildListRequest
+	6,   // 6: protocol.chat.v1.ChatService.RemoveGuildFromGuildList:input_type -> protocol.chat.v1.RemoveGuildFromGuildListRequest
+	7,   // 7: protocol.chat.v1.ChatService.GetGuild:input_type -> protocol.chat.v1.GetGuildRequest
+	8,   // 8: protocol.chat.v1.ChatService.GetGuildInvites:input_type -> protocol.chat.v1.GetGuildInvitesRequest
+	9,   // 9: protocol.chat.v1.ChatService.GetGuildMembers:input_type -> protocol.chat.v1.GetGuildMembersRequest
+	10,  // 10: protocol.chat.v1.ChatService.GetGuildChannels:input_type -> protocol.chat.v1.GetGuildChannelsRequest
+	11,  // 11: protocol.chat.v1.ChatService.GetChannelMessages:input_type -> protocol.chat.v1.GetChannelMessagesRequest
+	12,  // 12: protocol.chat.v1.ChatService.GetMessage:input_type -> protocol.chat.v1.GetMessageRequest
+	13,  // 13: protocol.chat.v1.ChatService.SearchMessages:input_type -> protocol.chat.v1.SearchMessagesRequest
+	14,  // 14: protocol.chat.v1.ChatService.GetMentions:input_type -> protocol.chat.v1.GetMentionsRequest
+	15,  // 15: protocol.chat.v1.ChatService.GetThreadMessages:input_type -> protocol.chat.v1.GetThreadMessagesRequest
+	16,  // 16: protocol.chat.v1.ChatService.GetActiveThreads:input_type -> protocol.chat.v1.GetActiveThreadsRequest
+	17,  // 17: protocol.chat.v1.ChatService.GetMessageHistory:input_type -> protocol.chat.v1.GetMessageHistoryRequest
+	18,  // 18: protocol.chat.v1.ChatService.GetPinnedMessages:input_type -> protocol.chat.v1.GetPinnedMessagesRequest
+	19,  // 19: protocol.chat.v1.ChatService.GetEmotePacks:input_type -> protocol.chat.v1.GetEmotePacksRequest
+	20,  // 20: protocol.chat.v1.ChatService.GetEmotePackEmotes:input_type -> protocol.chat.v1.GetEmotePackEmotesRequest
+	21,  // 21: protocol.chat.v1.ChatService.UpdateGuildName:input_type -> protocol.chat.v1.UpdateGuildNameRequest
+	22,  // 22: protocol.chat.v1.ChatService.SetGuildRetention:input_type -> protocol.chat.v1.SetGuildRetentionRequest
+	23,  // 23: protocol.chat.v1.ChatService.SetChannelRetention:input_type -> protocol.chat.v1.SetChannelRetentionRequest
+	24,  // 24: protocol.chat.v1.ChatService.GetRetention:input_type -> protocol.chat.v1.GetRetentionRequest
+	25,  // 25: protocol.chat.v1.ChatService.UpdateChannelName:input_type -> protocol.chat.v1.UpdateChannelNameRequest
+	26,  // 26: protocol.chat.v1.ChatService.SetChannelSlowMode:input_type -> protocol.chat.v1.SetChannelSlowModeRequest
+	27,  // 27: protocol.chat.v1.ChatService.UpdateChannelOrder:input_type -> protocol.chat.v1.UpdateChannelOrderRequest
+	28,  // 28: protocol.chat.v1.ChatService.UpdateMessage:input_type -> protocol.chat.v1.UpdateMessageRequest
+	29,  // 29: protocol.chat.v1.ChatService.AddEmoteToPack:input_type -> protocol.chat.v1.AddEmoteToPackRequest
+	30,  // 30: protocol.chat.v1.ChatService.DeleteGuild:input_type -> protocol.chat.v1.DeleteGuildRequest
+	31,  // 31: protocol.chat.v1.ChatService.DeleteInvite:input_type -> protocol.chat.v1.DeleteInviteRequest
+	32,  // 32: protocol.chat.v1.ChatService.DeleteChannel:input_type -> protocol.chat.v1.DeleteChannelRequest
+	33,  // 33: protocol.chat.v1.ChatService.DeleteMessage:input_type -> protocol.chat.v1.DeleteMessageRequest
+	34,  // 34: protocol.chat.v1.ChatService.RestoreMessage:input_type -> protocol.chat.v1.RestoreMessageRequest
+	35,  // 35: protocol.chat.v1.ChatService.GetDeletedMessages:input_type -> protocol.chat.v1.GetDeletedMessagesRequest
+	36,  // 36: protocol.chat.v1.ChatService.BulkDeleteMessages:input_type -> protocol.chat.v1.BulkDeleteMessagesRequest
+	37,  // 37: protocol.chat.v1.ChatService.AckChannel:input_type -> protocol.chat.v1.AckChannelRequest
+	38,  // 38: protocol.chat.v1.ChatService.GetReadStates:input_type -> protocol.chat.v1.GetReadStatesRequest
+	39,  // 39: protocol.chat.v1.ChatService.PinMessage:input_type -> protocol.chat.v1.PinMessageRequest
+	40,  // 40: protocol.chat.v1.ChatService.UnpinMessage:input_type -> protocol.chat.v1.UnpinMessageRequest
+	41,  // 41: protocol.chat.v1.ChatService.AddReaction:input_type -> protocol.chat.v1.AddReactionRequest
+	42,  // 42: protocol.chat.v1.ChatService.RemoveReaction:input_type -> protocol.chat.v1.RemoveReactionRequest
+	43,  // 43: protocol.chat.v1.ChatService.DeleteEmoteFromPack:input_type -> protocol.chat.v1.DeleteEmoteFromPackRequest
+	44,  // 44: protocol.chat.v1.ChatService.DeleteEmotePack:input_type -> protocol.chat.v1.DeleteEmotePackRequest
+	45,  // 45: protocol.chat.v1.ChatService.DequipEmotePack:input_type -> protocol.chat.v1.DequipEmotePackRequest
+	46,  // 46: protocol.chat.v1.ChatService.JoinGuild:input_type -> protocol.chat.v1.JoinGuildRequest
+	47,  // 47: protocol.chat.v1.ChatService.LeaveGuild:input_type -> protocol.chat.v1.LeaveGuildRequest
+	48,  // 48: protocol.chat.v1.ChatService.TriggerAction:input_type -> protocol.chat.v1.TriggerActionRequest
+	49,  // 49: protocol.chat.v1.ChatService.SendMessage:input_type -> protocol.chat.v1.SendMessageRequest
+	50,  // 50: protocol.chat.v1.ChatService.ScheduleMessage:input_type -> protocol.chat.v1.ScheduleMessageRequest
+	51,  // 51: protocol.chat.v1.ChatService.GetScheduledMessages:input_type -> protocol.chat.v1.GetScheduledMessagesRequest
+	52,  // 52: protocol.chat.v1.ChatService.UpdateScheduledMessage:input_type -> protocol.chat.v1.UpdateScheduledMessageRequest
+	53,  // 53: protocol.chat.v1.ChatService.CancelScheduledMessage:input_type -> protocol.chat.v1.CancelScheduledMessageRequest
+	54,  // 54: protocol.chat.v1.ChatService.CreateThread:input_type -> protocol.chat.v1.CreateThreadRequest
+	55,  // 55: protocol.chat.v1.ChatService.Typing:input_type -> protocol.chat.v1.TypingRequest
+	56,  // 56: protocol.chat.v1.ChatService.QueryHasPermission:input_type -> protocol.chat.v1.QueryPermissionsRequest
+	57,  // 57: protocol.chat.v1.ChatService.SetPermissions:input_type -> protocol.chat.v1.SetPermissionsRequest
+	58,  // 58: protocol.chat.v1.ChatService.GetPermissions:input_type -> protocol.chat.v1.GetPermissionsRequest
+	59,  // 59: protocol.chat.v1.ChatService.CreateAutomodRule:input_type -> protocol.chat.v1.CreateAutomodRuleRequest
+	60,  // 60: protocol.chat.v1.ChatService.UpdateAutomodRule:input_type -> protocol.chat.v1.UpdateAutomodRuleRequest
+	61,  // 61: protocol.chat.v1.ChatService.DeleteAutomodRule:input_type -> protocol.chat.v1.DeleteAutomodRuleRequest
+	62,  // 62: protocol.chat.v1.ChatService.GetAutomodRules:input_type -> protocol.chat.v1.GetAutomodRulesRequest
+	63,  // 63: protocol.chat.v1.ChatService.MoveRole:input_type -> protocol.chat.v1.MoveRoleRequest
+	64,  // 64: protocol.chat.v1.ChatService.GetGuildRoles:input_type -> protocol.chat.v1.GetGuildRolesRequest
+	65,  // 65: protocol.chat.v1.ChatService.AddGuildRole:input_type -> protocol.chat.v1.AddGuildRoleRequest
+	66,  // 66: protocol.chat.v1.ChatService.ModifyGuildRole:input_type -> protocol.chat.v1.ModifyGuildRoleRequest
+	67,  // 67: protocol.chat.v1.ChatService.DeleteGuildRole:input_type -> protocol.chat.v1.DeleteGuildRoleRequest
+	68,  // 68: protocol.chat.v1.ChatService.ManageUserRoles:input_type -> protocol.chat.v1.ManageUserRolesRequest
+	69,  // 69: protocol.chat.v1.ChatService.GetUserRoles:input_type -> protocol.chat.v1.GetUserRolesRequest
+	70,  // 70: protocol.chat.v1.ChatService.StreamEvents:input_type -> protocol.chat.v1.StreamEventsRequest
+	71,  // 71: protocol.chat.v1.ChatService.GetUser:input_type -> protocol.chat.v1.GetUserRequest
+	72,  // 72: protocol.chat.v1.ChatService.GetUserMetadata:input_type -> protocol.chat.v1.GetUserMetadataRequest
+	73,  // 73: protocol.chat.v1.ChatService.ProfileUpdate:input_type -> protocol.chat.v1.ProfileUpdateRequest
+	74,  // 74: protocol.chat.v1.ChatService.CreateGuild:output_type -> protocol.chat.v1.CreateGuildResponse
+	75,  // 75: protocol.chat.v1.ChatService.CreateInvite:output_type -> protocol.chat.v1.CreateInviteResponse
+	76,  // 76: protocol.chat.v1.ChatService.CreateChannel:output_type -> protocol.chat.v1.CreateChannelResponse
+	77,  // 77: protocol.chat.v1.ChatService.CreateEmotePack:output_type -> protocol.chat.v1.CreateEmotePackResponse
+	78,  // 78: protocol.chat.v1.ChatService.GetGuildList:output_type -> protocol.chat.v1.GetGuildListResponse
+	79,  // 79: protocol.chat.v1.ChatService.AddGuildToGuildList:output_type -> protocol.chat.v1.AddGuildToGuildListResponse
+	80,  // 80: protocol.chat.v1.ChatService.RemoveGuildFromGuildList:output_type -> protocol.chat.v1.RemoveGuildFromGuildListResponse
+	81,  // 81: protocol.chat.v1.ChatService.GetGuild:output_type -> protocol.chat.v1.GetGuildResponse
+	82,  // 82: protocol.chat.v1.ChatService.GetGuildInvites:output_type -> protocol.chat.v1.GetGuildInvitesResponse
+	83,  // 83: protocol.chat.v1.ChatService.GetGuildMembers:output_type -> protocol.chat.v1.GetGuildMembersResponse
+	84,  // 84: protocol.chat.v1.ChatService.GetGuildChannels:output_type -> protocol.chat.v1.GetGuildChannelsResponse
+	85,  // 85: protocol.chat.v1.ChatService.GetChannelMessages:output_type -> protocol.chat.v1.GetChannelMessagesResponse
+	86,  // 86: protocol.chat.v1.ChatService.GetMessage:output_type -> protocol.chat.v1.GetMessageResponse
+	87,  // 87: protocol.chat.v1.ChatService.SearchMessages:output_type -> protocol.chat.v1.SearchMessagesResponse
+	88,  // 88: protocol.chat.v1.ChatService.GetMentions:output_type -> protocol.chat.v1.GetMentionsResponse
+	89,  // 89: protocol.chat.v1.ChatService.GetThreadMessages:output_type -> protocol.chat.v1.GetThreadMessagesResponse
+	90,  // 90: protocol.chat.v1.ChatService.GetActiveThreads:output_type -> protocol.chat.v1.GetActiveThreadsResponse
+	91,  // 91: protocol.chat.v1.ChatService.GetMessageHistory:output_type -> protocol.chat.v1.GetMessageHistoryResponse
+	92,  // 92: protocol.chat.v1.ChatService.GetPinnedMessages:output_type -> protocol.chat.v1.GetPinnedMessagesResponse
+	93,  // 93: protocol.chat.v1.ChatService.GetEmotePacks:output_type -> protocol.chat.v1.GetEmotePacksResponse
+	94,  // 94: protocol.chat.v1.ChatService.GetEmotePackEmotes:output_type -> protocol.chat.v1.GetEmotePackEmotesResponse
+	95,  // 95: protocol.chat.v1.ChatService.UpdateGuildName:output_type -> google.protobuf.Empty
+	95,  // 96: protocol.chat.v1.ChatService.SetGuildRetention:output_type -> google.protobuf.Empty
+	95,  // 97: protocol.chat.v1.ChatService.SetChannelRetention:output_type -> google.protobuf.Empty
+	96,  // 98: protocol.chat.v1.ChatService.GetRetention:output_type -> protocol.chat.v1.GetRetentionResponse
+	95,  // 99: protocol.chat.v1.ChatService.UpdateChannelName:output_type -> google.protobuf.Empty
+	95,  // 100: protocol.chat.v1.ChatService.SetChannelSlowMode:output_type -> google.protobuf.Empty
+	95,  // 101: protocol.chat.v1.ChatService.UpdateChannelOrder:output_type -> google.protobuf.Empty
+	95,  // 102: protocol.chat.v1.ChatService.UpdateMessage:output_type -> google.protobuf.Empty
+	95,  // 103: protocol.chat.v1.ChatService.AddEmoteToPack:output_type -> google.protobuf.Empty
+	95,  // 104: protocol.chat.v1.ChatService.DeleteGuild:output_type -> google.protobuf.Empty
+	95,  // 105: protocol.chat.v1.ChatService.DeleteInvite:output_type -> google.protobuf.Empty
+	95,  // 106: protocol.chat.v1.ChatService.DeleteChannel:output_type -> google.protobuf.Empty
+	95,  // 107: protocol.chat.v1.ChatService.DeleteMessage:output_type -> google.protobuf.Empty
+	95,  // 108: protocol.chat.v1.ChatService.RestoreMessage:output_type -> google.protobuf.Empty
+	97,  // 109: protocol.chat.v1.ChatService.GetDeletedMessages:output_type -> protocol.chat.v1.GetDeletedMessagesResponse
+	98,  // 110: protocol.chat.v1.ChatService.BulkDeleteMessages:output_type -> protocol.chat.v1.BulkDeleteMessagesResponse
+	95,  // 111: protocol.chat.v1.ChatService.AckChannel:output_type -> google.protobuf.Empty
+	99,  // 112: protocol.chat.v1.ChatService.GetReadStates:output_type -> protocol.chat.v1.GetReadStatesResponse
+	95,  // 113: protocol.chat.v1.ChatService.PinMessage:output_type -> google.protobuf.Empty
+	95,  // 114: protocol.chat.v1.ChatService.UnpinMessage:output_type -> google.protobuf.Empty
+	95,  // 115: protocol.chat.v1.ChatService.AddReaction:output_type -> google.protobuf.Empty
+	95,  // 116: protocol.chat.v1.ChatService.RemoveReaction:output_type -> google.protobuf.Empty
+	95,  // 117: protocol.chat.v1.ChatService.DeleteEmoteFromPack:output_type -> google.protobuf.Empty
+	95,  // 118: protocol.chat.v1.ChatService.DeleteEmotePack:output_type -> google.protobuf.Empty
+	95,  // 119: protocol.chat.v1.ChatService.DequipEmotePack:output_type -> google.protobuf.Empty
+	100, // 120: protocol.chat.v1.ChatService.JoinGuild:output_type -> protocol.chat.v1.JoinGuildResponse
+	95,  // 121: protocol.chat.v1.ChatService.LeaveGuild:output_type -> google.protobuf.Empty
+	95,  // 122: protocol.chat.v1.ChatService.TriggerAction:output_type -> google.protobuf.Empty
+	101, // 123: protocol.chat.v1.ChatService.SendMessage:output_type -> protocol.chat.v1.SendMessageResponse
+	102, // 124: protocol.chat.v1.ChatService.ScheduleMessage:output_type -> protocol.chat.v1.ScheduleMessageResponse
+	103, // 125: protocol.chat.v1.ChatService.GetScheduledMessages:output_type -> protocol.chat.v1.GetScheduledMessagesResponse
+	95,  // 126: protocol.chat.v1.ChatService.UpdateScheduledMessage:output_type -> google.protobuf.Empty
+	95,  // 127: protocol.chat.v1.ChatService.CancelScheduledMessage:output_type -> google.protobuf.Empty
+	104, // 128: protocol.chat.v1.ChatService.CreateThread:output_type -> protocol.chat.v1.CreateThreadResponse
+	95,  // 129: protocol.chat.v1.ChatService.Typing:output_type -> google.protobuf.Empty
+	105, // 130: protocol.chat.v1.ChatService.QueryHasPermission:output_type -> protocol.chat.v1.QueryPermissionsResponse
+	95,  // 131: protocol.chat.v1.ChatService.SetPermissions:output_type -> google.protobuf.Empty
+	106, // 132: protocol.chat.v1.ChatService.GetPermissions:output_type -> protocol.chat.v1.GetPermissionsResponse
+	107, // 133: protocol.chat.v1.ChatService.CreateAutomodRule:output_type -> protocol.chat.v1.CreateAutomodRuleResponse
+	95,  // 134: protocol.chat.v1.ChatService.UpdateAutomodRule:output_type -> google.protobuf.Empty
+	95,  // 135: protocol.chat.v1.ChatService.DeleteAutomodRule:output_type -> google.protobuf.Empty
+	108, // 136: protocol.chat.v1.ChatService.GetAutomodRules:output_type -> protocol.chat.v1.GetAutomodRulesResponse
+	109, // 137: protocol.chat.v1.ChatService.MoveRole:output_type -> protocol.chat.v1.MoveRoleResponse
+	110, // 138: protocol.chat.v1.ChatService.GetGuildRoles:output_type -> protocol.chat.v1.GetGuildRolesResponse
+	111, // 139: protocol.chat.v1.ChatService.AddGuildRole:output_type -> protocol.chat.v1.AddGuildRoleResponse
+	95,  // 140: protocol.chat.v1.ChatService.ModifyGuildRole:output_type -> google.protobuf.Empty
+	95,  // 141: protocol.chat.v1.ChatService.DeleteGuildRole:output_type -> google.protobuf.Empty
+	95,  // 142: protocol.chat.v1.ChatService.ManageUserRoles:output_type -> google.protobuf.Empty
+	112, // 143: protocol.chat.v1.ChatService.GetUserRoles:output_type -> protocol.chat.v1.GetUserRolesResponse
+	113, // 144: protocol.chat.v1.ChatService.StreamEvents:output_type -> protocol.chat.v1.Event
+	114, // 145: protocol.chat.v1.ChatService.GetUser:output_type -> protocol.chat.v1.GetUserResponse
+	115, // 146: protocol.chat.v1.ChatService.GetUserMetadata:output_type -> protocol.chat.v1.GetUserMetadataResponse
+	95,  // 147: protocol.chat.v1.ChatService.ProfileUpdate:output_type -> google.protobuf.Empty
+	74,  // [74:148] is the sub-list for method output_type
+	0,   // [0:74] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_chat_v1_chat_proto_init() }
@@ -506,6 +786,7 @@ func file_chat_v1_chat_proto_init() {
 	file_chat_v1_emotes_proto_init()
 	file_chat_v1_permissions_proto_init()
 	file_chat_v1_streaming_proto_init()
+	file_chat_v1_automod_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -558,12 +839,33 @@ type ChatServiceClient interface {
 	GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error)
 	// This requires the "messages.view" permission.
 	GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*GetMessageResponse, error)
+	// Messages in channels without the "messages.view" permission are left
+	// out of the results.
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// Lists the latest messages mentioning you across guilds. Messages in
+	// channels without the "messages.view" permission are left out.
+	GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetMentionsResponse, error)
+	// This requires the "messages.view" permission.
+	GetThreadMessages(ctx context.Context, in *GetThreadMessagesRequest, opts ...grpc.CallOption) (*GetThreadMessagesResponse, error)
+	// This requires the "messages.view" permission.
+	GetActiveThreads(ctx context.Context, in *GetActiveThreadsRequest, opts ...grpc.CallOption) (*GetActiveThreadsResponse, error)
+	// This requires the "messages.history.view" permission.
+	GetMessageHistory(ctx context.Context, in *GetMessageHistoryRequest, opts ...grpc.CallOption) (*GetMessageHistoryResponse, error)
+	// This requires the "messages.view" permission.
+	GetPinnedMessages(ctx context.Context, in *GetPinnedMessagesRequest, opts ...grpc.CallOption) (*GetPinnedMessagesResponse, error)
 	GetEmotePacks(ctx context.Context, in *GetEmotePacksRequest, opts ...grpc.CallOption) (*GetEmotePacksResponse, error)
 	GetEmotePackEmotes(ctx context.Context, in *GetEmotePackEmotesRequest, opts ...grpc.CallOption) (*GetEmotePackEmotesResponse, error)
 	// This requires the "guild.manage.change-name" permission.
 	UpdateGuildName(ctx context.Context, in *UpdateGuildNameRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "guild.manage.retention" permission.
+	SetGuildRetention(ctx context.Context, in *SetGuildRetentionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "channels.manage.retention" permission.
+	SetChannelRetention(ctx context.Context, in *SetChannelRetentionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetRetention(ctx context.Context, in *GetRetentionRequest, opts ...grpc.CallOption) (*GetRetentionResponse, error)
 	// This requires the "channels.manage.change-name" permission.
 	UpdateChannelName(ctx context.Context, in *UpdateChannelNameRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "channels.manage.slow-mode" permission.
+	SetChannelSlowMode(ctx context.Context, in *SetChannelSlowModeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// This requires the "channels.manage.move" permission.
 	UpdateChannelOrder(ctx context.Context, in *UpdateChannelOrderRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// This requires the "messages.send" permission.
@@ -576,8 +878,28 @@ type ChatServiceClient interface {
 	// This requires the "channels.manage.delete" permission.
 	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// This requires the "messages.manage.delete" permission if you are not the
-	// message author.
+	// message author. Deleted messages can be restored until they are purged.
 	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "messages.manage.restore" permission.
+	RestoreMessage(ctx context.Context, in *RestoreMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "messages.manage.restore" permission.
+	GetDeletedMessages(ctx context.Context, in *GetDeletedMessagesRequest, opts ...grpc.CallOption) (*GetDeletedMessagesResponse, error)
+	// This requires the "messages.manage.bulk-delete" permission.
+	BulkDeleteMessages(ctx context.Context, in *BulkDeleteMessagesRequest, opts ...grpc.CallOption) (*BulkDeleteMessagesResponse, error)
+	// This requires the "messages.view" permission. Your other devices are
+	// told about the new read marker with a homeserver event.
+	AckChannel(ctx context.Context, in *AckChannelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// You will only be given the read state of channels you have the
+	// "messages.view" permission for.
+	GetReadStates(ctx context.Context, in *GetReadStatesRequest, opts ...grpc.CallOption) (*GetReadStatesResponse, error)
+	// This requires the "messages.pins.manage" permission.
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "messages.pins.manage" permission.
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "messages.reactions.add" permission.
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Removes your own reaction from a message.
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	DeleteEmoteFromPack(ctx context.Context, in *DeleteEmoteFromPackRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	DeleteEmotePack(ctx context.Context, in *DeleteEmotePackRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	DequipEmotePack(ctx context.Context, in *DequipEmotePackRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -585,8 +907,22 @@ type ChatServiceClient interface {
 	LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// This requires the "actions.trigger" permission.
 	TriggerAction(ctx context.Context, in *TriggerActionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// This requires the "messages.send" permission.
+	// This requires the "messages.send" permission. In slow mode channels,
+	// sending too soon fails with a RetryInfo detail giving the time left,
+	// unless you have the "messages.slowmode.bypass" permission. Mentions of
+	// roles that aren't pingable and of @everyone require the
+	// "messages.mentions.everyone" permission.
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// This requires the "messages.send" permission.
+	ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error)
+	// Only your own scheduled messages are returned.
+	GetScheduledMessages(ctx context.Context, in *GetScheduledMessagesRequest, opts ...grpc.CallOption) (*GetScheduledMessagesResponse, error)
+	UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "messages.threads.create" permission.
+	CreateThread(ctx context.Context, in *CreateThreadRequest, opts ...grpc.CallOption) (*CreateThreadResponse, error)
+	// This requires the "messages.send" permission.
+	Typing(ctx context.Context, in *TypingRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// This requires the "permissions.query" permission if you specify the As
 	// field.
 	QueryHasPermission(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error)
@@ -594,6 +930,14 @@ type ChatServiceClient interface {
 	SetPermissions(ctx context.Context, in *SetPermissionsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// This requires the "permissions.manage.get" permission.
 	GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
+	// This requires the "automod.manage" permission.
+	CreateAutomodRule(ctx context.Context, in *CreateAutomodRuleRequest, opts ...grpc.CallOption) (*CreateAutomodRuleResponse, error)
+	// This requires the "automod.manage" permission.
+	UpdateAutomodRule(ctx context.Context, in *UpdateAutomodRuleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "automod.manage" permission.
+	DeleteAutomodRule(ctx context.Context, in *DeleteAutomodRuleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// This requires the "automod.manage" permission.
+	GetAutomodRules(ctx context.Context, in *GetAutomodRulesRequest, opts ...grpc.CallOption) (*GetAutomodRulesResponse, error)
 	// This requires the "roles.manage" permission.
 	MoveRole(ctx context.Context, in *MoveRoleRequest, opts ...grpc.CallOption) (*MoveRoleResponse, error)
 	// This requires the "roles.get" permission.
@@ -739,6 +1083,60 @@ func (c *chatServiceClient) GetMessage(ctx context.Context, in *GetMessageReques
 	return out, nil
 }
 
+func (c *chatServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SearchMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetMentionsResponse, error) {
+	out := new(GetMentionsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetMentions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetThreadMessages(ctx context.Context, in *GetThreadMessagesRequest, opts ...grpc.CallOption) (*GetThreadMessagesResponse, error) {
+	out := new(GetThreadMessagesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetThreadMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetActiveThreads(ctx context.Context, in *GetActiveThreadsRequest, opts ...grpc.CallOption) (*GetActiveThreadsResponse, error) {
+	out := new(GetActiveThreadsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetActiveThreads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetMessageHistory(ctx context.Context, in *GetMessageHistoryRequest, opts ...grpc.CallOption) (*GetMessageHistoryResponse, error) {
+	out := new(GetMessageHistoryResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetMessageHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetPinnedMessages(ctx context.Context, in *GetPinnedMessagesRequest, opts ...grpc.CallOption) (*GetPinnedMessagesResponse, error) {
+	out := new(GetPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetPinnedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) GetEmotePacks(ctx context.Context, in *GetEmotePacksRequest, opts ...grpc.CallOption) (*GetEmotePacksResponse, error) {
 	out := new(GetEmotePacksResponse)
 	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetEmotePacks", in, out, opts...)
@@ -766,6 +1164,33 @@ func (c *chatServiceClient) UpdateGuildName(ctx context.Context, in *UpdateGuild
 	return out, nil
 }
 
+func (c *chatServiceClient) SetGuildRetention(ctx context.Context, in *SetGuildRetentionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SetGuildRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetChannelRetention(ctx context.Context, in *SetChannelRetentionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SetChannelRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetRetention(ctx context.Context, in *GetRetentionRequest, opts ...grpc.CallOption) (*GetRetentionResponse, error) {
+	out := new(GetRetentionResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) UpdateChannelName(ctx context.Context, in *UpdateChannelNameRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/UpdateChannelName", in, out, opts...)
@@ -775,6 +1200,15 @@ func (c *chatServiceClient) UpdateChannelName(ctx context.Context, in *UpdateCha
 	return out, nil
 }
 
+func (c *chatServiceClient) SetChannelSlowMode(ctx context.Context, in *SetChannelSlowModeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SetChannelSlowMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) UpdateChannelOrder(ctx context.Context, in *UpdateChannelOrderRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/UpdateChannelOrder", in, out, opts...)
@@ -838,218 +1272,389 @@ func (c *chatServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessage
 	return out, nil
 }
 
-func (c *chatServiceClient) DeleteEmoteFromPack(ctx context.Context, in *DeleteEmoteFromPackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *chatServiceClient) RestoreMessage(ctx context.Context, in *RestoreMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DeleteEmoteFromPack", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/RestoreMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) DeleteEmotePack(ctx context.Context, in *DeleteEmotePackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DeleteEmotePack", in, out, opts...)
+func (c *chatServiceClient) GetDeletedMessages(ctx context.Context, in *GetDeletedMessagesRequest, opts ...grpc.CallOption) (*GetDeletedMessagesResponse, error) {
+	out := new(GetDeletedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetDeletedMessages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) DequipEmotePack(ctx context.Context, in *DequipEmotePackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DequipEmotePack", in, out, opts...)
+func (c *chatServiceClient) BulkDeleteMessages(ctx context.Context, in *BulkDeleteMessagesRequest, opts ...grpc.CallOption) (*BulkDeleteMessagesResponse, error) {
+	out := new(BulkDeleteMessagesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/BulkDeleteMessages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) JoinGuild(ctx context.Context, in *JoinGuildRequest, opts ...grpc.CallOption) (*JoinGuildResponse, error) {
-	out := new(JoinGuildResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/JoinGuild", in, out, opts...)
+func (c *chatServiceClient) AckChannel(ctx context.Context, in *AckChannelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/AckChannel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/LeaveGuild", in, out, opts...)
+func (c *chatServiceClient) GetReadStates(ctx context.Context, in *GetReadStatesRequest, opts ...grpc.CallOption) (*GetReadStatesResponse, error) {
+	out := new(GetReadStatesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetReadStates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) TriggerAction(ctx context.Context, in *TriggerActionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *chatServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/TriggerAction", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/PinMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
-	out := new(SendMessageResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SendMessage", in, out, opts...)
+func (c *chatServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/UnpinMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) QueryHasPermission(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
-	out := new(QueryPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/QueryHasPermission", in, out, opts...)
+func (c *chatServiceClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/AddReaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) SetPermissions(ctx context.Context, in *SetPermissionsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *chatServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SetPermissions", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/RemoveReaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
-	out := new(GetPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetPermissions", in, out, opts...)
+func (c *chatServiceClient) DeleteEmoteFromPack(ctx context.Context, in *DeleteEmoteFromPackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DeleteEmoteFromPack", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) MoveRole(ctx context.Context, in *MoveRoleRequest, opts ...grpc.CallOption) (*MoveRoleResponse, error) {
-	out := new(MoveRoleResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/MoveRole", in, out, opts...)
+func (c *chatServiceClient) DeleteEmotePack(ctx context.Context, in *DeleteEmotePackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DeleteEmotePack", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) GetGuildRoles(ctx context.Context, in *GetGuildRolesRequest, opts ...grpc.CallOption) (*GetGuildRolesResponse, error) {
-	out := new(GetGuildRolesResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetGuildRoles", in, out, opts...)
+func (c *chatServiceClient) DequipEmotePack(ctx context.Context, in *DequipEmotePackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DequipEmotePack", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) AddGuildRole(ctx context.Context, in *AddGuildRoleRequest, opts ...grpc.CallOption) (*AddGuildRoleResponse, error) {
-	out := new(AddGuildRoleResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/AddGuildRole", in, out, opts...)
+func (c *chatServiceClient) JoinGuild(ctx context.Context, in *JoinGuildRequest, opts ...grpc.CallOption) (*JoinGuildResponse, error) {
+	out := new(JoinGuildResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/JoinGuild", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) ModifyGuildRole(ctx context.Context, in *ModifyGuildRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *chatServiceClient) LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/ModifyGuildRole", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/LeaveGuild", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) DeleteGuildRole(ctx context.Context, in *DeleteGuildRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *chatServiceClient) TriggerAction(ctx context.Context, in *TriggerActionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DeleteGuildRole", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/TriggerAction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) ManageUserRoles(ctx context.Context, in *ManageUserRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/ManageUserRoles", in, out, opts...)
+func (c *chatServiceClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	out := new(SendMessageResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SendMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error) {
-	out := new(GetUserRolesResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetUserRoles", in, out, opts...)
+func (c *chatServiceClient) ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error) {
+	out := new(ScheduleMessageResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/ScheduleMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) StreamEvents(ctx context.Context, opts ...grpc.CallOption) (ChatService_StreamEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ChatService_serviceDesc.Streams[0], "/protocol.chat.v1.ChatService/StreamEvents", opts...)
+func (c *chatServiceClient) GetScheduledMessages(ctx context.Context, in *GetScheduledMessagesRequest, opts ...grpc.CallOption) (*GetScheduledMessagesResponse, error) {
+	out := new(GetScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetScheduledMessages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &chatServiceStreamEventsClient{stream}
-	return x, nil
-}
-
-type ChatService_StreamEventsClient interface {
-	Send(*StreamEventsRequest) error
-	Recv() (*Event, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type chatServiceStreamEventsClient struct {
-	grpc.ClientStream
+func (c *chatServiceClient) UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/UpdateScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *chatServiceStreamEventsClient) Send(m *StreamEventsRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (c *chatServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/CancelScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *chatServiceStreamEventsClient) Recv() (*Event, error) {
-	m := new(Event)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *chatServiceClient) CreateThread(ctx context.Context, in *CreateThreadRequest, opts ...grpc.CallOption) (*CreateThreadResponse, error) {
+	out := new(CreateThreadResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/CreateThread", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *chatServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
-	out := new(GetUserResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetUser", in, out, opts...)
+func (c *chatServiceClient) Typing(ctx context.Context, in *TypingRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/Typing", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) GetUserMetadata(ctx context.Context, in *GetUserMetadataRequest, opts ...grpc.CallOption) (*GetUserMetadataResponse, error) {
-	out := new(GetUserMetadataResponse)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetUserMetadata", in, out, opts...)
+func (c *chatServiceClient) QueryHasPermission(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
+	out := new(QueryPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/QueryHasPermission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatServiceClient) ProfileUpdate(ctx context.Context, in *ProfileUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *chatServiceClient) SetPermissions(ctx context.Context, in *SetPermissionsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/ProfileUpdate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/SetPermissions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ChatServiceServer is the server API for ChatService service.
+func (c *chatServiceClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
+	out := new(GetPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateAutomodRule(ctx context.Context, in *CreateAutomodRuleRequest, opts ...grpc.CallOption) (*CreateAutomodRuleResponse, error) {
+	out := new(CreateAutomodRuleResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/CreateAutomodRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UpdateAutomodRule(ctx context.Context, in *UpdateAutomodRuleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/UpdateAutomodRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteAutomodRule(ctx context.Context, in *DeleteAutomodRuleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DeleteAutomodRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetAutomodRules(ctx context.Context, in *GetAutomodRulesRequest, opts ...grpc.CallOption) (*GetAutomodRulesResponse, error) {
+	out := new(GetAutomodRulesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetAutomodRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MoveRole(ctx context.Context, in *MoveRoleRequest, opts ...grpc.CallOption) (*MoveRoleResponse, error) {
+	out := new(MoveRoleResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/MoveRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetGuildRoles(ctx context.Context, in *GetGuildRolesRequest, opts ...grpc.CallOption) (*GetGuildRolesResponse, error) {
+	out := new(GetGuildRolesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetGuildRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) AddGuildRole(ctx context.Context, in *AddGuildRoleRequest, opts ...grpc.CallOption) (*AddGuildRoleResponse, error) {
+	out := new(AddGuildRoleResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/AddGuildRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ModifyGuildRole(ctx context.Context, in *ModifyGuildRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/ModifyGuildRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteGuildRole(ctx context.Context, in *DeleteGuildRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/DeleteGuildRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ManageUserRoles(ctx context.Context, in *ManageUserRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/ManageUserRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error) {
+	out := new(GetUserRolesResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetUserRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) StreamEvents(ctx context.Context, opts ...grpc.CallOption) (ChatService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ChatService_serviceDesc.Streams[0], "/protocol.chat.v1.ChatService/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServiceStreamEventsClient{stream}
+	return x, nil
+}
+
+type ChatService_StreamEventsClient interface {
+	Send(*StreamEventsRequest) error
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type chatServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServiceStreamEventsClient) Send(m *StreamEventsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *chatServiceStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetUserMetadata(ctx context.Context, in *GetUserMetadataRequest, opts ...grpc.CallOption) (*GetUserMetadataResponse, error) {
+	out := new(GetUserMetadataResponse)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/GetUserMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ProfileUpdate(ctx context.Context, in *ProfileUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protocol.chat.v1.ChatService/ProfileUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatServiceServer is the server API for ChatService service.
 type ChatServiceServer interface {
 	// This doesn't require any permissions.
 	CreateGuild(context.Context, *CreateGuildRequest) (*CreateGuildResponse, error)
@@ -1072,12 +1677,33 @@ type ChatServiceServer interface {
 	GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetChannelMessagesResponse, error)
 	// This requires the "messages.view" permission.
 	GetMessage(context.Context, *GetMessageRequest) (*GetMessageResponse, error)
+	// Messages in channels without the "messages.view" permission are left
+	// out of the results.
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// Lists the latest messages mentioning you across guilds. Messages in
+	// channels without the "messages.view" permission are left out.
+	GetMentions(context.Context, *GetMentionsRequest) (*GetMentionsResponse, error)
+	// This requires the "messages.view" permission.
+	GetThreadMessages(context.Context, *GetThreadMessagesRequest) (*GetThreadMessagesResponse, error)
+	// This requires the "messages.view" permission.
+	GetActiveThreads(context.Context, *GetActiveThreadsRequest) (*GetActiveThreadsResponse, error)
+	// This requires the "messages.history.view" permission.
+	GetMessageHistory(context.Context, *GetMessageHistoryRequest) (*GetMessageHistoryResponse, error)
+	// This requires the "messages.view" permission.
+	GetPinnedMessages(context.Context, *GetPinnedMessagesRequest) (*GetPinnedMessagesResponse, error)
 	GetEmotePacks(context.Context, *GetEmotePacksRequest) (*GetEmotePacksResponse, error)
 	GetEmotePackEmotes(context.Context, *GetEmotePackEmotesRequest) (*GetEmotePackEmotesResponse, error)
 	// This requires the "guild.manage.change-name" permission.
 	UpdateGuildName(context.Context, *UpdateGuildNameRequest) (*empty.Empty, error)
+	// This requires the "guild.manage.retention" permission.
+	SetGuildRetention(context.Context, *SetGuildRetentionRequest) (*empty.Empty, error)
+	// This requires the "channels.manage.retention" permission.
+	SetChannelRetention(context.Context, *SetChannelRetentionRequest) (*empty.Empty, error)
+	GetRetention(context.Context, *GetRetentionRequest) (*GetRetentionResponse, error)
 	// This requires the "channels.manage.change-name" permission.
 	UpdateChannelName(context.Context, *UpdateChannelNameRequest) (*empty.Empty, error)
+	// This requires the "channels.manage.slow-mode" permission.
+	SetChannelSlowMode(context.Context, *SetChannelSlowModeRequest) (*empty.Empty, error)
 	// This requires the "channels.manage.move" permission.
 	UpdateChannelOrder(context.Context, *UpdateChannelOrderRequest) (*empty.Empty, error)
 	// This requires the "messages.send" permission.
@@ -1090,8 +1716,28 @@ type ChatServiceServer interface {
 	// This requires the "channels.manage.delete" permission.
 	DeleteChannel(context.Context, *DeleteChannelRequest) (*empty.Empty, error)
 	// This requires the "messages.manage.delete" permission if you are not the
-	// message author.
+	// message author. Deleted messages can be restored until they are purged.
 	DeleteMessage(context.Context, *DeleteMessageRequest) (*empty.Empty, error)
+	// This requires the "messages.manage.restore" permission.
+	RestoreMessage(context.Context, *RestoreMessageRequest) (*empty.Empty, error)
+	// This requires the "messages.manage.restore" permission.
+	GetDeletedMessages(context.Context, *GetDeletedMessagesRequest) (*GetDeletedMessagesResponse, error)
+	// This requires the "messages.manage.bulk-delete" permission.
+	BulkDeleteMessages(context.Context, *BulkDeleteMessagesRequest) (*BulkDeleteMessagesResponse, error)
+	// This requires the "messages.view" permission. Your other devices are
+	// told about the new read marker with a homeserver event.
+	AckChannel(context.Context, *AckChannelRequest) (*empty.Empty, error)
+	// You will only be given the read state of channels you have the
+	// "messages.view" permission for.
+	GetReadStates(context.Context, *GetReadStatesRequest) (*GetReadStatesResponse, error)
+	// This requires the "messages.pins.manage" permission.
+	PinMessage(context.Context, *PinMessageRequest) (*empty.Empty, error)
+	// This requires the "messages.pins.manage" permission.
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*empty.Empty, error)
+	// This requires the "messages.reactions.add" permission.
+	AddReaction(context.Context, *AddReactionRequest) (*empty.Empty, error)
+	// Removes your own reaction from a message.
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*empty.Empty, error)
 	DeleteEmoteFromPack(context.Context, *DeleteEmoteFromPackRequest) (*empty.Empty, error)
 	DeleteEmotePack(context.Context, *DeleteEmotePackRequest) (*empty.Empty, error)
 	DequipEmotePack(context.Context, *DequipEmotePackRequest) (*empty.Empty, error)
@@ -1099,8 +1745,22 @@ type ChatServiceServer interface {
 	LeaveGuild(context.Context, *LeaveGuildRequest) (*empty.Empty, error)
 	// This requires the "actions.trigger" permission.
 	TriggerAction(context.Context, *TriggerActionRequest) (*empty.Empty, error)
-	// This requires the "messages.send" permission.
+	// This requires the "messages.send" permission. In slow mode channels,
+	// sending too soon fails with a RetryInfo detail giving the time left,
+	// unless you have the "messages.slowmode.bypass" permission. Mentions of
+	// roles that aren't pingable and of @everyone require the
+	// "messages.mentions.everyone" permission.
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	// This requires the "messages.send" permission.
+	ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error)
+	// Only your own scheduled messages are returned.
+	GetScheduledMessages(context.Context, *GetScheduledMessagesRequest) (*GetScheduledMessagesResponse, error)
+	UpdateScheduledMessage(context.Context, *UpdateScheduledMessageRequest) (*empty.Empty, error)
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*empty.Empty, error)
+	// This requires the "messages.threads.create" permission.
+	CreateThread(context.Context, *CreateThreadRequest) (*CreateThreadResponse, error)
+	// This requires the "messages.send" permission.
+	Typing(context.Context, *TypingRequest) (*empty.Empty, error)
 	// This requires the "permissions.query" permission if you specify the As
 	// field.
 	QueryHasPermission(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error)
@@ -1108,6 +1768,14 @@ type ChatServiceServer interface {
 	SetPermissions(context.Context, *SetPermissionsRequest) (*empty.Empty, error)
 	// This requires the "permissions.manage.get" permission.
 	GetPermissions(context.Context, *GetPermissionsRequest) (*GetPermissionsResponse, error)
+	// This requires the "automod.manage" permission.
+	CreateAutomodRule(context.Context, *CreateAutomodRuleRequest) (*CreateAutomodRuleResponse, error)
+	// This requires the "automod.manage" permission.
+	UpdateAutomodRule(context.Context, *UpdateAutomodRuleRequest) (*empty.Empty, error)
+	// This requires the "automod.manage" permission.
+	DeleteAutomodRule(context.Context, *DeleteAutomodRuleRequest) (*empty.Empty, error)
+	// This requires the "automod.manage" permission.
+	GetAutomodRules(context.Context, *GetAutomodRulesRequest) (*GetAutomodRulesResponse, error)
 	// This requires the "roles.manage" permission.
 	MoveRole(context.Context, *MoveRoleRequest) (*MoveRoleResponse, error)
 	// This requires the "roles.get" permission.
@@ -1171,6 +1839,24 @@ func (*UnimplementedChatServiceServer) GetChannelMessages(context.Context, *GetC
 func (*UnimplementedChatServiceServer) GetMessage(context.Context, *GetMessageRequest) (*GetMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMessage not implemented")
 }
+func (*UnimplementedChatServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (*UnimplementedChatServiceServer) GetMentions(context.Context, *GetMentionsRequest) (*GetMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentions not implemented")
+}
+func (*UnimplementedChatServiceServer) GetThreadMessages(context.Context, *GetThreadMessagesRequest) (*GetThreadMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadMessages not implemented")
+}
+func (*UnimplementedChatServiceServer) GetActiveThreads(context.Context, *GetActiveThreadsRequest) (*GetActiveThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveThreads not implemented")
+}
+func (*UnimplementedChatServiceServer) GetMessageHistory(context.Context, *GetMessageHistoryRequest) (*GetMessageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageHistory not implemented")
+}
+func (*UnimplementedChatServiceServer) GetPinnedMessages(context.Context, *GetPinnedMessagesRequest) (*GetPinnedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinnedMessages not implemented")
+}
 func (*UnimplementedChatServiceServer) GetEmotePacks(context.Context, *GetEmotePacksRequest) (*GetEmotePacksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmotePacks not implemented")
 }
@@ -1180,9 +1866,21 @@ func (*UnimplementedChatServiceServer) GetEmotePackEmotes(context.Context, *GetE
 func (*UnimplementedChatServiceServer) UpdateGuildName(context.Context, *UpdateGuildNameRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuildName not implemented")
 }
+func (*UnimplementedChatServiceServer) SetGuildRetention(context.Context, *SetGuildRetentionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuildRetention not implemented")
+}
+func (*UnimplementedChatServiceServer) SetChannelRetention(context.Context, *SetChannelRetentionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelRetention not implemented")
+}
+func (*UnimplementedChatServiceServer) GetRetention(context.Context, *GetRetentionRequest) (*GetRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetention not implemented")
+}
 func (*UnimplementedChatServiceServer) UpdateChannelName(context.Context, *UpdateChannelNameRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelName not implemented")
 }
+func (*UnimplementedChatServiceServer) SetChannelSlowMode(context.Context, *SetChannelSlowModeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelSlowMode not implemented")
+}
 func (*UnimplementedChatServiceServer) UpdateChannelOrder(context.Context, *UpdateChannelOrderRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelOrder not implemented")
 }
@@ -1204,6 +1902,33 @@ func (*UnimplementedChatServiceServer) DeleteChannel(context.Context, *DeleteCha
 func (*UnimplementedChatServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
 }
+func (*UnimplementedChatServiceServer) RestoreMessage(context.Context, *RestoreMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMessage not implemented")
+}
+func (*UnimplementedChatServiceServer) GetDeletedMessages(context.Context, *GetDeletedMessagesRequest) (*GetDeletedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedMessages not implemented")
+}
+func (*UnimplementedChatServiceServer) BulkDeleteMessages(context.Context, *BulkDeleteMessagesRequest) (*BulkDeleteMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteMessages not implemented")
+}
+func (*UnimplementedChatServiceServer) AckChannel(context.Context, *AckChannelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckChannel not implemented")
+}
+func (*UnimplementedChatServiceServer) GetReadStates(context.Context, *GetReadStatesRequest) (*GetReadStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadStates not implemented")
+}
+func (*UnimplementedChatServiceServer) PinMessage(context.Context, *PinMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (*UnimplementedChatServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (*UnimplementedChatServiceServer) AddReaction(context.Context, *AddReactionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (*UnimplementedChatServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
 func (*UnimplementedChatServiceServer) DeleteEmoteFromPack(context.Context, *DeleteEmoteFromPackRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmoteFromPack not implemented")
 }
@@ -1225,6 +1950,24 @@ func (*UnimplementedChatServiceServer) TriggerAction(context.Context, *TriggerAc
 func (*UnimplementedChatServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (*UnimplementedChatServiceServer) ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMessage not implemented")
+}
+func (*UnimplementedChatServiceServer) GetScheduledMessages(context.Context, *GetScheduledMessagesRequest) (*GetScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMessages not implemented")
+}
+func (*UnimplementedChatServiceServer) UpdateScheduledMessage(context.Context, *UpdateScheduledMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledMessage not implemented")
+}
+func (*UnimplementedChatServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (*UnimplementedChatServiceServer) CreateThread(context.Context, *CreateThreadRequest) (*CreateThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateThread not implemented")
+}
+func (*UnimplementedChatServiceServer) Typing(context.Context, *TypingRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Typing not implemented")
+}
 func (*UnimplementedChatServiceServer) QueryHasPermission(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryHasPermission not implemented")
 }
@@ -1234,6 +1977,18 @@ func (*UnimplementedChatServiceServer) SetPermissions(context.Context, *SetPermi
 func (*UnimplementedChatServiceServer) GetPermissions(context.Context, *GetPermissionsRequest) (*GetPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
 }
+func (*UnimplementedChatServiceServer) CreateAutomodRule(context.Context, *CreateAutomodRuleRequest) (*CreateAutomodRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAutomodRule not implemented")
+}
+func (*UnimplementedChatServiceServer) UpdateAutomodRule(context.Context, *UpdateAutomodRuleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutomodRule not implemented")
+}
+func (*UnimplementedChatServiceServer) DeleteAutomodRule(context.Context, *DeleteAutomodRuleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutomodRule not implemented")
+}
+func (*UnimplementedChatServiceServer) GetAutomodRules(context.Context, *GetAutomodRulesRequest) (*GetAutomodRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutomodRules not implemented")
+}
 func (*UnimplementedChatServiceServer) MoveRole(context.Context, *MoveRoleRequest) (*MoveRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveRole not implemented")
 }
@@ -1357,529 +2112,1051 @@ func _ChatService_GetGuildList_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: "/protocol.chat.v1.ChatService/GetGuildList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetGuildList(ctx, req.(*GetGuildListRequest))
+		return srv.(ChatServiceServer).GetGuildList(ctx, req.(*GetGuildListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AddGuildToGuildList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGuildToGuildListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddGuildToGuildList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/AddGuildToGuildList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddGuildToGuildList(ctx, req.(*AddGuildToGuildListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveGuildFromGuildList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGuildFromGuildListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveGuildFromGuildList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/RemoveGuildFromGuildList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveGuildFromGuildList(ctx, req.(*RemoveGuildFromGuildListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetGuild(ctx, req.(*GetGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetGuildInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetGuildInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetGuildInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetGuildInvites(ctx, req.(*GetGuildInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetGuildMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetGuildMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetGuildMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetGuildMembers(ctx, req.(*GetGuildMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetGuildChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetGuildChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetGuildChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetGuildChannels(ctx, req.(*GetGuildChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetChannelMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChannelMessages(ctx, req.(*GetChannelMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMessage(ctx, req.(*GetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/SearchMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetMentions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMentions(ctx, req.(*GetMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetThreadMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetThreadMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetThreadMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetThreadMessages(ctx, req.(*GetThreadMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetActiveThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetActiveThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetActiveThreads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetActiveThreads(ctx, req.(*GetActiveThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetMessageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMessageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetMessageHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMessageHistory(ctx, req.(*GetMessageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetPinnedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetPinnedMessages(ctx, req.(*GetPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetEmotePacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmotePacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetEmotePacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetEmotePacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetEmotePacks(ctx, req.(*GetEmotePacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetEmotePackEmotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmotePackEmotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetEmotePackEmotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetEmotePackEmotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetEmotePackEmotes(ctx, req.(*GetEmotePackEmotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UpdateGuildName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuildNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UpdateGuildName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/UpdateGuildName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UpdateGuildName(ctx, req.(*UpdateGuildNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetGuildRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuildRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetGuildRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/SetGuildRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetGuildRetention(ctx, req.(*SetGuildRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetChannelRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetChannelRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/SetChannelRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetChannelRetention(ctx, req.(*SetChannelRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/GetRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetRetention(ctx, req.(*GetRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UpdateChannelName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UpdateChannelName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/UpdateChannelName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UpdateChannelName(ctx, req.(*UpdateChannelNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetChannelSlowMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelSlowModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetChannelSlowMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/SetChannelSlowMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetChannelSlowMode(ctx, req.(*SetChannelSlowModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UpdateChannelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UpdateChannelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/UpdateChannelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UpdateChannelOrder(ctx, req.(*UpdateChannelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UpdateMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UpdateMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/UpdateMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UpdateMessage(ctx, req.(*UpdateMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AddEmoteToPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEmoteToPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddEmoteToPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/AddEmoteToPack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddEmoteToPack(ctx, req.(*AddEmoteToPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/DeleteGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteGuild(ctx, req.(*DeleteGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/DeleteInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteInvite(ctx, req.(*DeleteInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/DeleteChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.chat.v1.ChatService/DeleteMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_AddGuildToGuildList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddGuildToGuildListRequest)
+func _ChatService_RestoreMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).AddGuildToGuildList(ctx, in)
+		return srv.(ChatServiceServer).RestoreMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/AddGuildToGuildList",
+		FullMethod: "/protocol.chat.v1.ChatService/RestoreMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).AddGuildToGuildList(ctx, req.(*AddGuildToGuildListRequest))
+		return srv.(ChatServiceServer).RestoreMessage(ctx, req.(*RestoreMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_RemoveGuildFromGuildList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveGuildFromGuildListRequest)
+func _ChatService_GetDeletedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).RemoveGuildFromGuildList(ctx, in)
+		return srv.(ChatServiceServer).GetDeletedMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/RemoveGuildFromGuildList",
+		FullMethod: "/protocol.chat.v1.ChatService/GetDeletedMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).RemoveGuildFromGuildList(ctx, req.(*RemoveGuildFromGuildListRequest))
+		return srv.(ChatServiceServer).GetDeletedMessages(ctx, req.(*GetDeletedMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGuildRequest)
+func _ChatService_BulkDeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetGuild(ctx, in)
+		return srv.(ChatServiceServer).BulkDeleteMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetGuild",
+		FullMethod: "/protocol.chat.v1.ChatService/BulkDeleteMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetGuild(ctx, req.(*GetGuildRequest))
+		return srv.(ChatServiceServer).BulkDeleteMessages(ctx, req.(*BulkDeleteMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetGuildInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGuildInvitesRequest)
+func _ChatService_AckChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetGuildInvites(ctx, in)
+		return srv.(ChatServiceServer).AckChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetGuildInvites",
+		FullMethod: "/protocol.chat.v1.ChatService/AckChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetGuildInvites(ctx, req.(*GetGuildInvitesRequest))
+		return srv.(ChatServiceServer).AckChannel(ctx, req.(*AckChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetGuildMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGuildMembersRequest)
+func _ChatService_GetReadStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadStatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetGuildMembers(ctx, in)
+		return srv.(ChatServiceServer).GetReadStates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetGuildMembers",
+		FullMethod: "/protocol.chat.v1.ChatService/GetReadStates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetGuildMembers(ctx, req.(*GetGuildMembersRequest))
+		return srv.(ChatServiceServer).GetReadStates(ctx, req.(*GetReadStatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetGuildChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGuildChannelsRequest)
+func _ChatService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetGuildChannels(ctx, in)
+		return srv.(ChatServiceServer).PinMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetGuildChannels",
+		FullMethod: "/protocol.chat.v1.ChatService/PinMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetGuildChannels(ctx, req.(*GetGuildChannelsRequest))
+		return srv.(ChatServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetChannelMessagesRequest)
+func _ChatService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetChannelMessages(ctx, in)
+		return srv.(ChatServiceServer).UnpinMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetChannelMessages",
+		FullMethod: "/protocol.chat.v1.ChatService/UnpinMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetChannelMessages(ctx, req.(*GetChannelMessagesRequest))
+		return srv.(ChatServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessageRequest)
+func _ChatService_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetMessage(ctx, in)
+		return srv.(ChatServiceServer).AddReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetMessage",
+		FullMethod: "/protocol.chat.v1.ChatService/AddReaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetMessage(ctx, req.(*GetMessageRequest))
+		return srv.(ChatServiceServer).AddReaction(ctx, req.(*AddReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetEmotePacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEmotePacksRequest)
+func _ChatService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetEmotePacks(ctx, in)
+		return srv.(ChatServiceServer).RemoveReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetEmotePacks",
+		FullMethod: "/protocol.chat.v1.ChatService/RemoveReaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetEmotePacks(ctx, req.(*GetEmotePacksRequest))
+		return srv.(ChatServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetEmotePackEmotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEmotePackEmotesRequest)
+func _ChatService_DeleteEmoteFromPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmoteFromPackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetEmotePackEmotes(ctx, in)
+		return srv.(ChatServiceServer).DeleteEmoteFromPack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetEmotePackEmotes",
+		FullMethod: "/protocol.chat.v1.ChatService/DeleteEmoteFromPack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetEmotePackEmotes(ctx, req.(*GetEmotePackEmotesRequest))
+		return srv.(ChatServiceServer).DeleteEmoteFromPack(ctx, req.(*DeleteEmoteFromPackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_UpdateGuildName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGuildNameRequest)
+func _ChatService_DeleteEmotePack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmotePackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).UpdateGuildName(ctx, in)
+		return srv.(ChatServiceServer).DeleteEmotePack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/UpdateGuildName",
+		FullMethod: "/protocol.chat.v1.ChatService/DeleteEmotePack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).UpdateGuildName(ctx, req.(*UpdateGuildNameRequest))
+		return srv.(ChatServiceServer).DeleteEmotePack(ctx, req.(*DeleteEmotePackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_UpdateChannelName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateChannelNameRequest)
+func _ChatService_DequipEmotePack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DequipEmotePackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).UpdateChannelName(ctx, in)
+		return srv.(ChatServiceServer).DequipEmotePack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/UpdateChannelName",
+		FullMethod: "/protocol.chat.v1.ChatService/DequipEmotePack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).UpdateChannelName(ctx, req.(*UpdateChannelNameRequest))
+		return srv.(ChatServiceServer).DequipEmotePack(ctx, req.(*DequipEmotePackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_UpdateChannelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateChannelOrderRequest)
+func _ChatService_JoinGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGuildRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).UpdateChannelOrder(ctx, in)
+		return srv.(ChatServiceServer).JoinGuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/UpdateChannelOrder",
+		FullMethod: "/protocol.chat.v1.ChatService/JoinGuild",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).UpdateChannelOrder(ctx, req.(*UpdateChannelOrderRequest))
+		return srv.(ChatServiceServer).JoinGuild(ctx, req.(*JoinGuildRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_UpdateMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMessageRequest)
+func _ChatService_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGuildRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).UpdateMessage(ctx, in)
+		return srv.(ChatServiceServer).LeaveGuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/UpdateMessage",
+		FullMethod: "/protocol.chat.v1.ChatService/LeaveGuild",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).UpdateMessage(ctx, req.(*UpdateMessageRequest))
+		return srv.(ChatServiceServer).LeaveGuild(ctx, req.(*LeaveGuildRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_AddEmoteToPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEmoteToPackRequest)
+func _ChatService_TriggerAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).AddEmoteToPack(ctx, in)
+		return srv.(ChatServiceServer).TriggerAction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/AddEmoteToPack",
+		FullMethod: "/protocol.chat.v1.ChatService/TriggerAction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).AddEmoteToPack(ctx, req.(*AddEmoteToPackRequest))
+		return srv.(ChatServiceServer).TriggerAction(ctx, req.(*TriggerActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_DeleteGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGuildRequest)
+func _ChatService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).DeleteGuild(ctx, in)
+		return srv.(ChatServiceServer).SendMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/DeleteGuild",
+		FullMethod: "/protocol.chat.v1.ChatService/SendMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).DeleteGuild(ctx, req.(*DeleteGuildRequest))
+		return srv.(ChatServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_DeleteInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteInviteRequest)
+func _ChatService_ScheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).DeleteInvite(ctx, in)
+		return srv.(ChatServiceServer).ScheduleMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/DeleteInvite",
+		FullMethod: "/protocol.chat.v1.ChatService/ScheduleMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).DeleteInvite(ctx, req.(*DeleteInviteRequest))
+		return srv.(ChatServiceServer).ScheduleMessage(ctx, req.(*ScheduleMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteChannelRequest)
+func _ChatService_GetScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).DeleteChannel(ctx, in)
+		return srv.(ChatServiceServer).GetScheduledMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/DeleteChannel",
+		FullMethod: "/protocol.chat.v1.ChatService/GetScheduledMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+		return srv.(ChatServiceServer).GetScheduledMessages(ctx, req.(*GetScheduledMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMessageRequest)
+func _ChatService_UpdateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).DeleteMessage(ctx, in)
+		return srv.(ChatServiceServer).UpdateScheduledMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/DeleteMessage",
+		FullMethod: "/protocol.chat.v1.ChatService/UpdateScheduledMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+		return srv.(ChatServiceServer).UpdateScheduledMessage(ctx, req.(*UpdateScheduledMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_DeleteEmoteFromPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEmoteFromPackRequest)
+func _ChatService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).DeleteEmoteFromPack(ctx, in)
+		return srv.(ChatServiceServer).CancelScheduledMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/DeleteEmoteFromPack",
+		FullMethod: "/protocol.chat.v1.ChatService/CancelScheduledMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).DeleteEmoteFromPack(ctx, req.(*DeleteEmoteFromPackRequest))
+		return srv.(ChatServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_DeleteEmotePack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEmotePackRequest)
+func _ChatService_CreateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).DeleteEmotePack(ctx, in)
+		return srv.(ChatServiceServer).CreateThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/DeleteEmotePack",
+		FullMethod: "/protocol.chat.v1.ChatService/CreateThread",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).DeleteEmotePack(ctx, req.(*DeleteEmotePackRequest))
+		return srv.(ChatServiceServer).CreateThread(ctx, req.(*CreateThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_DequipEmotePack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DequipEmotePackRequest)
+func _ChatService_Typing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TypingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).DequipEmotePack(ctx, in)
+		return srv.(ChatServiceServer).Typing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/DequipEmotePack",
+		FullMethod: "/protocol.chat.v1.ChatService/Typing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).DequipEmotePack(ctx, req.(*DequipEmotePackRequest))
+		return srv.(ChatServiceServer).Typing(ctx, req.(*TypingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_JoinGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinGuildRequest)
+func _ChatService_QueryHasPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).JoinGuild(ctx, in)
+		return srv.(ChatServiceServer).QueryHasPermission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/JoinGuild",
+		FullMethod: "/protocol.chat.v1.ChatService/QueryHasPermission",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).JoinGuild(ctx, req.(*JoinGuildRequest))
+		return srv.(ChatServiceServer).QueryHasPermission(ctx, req.(*QueryPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveGuildRequest)
+func _ChatService_SetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).LeaveGuild(ctx, in)
+		return srv.(ChatServiceServer).SetPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/LeaveGuild",
+		FullMethod: "/protocol.chat.v1.ChatService/SetPermissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).LeaveGuild(ctx, req.(*LeaveGuildRequest))
+		return srv.(ChatServiceServer).SetPermissions(ctx, req.(*SetPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_TriggerAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TriggerActionRequest)
+func _ChatService_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).TriggerAction(ctx, in)
+		return srv.(ChatServiceServer).GetPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/TriggerAction",
+		FullMethod: "/protocol.chat.v1.ChatService/GetPermissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).TriggerAction(ctx, req.(*TriggerActionRequest))
+		return srv.(ChatServiceServer).GetPermissions(ctx, req.(*GetPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendMessageRequest)
+func _ChatService_CreateAutomodRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAutomodRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).SendMessage(ctx, in)
+		return srv.(ChatServiceServer).CreateAutomodRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/SendMessage",
+		FullMethod: "/protocol.chat.v1.ChatService/CreateAutomodRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+		return srv.(ChatServiceServer).CreateAutomodRule(ctx, req.(*CreateAutomodRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_QueryHasPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPermissionsRequest)
+func _ChatService_UpdateAutomodRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAutomodRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).QueryHasPermission(ctx, in)
+		return srv.(ChatServiceServer).UpdateAutomodRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/QueryHasPermission",
+		FullMethod: "/protocol.chat.v1.ChatService/UpdateAutomodRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).QueryHasPermission(ctx, req.(*QueryPermissionsRequest))
+		return srv.(ChatServiceServer).UpdateAutomodRule(ctx, req.(*UpdateAutomodRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_SetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPermissionsRequest)
+func _ChatService_DeleteAutomodRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutomodRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).SetPermissions(ctx, in)
+		return srv.(ChatServiceServer).DeleteAutomodRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/SetPermissions",
+		FullMethod: "/protocol.chat.v1.ChatService/DeleteAutomodRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).SetPermissions(ctx, req.(*SetPermissionsRequest))
+		return srv.(ChatServiceServer).DeleteAutomodRule(ctx, req.(*DeleteAutomodRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChatService_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPermissionsRequest)
+func _ChatService_GetAutomodRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAutomodRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServiceServer).GetPermissions(ctx, in)
+		return srv.(ChatServiceServer).GetAutomodRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.chat.v1.ChatService/GetPermissions",
+		FullMethod: "/protocol.chat.v1.ChatService/GetAutomodRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetPermissions(ctx, req.(*GetPermissionsRequest))
+		return srv.(ChatServiceServer).GetAutomodRules(ctx, req.(*GetAutomodRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2146,6 +3423,30 @@ var _ChatService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessage",
 			Handler:    _ChatService_GetMessage_Handler,
 		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _ChatService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "GetMentions",
+			Handler:    _ChatService_GetMentions_Handler,
+		},
+		{
+			MethodName: "GetThreadMessages",
+			Handler:    _ChatService_GetThreadMessages_Handler,
+		},
+		{
+			MethodName: "GetActiveThreads",
+			Handler:    _ChatService_GetActiveThreads_Handler,
+		},
+		{
+			MethodName: "GetMessageHistory",
+			Handler:    _ChatService_GetMessageHistory_Handler,
+		},
+		{
+			MethodName: "GetPinnedMessages",
+			Handler:    _ChatService_GetPinnedMessages_Handler,
+		},
 		{
 			MethodName: "GetEmotePacks",
 			Handler:    _ChatService_GetEmotePacks_Handler,
@@ -2158,10 +3459,26 @@ var _ChatService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGuildName",
 			Handler:    _ChatService_UpdateGuildName_Handler,
 		},
+		{
+			MethodName: "SetGuildRetention",
+			Handler:    _ChatService_SetGuildRetention_Handler,
+		},
+		{
+			MethodName: "SetChannelRetention",
+			Handler:    _ChatService_SetChannelRetention_Handler,
+		},
+		{
+			MethodName: "GetRetention",
+			Handler:    _ChatService_GetRetention_Handler,
+		},
 		{
 			MethodName: "UpdateChannelName",
 			Handler:    _ChatService_UpdateChannelName_Handler,
 		},
+		{
+			MethodName: "SetChannelSlowMode",
+			Handler:    _ChatService_SetChannelSlowMode_Handler,
+		},
 		{
 			MethodName: "UpdateChannelOrder",
 			Handler:    _ChatService_UpdateChannelOrder_Handler,
@@ -2190,6 +3507,42 @@ var _ChatService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMessage",
 			Handler:    _ChatService_DeleteMessage_Handler,
 		},
+		{
+			MethodName: "RestoreMessage",
+			Handler:    _ChatService_RestoreMessage_Handler,
+		},
+		{
+			MethodName: "GetDeletedMessages",
+			Handler:    _ChatService_GetDeletedMessages_Handler,
+		},
+		{
+			MethodName: "BulkDeleteMessages",
+			Handler:    _ChatService_BulkDeleteMessages_Handler,
+		},
+		{
+			MethodName: "AckChannel",
+			Handler:    _ChatService_AckChannel_Handler,
+		},
+		{
+			MethodName: "GetReadStates",
+			Handler:    _ChatService_GetReadStates_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _ChatService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _ChatService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _ChatService_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _ChatService_RemoveReaction_Handler,
+		},
 		{
 			MethodName: "DeleteEmoteFromPack",
 			Handler:    _ChatService_DeleteEmoteFromPack_Handler,
@@ -2218,6 +3571,30 @@ var _ChatService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _ChatService_SendMessage_Handler,
 		},
+		{
+			MethodName: "ScheduleMessage",
+			Handler:    _ChatService_ScheduleMessage_Handler,
+		},
+		{
+			MethodName: "GetScheduledMessages",
+			Handler:    _ChatService_GetScheduledMessages_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledMessage",
+			Handler:    _ChatService_UpdateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _ChatService_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "CreateThread",
+			Handler:    _ChatService_CreateThread_Handler,
+		},
+		{
+			MethodName: "Typing",
+			Handler:    _ChatService_Typing_Handler,
+		},
 		{
 			MethodName: "QueryHasPermission",
 			Handler:    _ChatService_QueryHasPermission_Handler,
@@ -2230,6 +3607,22 @@ var _ChatService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPermissions",
 			Handler:    _ChatService_GetPermissions_Handler,
 		},
+		{
+			MethodName: "CreateAutomodRule",
+			Handler:    _ChatService_CreateAutomodRule_Handler,
+		},
+		{
+			MethodName: "UpdateAutomodRule",
+			Handler:    _ChatService_UpdateAutomodRule_Handler,
+		},
+		{
+			MethodName: "DeleteAutomodRule",
+			Handler:    _ChatService_DeleteAutomodRule_Handler,
+		},
+		{
+			MethodName: "GetAutomodRules",
+			Handler:    _ChatService_GetAutomodRules_Handler,
+		},
 		{
 			MethodName: "MoveRole",
 			Handler:    _ChatService_MoveRole_Handler,
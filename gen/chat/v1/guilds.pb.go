@@ -1105,6 +1105,167 @@ func (*RemoveGuildFromGuildListResponse) Descriptor() ([]byte, []int) {
 	return file_chat_v1_guilds_proto_rawDescGZIP(), []int{21}
 }
 
+// RETENTION
+// Messages older than max_age seconds are deleted for good. A max_age of 0
+// keeps messages forever.
+type SetGuildRetentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	MaxAge  uint64 `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (x *SetGuildRetentionRequest) Reset() {
+	*x = SetGuildRetentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_guilds_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGuildRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuildRetentionRequest) ProtoMessage() {}
+
+func (x *SetGuildRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_guilds_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuildRetentionRequest.ProtoReflect.Descriptor instead.
+func (*SetGuildRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_guilds_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetGuildRetentionRequest) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *SetGuildRetentionRequest) GetMaxAge() uint64 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+type GetRetentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+}
+
+func (x *GetRetentionRequest) Reset() {
+	*x = GetRetentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_guilds_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionRequest) ProtoMessage() {}
+
+func (x *GetRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_guilds_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionRequest.ProtoReflect.Descriptor instead.
+func (*GetRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_guilds_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRetentionRequest) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+type GetRetentionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAge uint64 `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// Channels overriding the retention of the guild
+	Channels []*GetRetentionResponse_ChannelRetention `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *GetRetentionResponse) Reset() {
+	*x = GetRetentionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_guilds_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionResponse) ProtoMessage() {}
+
+func (x *GetRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_guilds_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionResponse.ProtoReflect.Descriptor instead.
+func (*GetRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_guilds_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRetentionResponse) GetMaxAge() uint64 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *GetRetentionResponse) GetChannels() []*GetRetentionResponse_ChannelRetention {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
 type GetGuildListResponse_GuildListEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1117,7 +1278,7 @@ type GetGuildListResponse_GuildListEntry struct {
 func (x *GetGuildListResponse_GuildListEntry) Reset() {
 	*x = GetGuildListResponse_GuildListEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_guilds_proto_msgTypes[22]
+		mi := &file_chat_v1_guilds_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1130,7 +1291,7 @@ func (x *GetGuildListResponse_GuildListEntry) String() string {
 func (*GetGuildListResponse_GuildListEntry) ProtoMessage() {}
 
 func (x *GetGuildListResponse_GuildListEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_guilds_proto_msgTypes[22]
+	mi := &file_chat_v1_guilds_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,7 +1334,7 @@ type GetGuildInvitesResponse_Invite struct {
 func (x *GetGuildInvitesResponse_Invite) Reset() {
 	*x = GetGuildInvitesResponse_Invite{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_guilds_proto_msgTypes[23]
+		mi := &file_chat_v1_guilds_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1186,7 +1347,7 @@ func (x *GetGuildInvitesResponse_Invite) String() string {
 func (*GetGuildInvitesResponse_Invite) ProtoMessage() {}
 
 func (x *GetGuildInvitesResponse_Invite) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_guilds_proto_msgTypes[23]
+	mi := &file_chat_v1_guilds_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,6 +1384,61 @@ func (x *GetGuildInvitesResponse_Invite) GetUseCount() int32 {
 	return 0
 }
 
+type GetRetentionResponse_ChannelRetention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MaxAge    uint64 `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (x *GetRetentionResponse_ChannelRetention) Reset() {
+	*x = GetRetentionResponse_ChannelRetention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_guilds_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetentionResponse_ChannelRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionResponse_ChannelRetention) ProtoMessage() {}
+
+func (x *GetRetentionResponse_ChannelRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_guilds_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionResponse_ChannelRetention.ProtoReflect.Descriptor instead.
+func (*GetRetentionResponse_ChannelRetention) Descriptor() ([]byte, []int) {
+	return file_chat_v1_guilds_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *GetRetentionResponse_ChannelRetention) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *GetRetentionResponse_ChannelRetention) GetMaxAge() uint64 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
 var File_chat_v1_guilds_proto protoreflect.FileDescriptor
 
 var file_chat_v1_guilds_proto_rawDesc = []byte{
@@ -1334,10 +1550,32 @@ var file_chat_v1_guilds_proto_rawDesc = []byte{
 	0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20,
 	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x47,
 	0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
-	0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x6d, 0x65,
-	0x6e, 0x74, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x68,
-	0x61, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x52, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x08,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x41, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x08, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x53, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x1a, 0x4e, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63,
+	0x68, 0x61, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1352,41 +1590,46 @@ func file_chat_v1_guilds_proto_rawDescGZIP() []byte {
 	return file_chat_v1_guilds_proto_rawDescData
 }
 
-var file_chat_v1_guilds_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_chat_v1_guilds_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_chat_v1_guilds_proto_goTypes = []interface{}{
-	(*CreateGuildRequest)(nil),                  // 0: protocol.chat.v1.CreateGuildRequest
-	(*CreateGuildResponse)(nil),                 // 1: protocol.chat.v1.CreateGuildResponse
-	(*CreateInviteRequest)(nil),                 // 2: protocol.chat.v1.CreateInviteRequest
-	(*CreateInviteResponse)(nil),                // 3: protocol.chat.v1.CreateInviteResponse
-	(*GetGuildListRequest)(nil),                 // 4: protocol.chat.v1.GetGuildListRequest
-	(*GetGuildListResponse)(nil),                // 5: protocol.chat.v1.GetGuildListResponse
-	(*GetGuildRequest)(nil),                     // 6: protocol.chat.v1.GetGuildRequest
-	(*GetGuildResponse)(nil),                    // 7: protocol.chat.v1.GetGuildResponse
-	(*GetGuildInvitesRequest)(nil),              // 8: protocol.chat.v1.GetGuildInvitesRequest
-	(*GetGuildInvitesResponse)(nil),             // 9: protocol.chat.v1.GetGuildInvitesResponse
-	(*GetGuildMembersRequest)(nil),              // 10: protocol.chat.v1.GetGuildMembersRequest
-	(*GetGuildMembersResponse)(nil),             // 11: protocol.chat.v1.GetGuildMembersResponse
-	(*UpdateGuildNameRequest)(nil),              // 12: protocol.chat.v1.UpdateGuildNameRequest
-	(*DeleteGuildRequest)(nil),                  // 13: protocol.chat.v1.DeleteGuildRequest
-	(*DeleteInviteRequest)(nil),                 // 14: protocol.chat.v1.DeleteInviteRequest
-	(*JoinGuildRequest)(nil),                    // 15: protocol.chat.v1.JoinGuildRequest
-	(*JoinGuildResponse)(nil),                   // 16: protocol.chat.v1.JoinGuildResponse
-	(*LeaveGuildRequest)(nil),                   // 17: protocol.chat.v1.LeaveGuildRequest
-	(*AddGuildToGuildListRequest)(nil),          // 18: protocol.chat.v1.AddGuildToGuildListRequest
-	(*AddGuildToGuildListResponse)(nil),         // 19: protocol.chat.v1.AddGuildToGuildListResponse
-	(*RemoveGuildFromGuildListRequest)(nil),     // 20: protocol.chat.v1.RemoveGuildFromGuildListRequest
-	(*RemoveGuildFromGuildListResponse)(nil),    // 21: protocol.chat.v1.RemoveGuildFromGuildListResponse
-	(*GetGuildListResponse_GuildListEntry)(nil), // 22: protocol.chat.v1.GetGuildListResponse.GuildListEntry
-	(*GetGuildInvitesResponse_Invite)(nil),      // 23: protocol.chat.v1.GetGuildInvitesResponse.Invite
+	(*CreateGuildRequest)(nil),                    // 0: protocol.chat.v1.CreateGuildRequest
+	(*CreateGuildResponse)(nil),                   // 1: protocol.chat.v1.CreateGuildResponse
+	(*CreateInviteRequest)(nil),                   // 2: protocol.chat.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),                  // 3: protocol.chat.v1.CreateInviteResponse
+	(*GetGuildListRequest)(nil),                   // 4: protocol.chat.v1.GetGuildListRequest
+	(*GetGuildListResponse)(nil),                  // 5: protocol.chat.v1.GetGuildListResponse
+	(*GetGuildRequest)(nil),                       // 6: protocol.chat.v1.GetGuildRequest
+	(*GetGuildResponse)(nil),                      // 7: protocol.chat.v1.GetGuildResponse
+	(*GetGuildInvitesRequest)(nil),                // 8: protocol.chat.v1.GetGuildInvitesRequest
+	(*GetGuildInvitesResponse)(nil),               // 9: protocol.chat.v1.GetGuildInvitesResponse
+	(*GetGuildMembersRequest)(nil),                // 10: protocol.chat.v1.GetGuildMembersRequest
+	(*GetGuildMembersResponse)(nil),               // 11: protocol.chat.v1.GetGuildMembersResponse
+	(*UpdateGuildNameRequest)(nil),                // 12: protocol.chat.v1.UpdateGuildNameRequest
+	(*DeleteGuildRequest)(nil),                    // 13: protocol.chat.v1.DeleteGuildRequest
+	(*DeleteInviteRequest)(nil),                   // 14: protocol.chat.v1.DeleteInviteRequest
+	(*JoinGuildRequest)(nil),                      // 15: protocol.chat.v1.JoinGuildRequest
+	(*JoinGuildResponse)(nil),                     // 16: protocol.chat.v1.JoinGuildResponse
+	(*LeaveGuildRequest)(nil),                     // 17: protocol.chat.v1.LeaveGuildRequest
+	(*AddGuildToGuildListRequest)(nil),            // 18: protocol.chat.v1.AddGuildToGuildListRequest
+	(*AddGuildToGuildListResponse)(nil),           // 19: protocol.chat.v1.AddGuildToGuildListResponse
+	(*RemoveGuildFromGuildListRequest)(nil),       // 20: protocol.chat.v1.RemoveGuildFromGuildListRequest
+	(*RemoveGuildFromGuildListResponse)(nil),      // 21: protocol.chat.v1.RemoveGuildFromGuildListResponse
+	(*SetGuildRetentionRequest)(nil),              // 22: protocol.chat.v1.SetGuildRetentionRequest
+	(*GetRetentionRequest)(nil),                   // 23: protocol.chat.v1.GetRetentionRequest
+	(*GetRetentionResponse)(nil),                  // 24: protocol.chat.v1.GetRetentionResponse
+	(*GetGuildListResponse_GuildListEntry)(nil),   // 25: protocol.chat.v1.GetGuildListResponse.GuildListEntry
+	(*GetGuildInvitesResponse_Invite)(nil),        // 26: protocol.chat.v1.GetGuildInvitesResponse.Invite
+	(*GetRetentionResponse_ChannelRetention)(nil), // 27: protocol.chat.v1.GetRetentionResponse.ChannelRetention
 }
 var file_chat_v1_guilds_proto_depIdxs = []int32{
-	22, // 0: protocol.chat.v1.GetGuildListResponse.guilds:type_name -> protocol.chat.v1.GetGuildListResponse.GuildListEntry
-	23, // 1: protocol.chat.v1.GetGuildInvitesResponse.invites:type_name -> protocol.chat.v1.GetGuildInvitesResponse.Invite
-	2,  // [2:2] is the sub-list for method output_type
-	2,  // [2:2] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	25, // 0: protocol.chat.v1.GetGuildListResponse.guilds:type_name -> protocol.chat.v1.GetGuildListResponse.GuildListEntry
+	26, // 1: protocol.chat.v1.GetGuildInvitesResponse.invites:type_name -> protocol.chat.v1.GetGuildInvitesResponse.Invite
+	27, // 2: protocol.chat.v1.GetRetentionResponse.channels:type_name -> protocol.chat.v1.GetRetentionResponse.ChannelRetention
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_chat_v1_guilds_proto_init() }
@@ -1660,7 +1903,7 @@ func file_chat_v1_guilds_proto_init() {
 			}
 		}
 		file_chat_v1_guilds_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGuildListResponse_GuildListEntry); i {
+			switch v := v.(*SetGuildRetentionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1672,6 +1915,42 @@ func file_chat_v1_guilds_proto_init() {
 			}
 		}
 		file_chat_v1_guilds_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRetentionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_guilds_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRetentionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_guilds_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuildListResponse_GuildListEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_guilds_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGuildInvitesResponse_Invite); i {
 			case 0:
 				return &v.state
@@ -1683,6 +1962,18 @@ func file_chat_v1_guilds_proto_init() {
 				return nil
 			}
 		}
+		file_chat_v1_guilds_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRetentionResponse_ChannelRetention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1690,7 +1981,7 @@ func file_chat_v1_guilds_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chat_v1_guilds_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
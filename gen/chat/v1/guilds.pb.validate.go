@@ -1587,6 +1587,230 @@ var _ interface {
 	ErrorName() string
 } = RemoveGuildFromGuildListResponseValidationError{}
 
+// Validate checks the field values on SetGuildRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetGuildRetentionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for MaxAge
+
+	return nil
+}
+
+// SetGuildRetentionRequestValidationError is the validation error returned by
+// SetGuildRetentionRequest.Validate if the designated constraints aren't met.
+type SetGuildRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGuildRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGuildRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGuildRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGuildRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGuildRetentionRequestValidationError) ErrorName() string {
+	return "SetGuildRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGuildRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGuildRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGuildRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGuildRetentionRequestValidationError{}
+
+// Validate checks the field values on GetRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetRetentionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	return nil
+}
+
+// GetRetentionRequestValidationError is the validation error returned by
+// GetRetentionRequest.Validate if the designated constraints aren't met.
+type GetRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRetentionRequestValidationError) ErrorName() string {
+	return "GetRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRetentionRequestValidationError{}
+
+// Validate checks the field values on GetRetentionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetRetentionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MaxAge
+
+	for idx, item := range m.GetChannels() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRetentionResponseValidationError{
+					field:  fmt.Sprintf("Channels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetRetentionResponseValidationError is the validation error returned by
+// GetRetentionResponse.Validate if the designated constraints aren't met.
+type GetRetentionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRetentionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRetentionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRetentionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRetentionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRetentionResponseValidationError) ErrorName() string {
+	return "GetRetentionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRetentionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRetentionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRetentionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRetentionResponseValidationError{}
+
 // Validate checks the field values on GetGuildListResponse_GuildListEntry with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
@@ -1732,3 +1956,75 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetGuildInvitesResponse_InviteValidationError{}
+
+// Validate checks the field values on GetRetentionResponse_ChannelRetention
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GetRetentionResponse_ChannelRetention) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MaxAge
+
+	return nil
+}
+
+// GetRetentionResponse_ChannelRetentionValidationError is the validation error
+// returned by GetRetentionResponse_ChannelRetention.Validate if the
+// designated constraints aren't met.
+type GetRetentionResponse_ChannelRetentionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRetentionResponse_ChannelRetentionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRetentionResponse_ChannelRetentionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRetentionResponse_ChannelRetentionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRetentionResponse_ChannelRetentionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRetentionResponse_ChannelRetentionValidationError) ErrorName() string {
+	return "GetRetentionResponse_ChannelRetentionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRetentionResponse_ChannelRetentionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRetentionResponse_ChannelRetention.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRetentionResponse_ChannelRetentionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRetentionResponse_ChannelRetentionValidationError{}
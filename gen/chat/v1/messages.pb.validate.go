@@ -50,6 +50,12 @@ func (m *GetChannelMessagesRequest) Validate() error {
 
 	// no validation rules for BeforeMessage
 
+	// no validation rules for AfterMessage
+
+	// no validation rules for AroundMessage
+
+	// no validation rules for Limit
+
 	return nil
 }
 
@@ -134,6 +140,8 @@ func (m *GetChannelMessagesResponse) Validate() error {
 
 	}
 
+	// no validation rules for ReachedBottom
+
 	return nil
 }
 
@@ -676,6 +684,8 @@ func (m *SendMessageRequest) Validate() error {
 
 	// no validation rules for EchoId
 
+	// no validation rules for ThreadId
+
 	return nil
 }
 
@@ -803,3 +813,3068 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SendMessageResponseValidationError{}
+
+// Validate checks the field values on ScheduleMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ScheduleMessageRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleMessageRequestValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleMessageRequestValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ScheduleMessageRequestValidationError is the validation error returned by
+// ScheduleMessageRequest.Validate if the designated constraints aren't met.
+type ScheduleMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleMessageRequestValidationError) ErrorName() string {
+	return "ScheduleMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleMessageRequestValidationError{}
+
+// Validate checks the field values on ScheduleMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ScheduleMessageResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ScheduledId
+
+	return nil
+}
+
+// ScheduleMessageResponseValidationError is the validation error returned by
+// ScheduleMessageResponse.Validate if the designated constraints aren't met.
+type ScheduleMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleMessageResponseValidationError) ErrorName() string {
+	return "ScheduleMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleMessageResponseValidationError{}
+
+// Validate checks the field values on ScheduledMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ScheduledMessage) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ScheduledId
+
+	if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledMessageValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledMessageValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ScheduledMessageValidationError is the validation error returned by
+// ScheduledMessage.Validate if the designated constraints aren't met.
+type ScheduledMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledMessageValidationError) ErrorName() string { return "ScheduledMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduledMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduledMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledMessageValidationError{}
+
+// Validate checks the field values on GetScheduledMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetScheduledMessagesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	return nil
+}
+
+// GetScheduledMessagesRequestValidationError is the validation error returned
+// by GetScheduledMessagesRequest.Validate if the designated constraints
+// aren't met.
+type GetScheduledMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScheduledMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScheduledMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScheduledMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScheduledMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScheduledMessagesRequestValidationError) ErrorName() string {
+	return "GetScheduledMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScheduledMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScheduledMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScheduledMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScheduledMessagesRequestValidationError{}
+
+// Validate checks the field values on GetScheduledMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetScheduledMessagesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetScheduledMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetScheduledMessagesResponseValidationError{
+					field:  fmt.Sprintf("ScheduledMessages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetScheduledMessagesResponseValidationError is the validation error returned
+// by GetScheduledMessagesResponse.Validate if the designated constraints
+// aren't met.
+type GetScheduledMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScheduledMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScheduledMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScheduledMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScheduledMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScheduledMessagesResponseValidationError) ErrorName() string {
+	return "GetScheduledMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScheduledMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScheduledMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScheduledMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScheduledMessagesResponseValidationError{}
+
+// Validate checks the field values on UpdateScheduledMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateScheduledMessageRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ScheduledId
+
+	if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateScheduledMessageRequestValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateScheduledMessageRequestValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateScheduledMessageRequestValidationError is the validation error
+// returned by UpdateScheduledMessageRequest.Validate if the designated
+// constraints aren't met.
+type UpdateScheduledMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateScheduledMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateScheduledMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateScheduledMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateScheduledMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateScheduledMessageRequestValidationError) ErrorName() string {
+	return "UpdateScheduledMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateScheduledMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateScheduledMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateScheduledMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateScheduledMessageRequestValidationError{}
+
+// Validate checks the field values on CancelScheduledMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CancelScheduledMessageRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ScheduledId
+
+	return nil
+}
+
+// CancelScheduledMessageRequestValidationError is the validation error
+// returned by CancelScheduledMessageRequest.Validate if the designated
+// constraints aren't met.
+type CancelScheduledMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledMessageRequestValidationError) ErrorName() string {
+	return "CancelScheduledMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledMessageRequestValidationError{}
+
+// Validate checks the field values on TypingRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *TypingRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	return nil
+}
+
+// TypingRequestValidationError is the validation error returned by
+// TypingRequest.Validate if the designated constraints aren't met.
+type TypingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TypingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TypingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TypingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TypingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TypingRequestValidationError) ErrorName() string { return "TypingRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TypingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTypingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TypingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TypingRequestValidationError{}
+
+// Validate checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchMessagesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for Query
+
+	// no validation rules for AuthorId
+
+	if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchMessagesRequestValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchMessagesRequestValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HasAttachment
+
+	// no validation rules for InReplyTo
+
+	// no validation rules for BeforeMessage
+
+	// no validation rules for Limit
+
+	return nil
+}
+
+// SearchMessagesRequestValidationError is the validation error returned by
+// SearchMessagesRequest.Validate if the designated constraints aren't met.
+type SearchMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesRequestValidationError) ErrorName() string {
+	return "SearchMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesRequestValidationError{}
+
+// Validate checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchMessagesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReachedEnd
+
+	return nil
+}
+
+// SearchMessagesResponseValidationError is the validation error returned by
+// SearchMessagesResponse.Validate if the designated constraints aren't met.
+type SearchMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesResponseValidationError) ErrorName() string {
+	return "SearchMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesResponseValidationError{}
+
+// Validate checks the field values on BulkDeleteMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BulkDeleteMessagesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for AuthorId
+
+	if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkDeleteMessagesRequestValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkDeleteMessagesRequestValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Last
+
+	return nil
+}
+
+// BulkDeleteMessagesRequestValidationError is the validation error returned by
+// BulkDeleteMessagesRequest.Validate if the designated constraints aren't met.
+type BulkDeleteMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkDeleteMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkDeleteMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkDeleteMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkDeleteMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkDeleteMessagesRequestValidationError) ErrorName() string {
+	return "BulkDeleteMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkDeleteMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkDeleteMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkDeleteMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkDeleteMessagesRequestValidationError{}
+
+// Validate checks the field values on BulkDeleteMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BulkDeleteMessagesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// BulkDeleteMessagesResponseValidationError is the validation error returned
+// by BulkDeleteMessagesResponse.Validate if the designated constraints aren't met.
+type BulkDeleteMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkDeleteMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkDeleteMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkDeleteMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkDeleteMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkDeleteMessagesResponseValidationError) ErrorName() string {
+	return "BulkDeleteMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkDeleteMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkDeleteMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkDeleteMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkDeleteMessagesResponseValidationError{}
+
+// Validate checks the field values on GetMentionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMentionsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for BeforeMessage
+
+	// no validation rules for Limit
+
+	return nil
+}
+
+// GetMentionsRequestValidationError is the validation error returned by
+// GetMentionsRequest.Validate if the designated constraints aren't met.
+type GetMentionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMentionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMentionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMentionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMentionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMentionsRequestValidationError) ErrorName() string {
+	return "GetMentionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMentionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMentionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMentionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMentionsRequestValidationError{}
+
+// Validate checks the field values on GetMentionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMentionsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMentionsResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReachedEnd
+
+	return nil
+}
+
+// GetMentionsResponseValidationError is the validation error returned by
+// GetMentionsResponse.Validate if the designated constraints aren't met.
+type GetMentionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMentionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMentionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMentionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMentionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMentionsResponseValidationError) ErrorName() string {
+	return "GetMentionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMentionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMentionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMentionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMentionsResponseValidationError{}
+
+// Validate checks the field values on AckChannelRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *AckChannelRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	return nil
+}
+
+// AckChannelRequestValidationError is the validation error returned by
+// AckChannelRequest.Validate if the designated constraints aren't met.
+type AckChannelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AckChannelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AckChannelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AckChannelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AckChannelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AckChannelRequestValidationError) ErrorName() string {
+	return "AckChannelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AckChannelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAckChannelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AckChannelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AckChannelRequestValidationError{}
+
+// Validate checks the field values on GetReadStatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetReadStatesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	return nil
+}
+
+// GetReadStatesRequestValidationError is the validation error returned by
+// GetReadStatesRequest.Validate if the designated constraints aren't met.
+type GetReadStatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReadStatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReadStatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReadStatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReadStatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReadStatesRequestValidationError) ErrorName() string {
+	return "GetReadStatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReadStatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReadStatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReadStatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReadStatesRequestValidationError{}
+
+// Validate checks the field values on ChannelReadState with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ChannelReadState) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ChannelId
+
+	// no validation rules for LastReadId
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for MentionCount
+
+	return nil
+}
+
+// ChannelReadStateValidationError is the validation error returned by
+// ChannelReadState.Validate if the designated constraints aren't met.
+type ChannelReadStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelReadStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelReadStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelReadStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelReadStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelReadStateValidationError) ErrorName() string { return "ChannelReadStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChannelReadStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelReadState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelReadStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelReadStateValidationError{}
+
+// Validate checks the field values on GetReadStatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetReadStatesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetReadStates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReadStatesResponseValidationError{
+					field:  fmt.Sprintf("ReadStates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetReadStatesResponseValidationError is the validation error returned by
+// GetReadStatesResponse.Validate if the designated constraints aren't met.
+type GetReadStatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReadStatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReadStatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReadStatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReadStatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReadStatesResponseValidationError) ErrorName() string {
+	return "GetReadStatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReadStatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReadStatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReadStatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReadStatesResponseValidationError{}
+
+// Validate checks the field values on RestoreMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreMessageRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	return nil
+}
+
+// RestoreMessageRequestValidationError is the validation error returned by
+// RestoreMessageRequest.Validate if the designated constraints aren't met.
+type RestoreMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreMessageRequestValidationError) ErrorName() string {
+	return "RestoreMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreMessageRequestValidationError{}
+
+// Validate checks the field values on GetDeletedMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetDeletedMessagesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for BeforeMessage
+
+	// no validation rules for Limit
+
+	return nil
+}
+
+// GetDeletedMessagesRequestValidationError is the validation error returned by
+// GetDeletedMessagesRequest.Validate if the designated constraints aren't met.
+type GetDeletedMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeletedMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeletedMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeletedMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeletedMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeletedMessagesRequestValidationError) ErrorName() string {
+	return "GetDeletedMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeletedMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeletedMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeletedMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeletedMessagesRequestValidationError{}
+
+// Validate checks the field values on DeletedMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *DeletedMessage) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletedMessageValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DeletedBy
+
+	if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletedMessageValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeletedMessageValidationError is the validation error returned by
+// DeletedMessage.Validate if the designated constraints aren't met.
+type DeletedMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletedMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletedMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletedMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletedMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletedMessageValidationError) ErrorName() string { return "DeletedMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletedMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletedMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletedMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletedMessageValidationError{}
+
+// Validate checks the field values on GetDeletedMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetDeletedMessagesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDeletedMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReachedEnd
+
+	return nil
+}
+
+// GetDeletedMessagesResponseValidationError is the validation error returned
+// by GetDeletedMessagesResponse.Validate if the designated constraints aren't met.
+type GetDeletedMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeletedMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeletedMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeletedMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeletedMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeletedMessagesResponseValidationError) ErrorName() string {
+	return "GetDeletedMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeletedMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeletedMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeletedMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeletedMessagesResponseValidationError{}
+
+// Validate checks the field values on PinMessageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *PinMessageRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	return nil
+}
+
+// PinMessageRequestValidationError is the validation error returned by
+// PinMessageRequest.Validate if the designated constraints aren't met.
+type PinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinMessageRequestValidationError) ErrorName() string {
+	return "PinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinMessageRequestValidationError{}
+
+// Validate checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UnpinMessageRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	return nil
+}
+
+// UnpinMessageRequestValidationError is the validation error returned by
+// UnpinMessageRequest.Validate if the designated constraints aren't met.
+type UnpinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinMessageRequestValidationError) ErrorName() string {
+	return "UnpinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinMessageRequestValidationError{}
+
+// Validate checks the field values on GetPinnedMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetPinnedMessagesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	return nil
+}
+
+// GetPinnedMessagesRequestValidationError is the validation error returned by
+// GetPinnedMessagesRequest.Validate if the designated constraints aren't met.
+type GetPinnedMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPinnedMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPinnedMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPinnedMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPinnedMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPinnedMessagesRequestValidationError) ErrorName() string {
+	return "GetPinnedMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPinnedMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPinnedMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPinnedMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPinnedMessagesRequestValidationError{}
+
+// Validate checks the field values on GetPinnedMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetPinnedMessagesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPinnedMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetPinnedMessagesResponseValidationError is the validation error returned by
+// GetPinnedMessagesResponse.Validate if the designated constraints aren't met.
+type GetPinnedMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPinnedMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPinnedMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPinnedMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPinnedMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPinnedMessagesResponseValidationError) ErrorName() string {
+	return "GetPinnedMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPinnedMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPinnedMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPinnedMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPinnedMessagesResponseValidationError{}
+
+// Validate checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AddReactionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for Emoji
+
+	// no validation rules for Emote
+
+	return nil
+}
+
+// AddReactionRequestValidationError is the validation error returned by
+// AddReactionRequest.Validate if the designated constraints aren't met.
+type AddReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddReactionRequestValidationError) ErrorName() string {
+	return "AddReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddReactionRequestValidationError{}
+
+// Validate checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveReactionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for Emoji
+
+	// no validation rules for Emote
+
+	return nil
+}
+
+// RemoveReactionRequestValidationError is the validation error returned by
+// RemoveReactionRequest.Validate if the designated constraints aren't met.
+type RemoveReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveReactionRequestValidationError) ErrorName() string {
+	return "RemoveReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveReactionRequestValidationError{}
+
+// Validate checks the field values on Thread with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Thread) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for ThreadId
+
+	// no validation rules for Name
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ThreadValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastMessageAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ThreadValidationError{
+				field:  "LastMessageAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Archived
+
+	return nil
+}
+
+// ThreadValidationError is the validation error returned by Thread.Validate if
+// the designated constraints aren't met.
+type ThreadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ThreadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ThreadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ThreadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ThreadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ThreadValidationError) ErrorName() string { return "ThreadValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ThreadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sThread.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ThreadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ThreadValidationError{}
+
+// Validate checks the field values on CreateThreadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateThreadRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for Name
+
+	return nil
+}
+
+// CreateThreadRequestValidationError is the validation error returned by
+// CreateThreadRequest.Validate if the designated constraints aren't met.
+type CreateThreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateThreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateThreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateThreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateThreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateThreadRequestValidationError) ErrorName() string {
+	return "CreateThreadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateThreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateThreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateThreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateThreadRequestValidationError{}
+
+// Validate checks the field values on CreateThreadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateThreadResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetThread()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateThreadResponseValidationError{
+				field:  "Thread",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateThreadResponseValidationError is the validation error returned by
+// CreateThreadResponse.Validate if the designated constraints aren't met.
+type CreateThreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateThreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateThreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateThreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateThreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateThreadResponseValidationError) ErrorName() string {
+	return "CreateThreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateThreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateThreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateThreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateThreadResponseValidationError{}
+
+// Validate checks the field values on GetThreadMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetThreadMessagesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for ThreadId
+
+	// no validation rules for BeforeMessage
+
+	// no validation rules for Limit
+
+	return nil
+}
+
+// GetThreadMessagesRequestValidationError is the validation error returned by
+// GetThreadMessagesRequest.Validate if the designated constraints aren't met.
+type GetThreadMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetThreadMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetThreadMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetThreadMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetThreadMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetThreadMessagesRequestValidationError) ErrorName() string {
+	return "GetThreadMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetThreadMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetThreadMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetThreadMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetThreadMessagesRequestValidationError{}
+
+// Validate checks the field values on GetThreadMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetThreadMessagesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ReachedTop
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetThreadMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetThreadMessagesResponseValidationError is the validation error returned by
+// GetThreadMessagesResponse.Validate if the designated constraints aren't met.
+type GetThreadMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetThreadMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetThreadMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetThreadMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetThreadMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetThreadMessagesResponseValidationError) ErrorName() string {
+	return "GetThreadMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetThreadMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetThreadMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetThreadMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetThreadMessagesResponseValidationError{}
+
+// Validate checks the field values on GetActiveThreadsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetActiveThreadsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	return nil
+}
+
+// GetActiveThreadsRequestValidationError is the validation error returned by
+// GetActiveThreadsRequest.Validate if the designated constraints aren't met.
+type GetActiveThreadsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActiveThreadsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActiveThreadsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActiveThreadsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActiveThreadsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActiveThreadsRequestValidationError) ErrorName() string {
+	return "GetActiveThreadsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetActiveThreadsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActiveThreadsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActiveThreadsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActiveThreadsRequestValidationError{}
+
+// Validate checks the field values on GetActiveThreadsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetActiveThreadsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetThreads() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetActiveThreadsResponseValidationError{
+					field:  fmt.Sprintf("Threads[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetActiveThreadsResponseValidationError is the validation error returned by
+// GetActiveThreadsResponse.Validate if the designated constraints aren't met.
+type GetActiveThreadsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActiveThreadsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActiveThreadsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActiveThreadsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActiveThreadsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActiveThreadsResponseValidationError) ErrorName() string {
+	return "GetActiveThreadsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetActiveThreadsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActiveThreadsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActiveThreadsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActiveThreadsResponseValidationError{}
+
+// Validate checks the field values on MessageRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *MessageRevision) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Content
+
+	for idx, item := range m.GetEmbeds() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageRevisionValidationError{
+					field:  fmt.Sprintf("Embeds[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageRevisionValidationError{
+					field:  fmt.Sprintf("Actions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageRevisionValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetOverrides()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageRevisionValidationError{
+				field:  "Overrides",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetWrittenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageRevisionValidationError{
+				field:  "WrittenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetReplacedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageRevisionValidationError{
+				field:  "ReplacedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// MessageRevisionValidationError is the validation error returned by
+// MessageRevision.Validate if the designated constraints aren't met.
+type MessageRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageRevisionValidationError) ErrorName() string { return "MessageRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageRevisionValidationError{}
+
+// Validate checks the field values on GetMessageHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMessageHistoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	return nil
+}
+
+// GetMessageHistoryRequestValidationError is the validation error returned by
+// GetMessageHistoryRequest.Validate if the designated constraints aren't met.
+type GetMessageHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageHistoryRequestValidationError) ErrorName() string {
+	return "GetMessageHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageHistoryRequestValidationError{}
+
+// Validate checks the field values on GetMessageHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMessageHistoryResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMessageHistoryResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetMessageHistoryResponseValidationError is the validation error returned by
+// GetMessageHistoryResponse.Validate if the designated constraints aren't met.
+type GetMessageHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageHistoryResponseValidationError) ErrorName() string {
+	return "GetMessageHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageHistoryResponseValidationError{}
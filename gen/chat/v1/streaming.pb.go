@@ -36,6 +36,12 @@ type StreamEventsRequest struct {
 	//	*StreamEventsRequest_SubscribeToGuild_
 	//	*StreamEventsRequest_SubscribeToActions_
 	//	*StreamEventsRequest_SubscribeToHomeserverEvents_
+	//	*StreamEventsRequest_UpdateGuildChannels_
+	//	*StreamEventsRequest_AckHeartbeat_
+	//	*StreamEventsRequest_UnsubscribeFromGuild_
+	//	*StreamEventsRequest_UnsubscribeFromActions_
+	//	*StreamEventsRequest_UnsubscribeFromHomeserverEvents_
+	//	*StreamEventsRequest_UpdateGuildThreads_
 	Request isStreamEventsRequest_Request `protobuf_oneof:"request"`
 }
 
@@ -99,6 +105,48 @@ func (x *StreamEventsRequest) GetSubscribeToHomeserverEvents() *StreamEventsRequ
 	return nil
 }
 
+func (x *StreamEventsRequest) GetUpdateGuildChannels() *StreamEventsRequest_UpdateGuildChannels {
+	if x, ok := x.GetRequest().(*StreamEventsRequest_UpdateGuildChannels_); ok {
+		return x.UpdateGuildChannels
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetAckHeartbeat() *StreamEventsRequest_AckHeartbeat {
+	if x, ok := x.GetRequest().(*StreamEventsRequest_AckHeartbeat_); ok {
+		return x.AckHeartbeat
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetUnsubscribeFromGuild() *StreamEventsRequest_UnsubscribeFromGuild {
+	if x, ok := x.GetRequest().(*StreamEventsRequest_UnsubscribeFromGuild_); ok {
+		return x.UnsubscribeFromGuild
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetUnsubscribeFromActions() *StreamEventsRequest_UnsubscribeFromActions {
+	if x, ok := x.GetRequest().(*StreamEventsRequest_UnsubscribeFromActions_); ok {
+		return x.UnsubscribeFromActions
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetUnsubscribeFromHomeserverEvents() *StreamEventsRequest_UnsubscribeFromHomeserverEvents {
+	if x, ok := x.GetRequest().(*StreamEventsRequest_UnsubscribeFromHomeserverEvents_); ok {
+		return x.UnsubscribeFromHomeserverEvents
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetUpdateGuildThreads() *StreamEventsRequest_UpdateGuildThreads {
+	if x, ok := x.GetRequest().(*StreamEventsRequest_UpdateGuildThreads_); ok {
+		return x.UpdateGuildThreads
+	}
+	return nil
+}
+
 type isStreamEventsRequest_Request interface {
 	isStreamEventsRequest_Request()
 }
@@ -115,12 +163,48 @@ type StreamEventsRequest_SubscribeToHomeserverEvents_ struct {
 	SubscribeToHomeserverEvents *StreamEventsRequest_SubscribeToHomeserverEvents `protobuf:"bytes,3,opt,name=subscribe_to_homeserver_events,json=subscribeToHomeserverEvents,proto3,oneof"`
 }
 
+type StreamEventsRequest_UpdateGuildChannels_ struct {
+	UpdateGuildChannels *StreamEventsRequest_UpdateGuildChannels `protobuf:"bytes,4,opt,name=update_guild_channels,json=updateGuildChannels,proto3,oneof"`
+}
+
+type StreamEventsRequest_AckHeartbeat_ struct {
+	AckHeartbeat *StreamEventsRequest_AckHeartbeat `protobuf:"bytes,5,opt,name=ack_heartbeat,json=ackHeartbeat,proto3,oneof"`
+}
+
+type StreamEventsRequest_UnsubscribeFromGuild_ struct {
+	UnsubscribeFromGuild *StreamEventsRequest_UnsubscribeFromGuild `protobuf:"bytes,6,opt,name=unsubscribe_from_guild,json=unsubscribeFromGuild,proto3,oneof"`
+}
+
+type StreamEventsRequest_UnsubscribeFromActions_ struct {
+	UnsubscribeFromActions *StreamEventsRequest_UnsubscribeFromActions `protobuf:"bytes,7,opt,name=unsubscribe_from_actions,json=unsubscribeFromActions,proto3,oneof"`
+}
+
+type StreamEventsRequest_UnsubscribeFromHomeserverEvents_ struct {
+	UnsubscribeFromHomeserverEvents *StreamEventsRequest_UnsubscribeFromHomeserverEvents `protobuf:"bytes,8,opt,name=unsubscribe_from_homeserver_events,json=unsubscribeFromHomeserverEvents,proto3,oneof"`
+}
+
+type StreamEventsRequest_UpdateGuildThreads_ struct {
+	UpdateGuildThreads *StreamEventsRequest_UpdateGuildThreads `protobuf:"bytes,9,opt,name=update_guild_threads,json=updateGuildThreads,proto3,oneof"`
+}
+
 func (*StreamEventsRequest_SubscribeToGuild_) isStreamEventsRequest_Request() {}
 
 func (*StreamEventsRequest_SubscribeToActions_) isStreamEventsRequest_Request() {}
 
 func (*StreamEventsRequest_SubscribeToHomeserverEvents_) isStreamEventsRequest_Request() {}
 
+func (*StreamEventsRequest_UpdateGuildChannels_) isStreamEventsRequest_Request() {}
+
+func (*StreamEventsRequest_AckHeartbeat_) isStreamEventsRequest_Request() {}
+
+func (*StreamEventsRequest_UnsubscribeFromGuild_) isStreamEventsRequest_Request() {}
+
+func (*StreamEventsRequest_UnsubscribeFromActions_) isStreamEventsRequest_Request() {}
+
+func (*StreamEventsRequest_UnsubscribeFromHomeserverEvents_) isStreamEventsRequest_Request() {}
+
+func (*StreamEventsRequest_UpdateGuildThreads_) isStreamEventsRequest_Request() {}
+
 type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -142,7 +226,25 @@ type Event struct {
 	//	*Event_LeftMember
 	//	*Event_RoleMoved_
 	//	*Event_ProfileUpdated_
+	//	*Event_ResyncRequired_
+	//	*Event_Typing_
+	//	*Event_TypingStopped_
+	//	*Event_Heartbeat_
+	//	*Event_RequestFailed_
+	//	*Event_MessagePinned_
+	//	*Event_MessageUnpinned_
+	//	*Event_ReactionAdded_
+	//	*Event_ReactionRemoved_
+	//	*Event_ThreadCreated_
+	//	*Event_ThreadArchived_
+	//	*Event_ThreadUnarchived_
+	//	*Event_MessagesBulkDeleted_
+	//	*Event_MentionReceived_
+	//	*Event_ChannelAcked_
+	//	*Event_MessageRestored_
 	Event isEvent_Event `protobuf_oneof:"event"`
+	// sequence increases with every event of a subscription
+	Sequence uint64 `protobuf:"varint,17,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (x *Event) Reset() {
@@ -289,6 +391,125 @@ func (x *Event) GetProfileUpdated() *Event_ProfileUpdated {
 	return nil
 }
 
+func (x *Event) GetResyncRequired() *Event_ResyncRequired {
+	if x, ok := x.GetEvent().(*Event_ResyncRequired_); ok {
+		return x.ResyncRequired
+	}
+	return nil
+}
+
+func (x *Event) GetTyping() *Event_Typing {
+	if x, ok := x.GetEvent().(*Event_Typing_); ok {
+		return x.Typing
+	}
+	return nil
+}
+
+func (x *Event) GetTypingStopped() *Event_TypingStopped {
+	if x, ok := x.GetEvent().(*Event_TypingStopped_); ok {
+		return x.TypingStopped
+	}
+	return nil
+}
+
+func (x *Event) GetHeartbeat() *Event_Heartbeat {
+	if x, ok := x.GetEvent().(*Event_Heartbeat_); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *Event) GetRequestFailed() *Event_RequestFailed {
+	if x, ok := x.GetEvent().(*Event_RequestFailed_); ok {
+		return x.RequestFailed
+	}
+	return nil
+}
+
+func (x *Event) GetMessagePinned() *Event_MessagePinned {
+	if x, ok := x.GetEvent().(*Event_MessagePinned_); ok {
+		return x.MessagePinned
+	}
+	return nil
+}
+
+func (x *Event) GetMessageUnpinned() *Event_MessageUnpinned {
+	if x, ok := x.GetEvent().(*Event_MessageUnpinned_); ok {
+		return x.MessageUnpinned
+	}
+	return nil
+}
+
+func (x *Event) GetReactionAdded() *Event_ReactionAdded {
+	if x, ok := x.GetEvent().(*Event_ReactionAdded_); ok {
+		return x.ReactionAdded
+	}
+	return nil
+}
+
+func (x *Event) GetReactionRemoved() *Event_ReactionRemoved {
+	if x, ok := x.GetEvent().(*Event_ReactionRemoved_); ok {
+		return x.ReactionRemoved
+	}
+	return nil
+}
+
+func (x *Event) GetThreadCreated() *Event_ThreadCreated {
+	if x, ok := x.GetEvent().(*Event_ThreadCreated_); ok {
+		return x.ThreadCreated
+	}
+	return nil
+}
+
+func (x *Event) GetThreadArchived() *Event_ThreadArchived {
+	if x, ok := x.GetEvent().(*Event_ThreadArchived_); ok {
+		return x.ThreadArchived
+	}
+	return nil
+}
+
+func (x *Event) GetThreadUnarchived() *Event_ThreadUnarchived {
+	if x, ok := x.GetEvent().(*Event_ThreadUnarchived_); ok {
+		return x.ThreadUnarchived
+	}
+	return nil
+}
+
+func (x *Event) GetMessagesBulkDeleted() *Event_MessagesBulkDeleted {
+	if x, ok := x.GetEvent().(*Event_MessagesBulkDeleted_); ok {
+		return x.MessagesBulkDeleted
+	}
+	return nil
+}
+
+func (x *Event) GetMentionReceived() *Event_MentionReceived {
+	if x, ok := x.GetEvent().(*Event_MentionReceived_); ok {
+		return x.MentionReceived
+	}
+	return nil
+}
+
+func (x *Event) GetChannelAcked() *Event_ChannelAcked {
+	if x, ok := x.GetEvent().(*Event_ChannelAcked_); ok {
+		return x.ChannelAcked
+	}
+	return nil
+}
+
+func (x *Event) GetMessageRestored() *Event_MessageRestored {
+	if x, ok := x.GetEvent().(*Event_MessageRestored_); ok {
+		return x.MessageRestored
+	}
+	return nil
+}
+
+func (x *Event) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 type isEvent_Event interface {
 	isEvent_Event()
 }
@@ -353,6 +574,70 @@ type Event_ProfileUpdated_ struct {
 	ProfileUpdated *Event_ProfileUpdated `protobuf:"bytes,15,opt,name=profile_updated,json=profileUpdated,proto3,oneof"`
 }
 
+type Event_ResyncRequired_ struct {
+	ResyncRequired *Event_ResyncRequired `protobuf:"bytes,16,opt,name=resync_required,json=resyncRequired,proto3,oneof"`
+}
+
+type Event_Typing_ struct {
+	Typing *Event_Typing `protobuf:"bytes,18,opt,name=typing,proto3,oneof"`
+}
+
+type Event_TypingStopped_ struct {
+	TypingStopped *Event_TypingStopped `protobuf:"bytes,19,opt,name=typing_stopped,json=typingStopped,proto3,oneof"`
+}
+
+type Event_Heartbeat_ struct {
+	Heartbeat *Event_Heartbeat `protobuf:"bytes,20,opt,name=heartbeat,proto3,oneof"`
+}
+
+type Event_RequestFailed_ struct {
+	RequestFailed *Event_RequestFailed `protobuf:"bytes,21,opt,name=request_failed,json=requestFailed,proto3,oneof"`
+}
+
+type Event_MessagePinned_ struct {
+	MessagePinned *Event_MessagePinned `protobuf:"bytes,22,opt,name=message_pinned,json=messagePinned,proto3,oneof"`
+}
+
+type Event_MessageUnpinned_ struct {
+	MessageUnpinned *Event_MessageUnpinned `protobuf:"bytes,23,opt,name=message_unpinned,json=messageUnpinned,proto3,oneof"`
+}
+
+type Event_ReactionAdded_ struct {
+	ReactionAdded *Event_ReactionAdded `protobuf:"bytes,24,opt,name=reaction_added,json=reactionAdded,proto3,oneof"`
+}
+
+type Event_ReactionRemoved_ struct {
+	ReactionRemoved *Event_ReactionRemoved `protobuf:"bytes,25,opt,name=reaction_removed,json=reactionRemoved,proto3,oneof"`
+}
+
+type Event_ThreadCreated_ struct {
+	ThreadCreated *Event_ThreadCreated `protobuf:"bytes,26,opt,name=thread_created,json=threadCreated,proto3,oneof"`
+}
+
+type Event_ThreadArchived_ struct {
+	ThreadArchived *Event_ThreadArchived `protobuf:"bytes,27,opt,name=thread_archived,json=threadArchived,proto3,oneof"`
+}
+
+type Event_ThreadUnarchived_ struct {
+	ThreadUnarchived *Event_ThreadUnarchived `protobuf:"bytes,28,opt,name=thread_unarchived,json=threadUnarchived,proto3,oneof"`
+}
+
+type Event_MessagesBulkDeleted_ struct {
+	MessagesBulkDeleted *Event_MessagesBulkDeleted `protobuf:"bytes,29,opt,name=messages_bulk_deleted,json=messagesBulkDeleted,proto3,oneof"`
+}
+
+type Event_MentionReceived_ struct {
+	MentionReceived *Event_MentionReceived `protobuf:"bytes,30,opt,name=mention_received,json=mentionReceived,proto3,oneof"`
+}
+
+type Event_ChannelAcked_ struct {
+	ChannelAcked *Event_ChannelAcked `protobuf:"bytes,31,opt,name=channel_acked,json=channelAcked,proto3,oneof"`
+}
+
+type Event_MessageRestored_ struct {
+	MessageRestored *Event_MessageRestored `protobuf:"bytes,32,opt,name=message_restored,json=messageRestored,proto3,oneof"`
+}
+
 func (*Event_GuildAddedToList_) isEvent_Event() {}
 
 func (*Event_GuildRemovedFromList_) isEvent_Event() {}
@@ -383,12 +668,52 @@ func (*Event_RoleMoved_) isEvent_Event() {}
 
 func (*Event_ProfileUpdated_) isEvent_Event() {}
 
+func (*Event_ResyncRequired_) isEvent_Event() {}
+
+func (*Event_Typing_) isEvent_Event() {}
+
+func (*Event_TypingStopped_) isEvent_Event() {}
+
+func (*Event_Heartbeat_) isEvent_Event() {}
+
+func (*Event_RequestFailed_) isEvent_Event() {}
+
+func (*Event_MessagePinned_) isEvent_Event() {}
+
+func (*Event_MessageUnpinned_) isEvent_Event() {}
+
+func (*Event_ReactionAdded_) isEvent_Event() {}
+
+func (*Event_ReactionRemoved_) isEvent_Event() {}
+
+func (*Event_ThreadCreated_) isEvent_Event() {}
+
+func (*Event_ThreadArchived_) isEvent_Event() {}
+
+func (*Event_ThreadUnarchived_) isEvent_Event() {}
+
+func (*Event_MessagesBulkDeleted_) isEvent_Event() {}
+
+func (*Event_MentionReceived_) isEvent_Event() {}
+
+func (*Event_ChannelAcked_) isEvent_Event() {}
+
+func (*Event_MessageRestored_) isEvent_Event() {}
+
+// resume_after is the sequence of the last event the client received,
+// events after it are replayed if they are still available
 type StreamEventsRequest_SubscribeToGuild struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	GuildId     uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ResumeAfter uint64 `protobuf:"varint,2,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
+	// message events are only sent for these channels, or for every
+	// channel of the guild if empty
+	ChannelIds []uint64 `protobuf:"varint,3,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	// events about messages in threads are only sent for these threads
+	ThreadIds []uint64 `protobuf:"varint,4,rep,packed,name=thread_ids,json=threadIds,proto3" json:"thread_ids,omitempty"`
 }
 
 func (x *StreamEventsRequest_SubscribeToGuild) Reset() {
@@ -430,10 +755,33 @@ func (x *StreamEventsRequest_SubscribeToGuild) GetGuildId() uint64 {
 	return 0
 }
 
+func (x *StreamEventsRequest_SubscribeToGuild) GetResumeAfter() uint64 {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return 0
+}
+
+func (x *StreamEventsRequest_SubscribeToGuild) GetChannelIds() []uint64 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest_SubscribeToGuild) GetThreadIds() []uint64 {
+	if x != nil {
+		return x.ThreadIds
+	}
+	return nil
+}
+
 type StreamEventsRequest_SubscribeToActions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ResumeAfter uint64 `protobuf:"varint,1,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
 }
 
 func (x *StreamEventsRequest_SubscribeToActions) Reset() {
@@ -468,10 +816,19 @@ func (*StreamEventsRequest_SubscribeToActions) Descriptor() ([]byte, []int) {
 	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 1}
 }
 
+func (x *StreamEventsRequest_SubscribeToActions) GetResumeAfter() uint64 {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return 0
+}
+
 type StreamEventsRequest_SubscribeToHomeserverEvents struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ResumeAfter uint64 `protobuf:"varint,1,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
 }
 
 func (x *StreamEventsRequest_SubscribeToHomeserverEvents) Reset() {
@@ -506,17 +863,23 @@ func (*StreamEventsRequest_SubscribeToHomeserverEvents) Descriptor() ([]byte, []
 	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 2}
 }
 
-type Event_MessageSent struct {
+func (x *StreamEventsRequest_SubscribeToHomeserverEvents) GetResumeAfter() uint64 {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return 0
+}
+
+type StreamEventsRequest_UnsubscribeFromGuild struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EchoId  uint64      `protobuf:"varint,1,opt,name=echo_id,json=echoId,proto3" json:"echo_id,omitempty"`
-	Message *v1.Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 }
 
-func (x *Event_MessageSent) Reset() {
-	*x = Event_MessageSent{}
+func (x *StreamEventsRequest_UnsubscribeFromGuild) Reset() {
+	*x = StreamEventsRequest_UnsubscribeFromGuild{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_v1_streaming_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,13 +887,13 @@ func (x *Event_MessageSent) Reset() {
 	}
 }
 
-func (x *Event_MessageSent) String() string {
+func (x *StreamEventsRequest_UnsubscribeFromGuild) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_MessageSent) ProtoMessage() {}
+func (*StreamEventsRequest_UnsubscribeFromGuild) ProtoMessage() {}
 
-func (x *Event_MessageSent) ProtoReflect() protoreflect.Message {
+func (x *StreamEventsRequest_UnsubscribeFromGuild) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_v1_streaming_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,48 +905,26 @@ func (x *Event_MessageSent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_MessageSent.ProtoReflect.Descriptor instead.
-func (*Event_MessageSent) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use StreamEventsRequest_UnsubscribeFromGuild.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest_UnsubscribeFromGuild) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 3}
 }
 
-func (x *Event_MessageSent) GetEchoId() uint64 {
+func (x *StreamEventsRequest_UnsubscribeFromGuild) GetGuildId() uint64 {
 	if x != nil {
-		return x.EchoId
+		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_MessageSent) GetMessage() *v1.Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-type Event_MessageUpdated struct {
+type StreamEventsRequest_UnsubscribeFromActions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GuildId           uint64               `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	ChannelId         uint64               `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	MessageId         uint64               `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	EditedAt          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
-	Content           string               `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	UpdateContent     bool                 `protobuf:"varint,6,opt,name=update_content,json=updateContent,proto3" json:"update_content,omitempty"`
-	Embeds            []*v1.Embed          `protobuf:"bytes,7,rep,name=embeds,proto3" json:"embeds,omitempty"`
-	UpdateEmbeds      bool                 `protobuf:"varint,8,opt,name=update_embeds,json=updateEmbeds,proto3" json:"update_embeds,omitempty"`
-	Actions           []*v1.Action         `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
-	UpdateActions     bool                 `protobuf:"varint,10,opt,name=update_actions,json=updateActions,proto3" json:"update_actions,omitempty"`
-	Attachments       []*v1.Attachment     `protobuf:"bytes,11,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	UpdateAttachments bool                 `protobuf:"varint,12,opt,name=update_attachments,json=updateAttachments,proto3" json:"update_attachments,omitempty"`
-	Overrides         *v1.Override         `protobuf:"bytes,13,opt,name=overrides,proto3" json:"overrides,omitempty"`
-	UpdateOverrides   bool                 `protobuf:"varint,14,opt,name=update_overrides,json=updateOverrides,proto3" json:"update_overrides,omitempty"`
 }
 
-func (x *Event_MessageUpdated) Reset() {
-	*x = Event_MessageUpdated{}
+func (x *StreamEventsRequest_UnsubscribeFromActions) Reset() {
+	*x = StreamEventsRequest_UnsubscribeFromActions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_v1_streaming_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,13 +932,13 @@ func (x *Event_MessageUpdated) Reset() {
 	}
 }
 
-func (x *Event_MessageUpdated) String() string {
+func (x *StreamEventsRequest_UnsubscribeFromActions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_MessageUpdated) ProtoMessage() {}
+func (*StreamEventsRequest_UnsubscribeFromActions) ProtoMessage() {}
 
-func (x *Event_MessageUpdated) ProtoReflect() protoreflect.Message {
+func (x *StreamEventsRequest_UnsubscribeFromActions) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_v1_streaming_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -609,136 +950,1416 @@ func (x *Event_MessageUpdated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_MessageUpdated.ProtoReflect.Descriptor instead.
-func (*Event_MessageUpdated) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use StreamEventsRequest_UnsubscribeFromActions.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest_UnsubscribeFromActions) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 4}
 }
 
-func (x *Event_MessageUpdated) GetGuildId() uint64 {
-	if x != nil {
-		return x.GuildId
-	}
-	return 0
+type StreamEventsRequest_UnsubscribeFromHomeserverEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Event_MessageUpdated) GetChannelId() uint64 {
-	if x != nil {
-		return x.ChannelId
+func (x *StreamEventsRequest_UnsubscribeFromHomeserverEvents) Reset() {
+	*x = StreamEventsRequest_UnsubscribeFromHomeserverEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Event_MessageUpdated) GetMessageId() uint64 {
-	if x != nil {
-		return x.MessageId
-	}
-	return 0
+func (x *StreamEventsRequest_UnsubscribeFromHomeserverEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest_UnsubscribeFromHomeserverEvents) ProtoMessage() {}
+
+func (x *StreamEventsRequest_UnsubscribeFromHomeserverEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest_UnsubscribeFromHomeserverEvents.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest_UnsubscribeFromHomeserverEvents) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 5}
+}
+
+// Acknowledges a Heartbeat event, streams that leave too many
+// heartbeats unacknowledged are closed
+type StreamEventsRequest_AckHeartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamEventsRequest_AckHeartbeat) Reset() {
+	*x = StreamEventsRequest_AckHeartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsRequest_AckHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest_AckHeartbeat) ProtoMessage() {}
+
+func (x *StreamEventsRequest_AckHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest_AckHeartbeat.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest_AckHeartbeat) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 6}
+}
+
+// Changes the channels of an existing guild subscription
+type StreamEventsRequest_UpdateGuildChannels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId    uint64   `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelIds []uint64 `protobuf:"varint,2,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+}
+
+func (x *StreamEventsRequest_UpdateGuildChannels) Reset() {
+	*x = StreamEventsRequest_UpdateGuildChannels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsRequest_UpdateGuildChannels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest_UpdateGuildChannels) ProtoMessage() {}
+
+func (x *StreamEventsRequest_UpdateGuildChannels) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest_UpdateGuildChannels.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest_UpdateGuildChannels) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *StreamEventsRequest_UpdateGuildChannels) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *StreamEventsRequest_UpdateGuildChannels) GetChannelIds() []uint64 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+// Changes the threads of an existing guild subscription
+type StreamEventsRequest_UpdateGuildThreads struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64   `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ThreadIds []uint64 `protobuf:"varint,2,rep,packed,name=thread_ids,json=threadIds,proto3" json:"thread_ids,omitempty"`
+}
+
+func (x *StreamEventsRequest_UpdateGuildThreads) Reset() {
+	*x = StreamEventsRequest_UpdateGuildThreads{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsRequest_UpdateGuildThreads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest_UpdateGuildThreads) ProtoMessage() {}
+
+func (x *StreamEventsRequest_UpdateGuildThreads) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest_UpdateGuildThreads.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest_UpdateGuildThreads) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{0, 8}
+}
+
+func (x *StreamEventsRequest_UpdateGuildThreads) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *StreamEventsRequest_UpdateGuildThreads) GetThreadIds() []uint64 {
+	if x != nil {
+		return x.ThreadIds
+	}
+	return nil
+}
+
+type Event_MessageSent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EchoId  uint64      `protobuf:"varint,1,opt,name=echo_id,json=echoId,proto3" json:"echo_id,omitempty"`
+	Message *v1.Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Event_MessageSent) Reset() {
+	*x = Event_MessageSent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MessageSent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MessageSent) ProtoMessage() {}
+
+func (x *Event_MessageSent) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MessageSent.ProtoReflect.Descriptor instead.
+func (*Event_MessageSent) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Event_MessageSent) GetEchoId() uint64 {
+	if x != nil {
+		return x.EchoId
+	}
+	return 0
+}
+
+func (x *Event_MessageSent) GetMessage() *v1.Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Event_MessageUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId           uint64               `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId         uint64               `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId         uint64               `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	EditedAt          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Content           string               `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	UpdateContent     bool                 `protobuf:"varint,6,opt,name=update_content,json=updateContent,proto3" json:"update_content,omitempty"`
+	Embeds            []*v1.Embed          `protobuf:"bytes,7,rep,name=embeds,proto3" json:"embeds,omitempty"`
+	UpdateEmbeds      bool                 `protobuf:"varint,8,opt,name=update_embeds,json=updateEmbeds,proto3" json:"update_embeds,omitempty"`
+	Actions           []*v1.Action         `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+	UpdateActions     bool                 `protobuf:"varint,10,opt,name=update_actions,json=updateActions,proto3" json:"update_actions,omitempty"`
+	Attachments       []*v1.Attachment     `protobuf:"bytes,11,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	UpdateAttachments bool                 `protobuf:"varint,12,opt,name=update_attachments,json=updateAttachments,proto3" json:"update_attachments,omitempty"`
+	Overrides         *v1.Override         `protobuf:"bytes,13,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	UpdateOverrides   bool                 `protobuf:"varint,14,opt,name=update_overrides,json=updateOverrides,proto3" json:"update_overrides,omitempty"`
+	ThreadId          uint64               `protobuf:"varint,15,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+}
+
+func (x *Event_MessageUpdated) Reset() {
+	*x = Event_MessageUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MessageUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MessageUpdated) ProtoMessage() {}
+
+func (x *Event_MessageUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MessageUpdated.ProtoReflect.Descriptor instead.
+func (*Event_MessageUpdated) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Event_MessageUpdated) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_MessageUpdated) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_MessageUpdated) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
 }
 
 func (x *Event_MessageUpdated) GetEditedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.EditedAt
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Event_MessageUpdated) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Event_MessageUpdated) GetUpdateContent() bool {
+	if x != nil {
+		return x.UpdateContent
+	}
+	return false
+}
+
+func (x *Event_MessageUpdated) GetEmbeds() []*v1.Embed {
+	if x != nil {
+		return x.Embeds
+	}
+	return nil
+}
+
+func (x *Event_MessageUpdated) GetUpdateEmbeds() bool {
+	if x != nil {
+		return x.UpdateEmbeds
+	}
+	return false
+}
+
+func (x *Event_MessageUpdated) GetActions() []*v1.Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *Event_MessageUpdated) GetUpdateActions() bool {
+	if x != nil {
+		return x.UpdateActions
+	}
+	return false
+}
+
+func (x *Event_MessageUpdated) GetAttachments() []*v1.Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Event_MessageUpdated) GetUpdateAttachments() bool {
+	if x != nil {
+		return x.UpdateAttachments
+	}
+	return false
+}
+
+func (x *Event_MessageUpdated) GetOverrides() *v1.Override {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *Event_MessageUpdated) GetUpdateOverrides() bool {
+	if x != nil {
+		return x.UpdateOverrides
+	}
+	return false
+}
+
+func (x *Event_MessageUpdated) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+type Event_MessageDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ThreadId  uint64 `protobuf:"varint,4,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+}
+
+func (x *Event_MessageDeleted) Reset() {
+	*x = Event_MessageDeleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MessageDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MessageDeleted) ProtoMessage() {}
+
+func (x *Event_MessageDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MessageDeleted.ProtoReflect.Descriptor instead.
+func (*Event_MessageDeleted) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Event_MessageDeleted) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_MessageDeleted) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_MessageDeleted) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Event_MessageDeleted) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+// Sent instead of a MessageDeleted for every message removed by a bulk
+// delete
+type Event_MessagesBulkDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId    uint64   `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId  uint64   `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageIds []uint64 `protobuf:"varint,3,rep,packed,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	// the thread the messages were posted in, 0 if they were posted
+	// directly in the channel
+	ThreadId uint64 `protobuf:"varint,4,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+}
+
+func (x *Event_MessagesBulkDeleted) Reset() {
+	*x = Event_MessagesBulkDeleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MessagesBulkDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MessagesBulkDeleted) ProtoMessage() {}
+
+func (x *Event_MessagesBulkDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MessagesBulkDeleted.ProtoReflect.Descriptor instead.
+func (*Event_MessagesBulkDeleted) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *Event_MessagesBulkDeleted) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_MessagesBulkDeleted) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_MessagesBulkDeleted) GetMessageIds() []uint64 {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+func (x *Event_MessagesBulkDeleted) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+// Sent as a homeserver event to the users a new or edited message
+// mentions
+type Event_MentionReceived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *v1.Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Event_MentionReceived) Reset() {
+	*x = Event_MentionReceived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MentionReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MentionReceived) ProtoMessage() {}
+
+func (x *Event_MentionReceived) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MentionReceived.ProtoReflect.Descriptor instead.
+func (*Event_MentionReceived) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *Event_MentionReceived) GetMessage() *v1.Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Sent as a homeserver event when you acknowledge a channel, so your other
+// devices can update their read marker
+type Event_ChannelAcked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *Event_ChannelAcked) Reset() {
+	*x = Event_ChannelAcked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ChannelAcked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ChannelAcked) ProtoMessage() {}
+
+func (x *Event_ChannelAcked) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ChannelAcked.ProtoReflect.Descriptor instead.
+func (*Event_ChannelAcked) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *Event_ChannelAcked) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_ChannelAcked) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ChannelAcked) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// Sent when a moderator restores a deleted message
+type Event_MessageRestored struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *v1.Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Event_MessageRestored) Reset() {
+	*x = Event_MessageRestored{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MessageRestored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MessageRestored) ProtoMessage() {}
+
+func (x *Event_MessageRestored) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MessageRestored.ProtoReflect.Descriptor instead.
+func (*Event_MessageRestored) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 6}
+}
+
+func (x *Event_MessageRestored) GetMessage() *v1.Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Event_MessagePinned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	PinnedBy  uint64 `protobuf:"varint,4,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`
+}
+
+func (x *Event_MessagePinned) Reset() {
+	*x = Event_MessagePinned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MessagePinned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MessagePinned) ProtoMessage() {}
+
+func (x *Event_MessagePinned) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MessagePinned.ProtoReflect.Descriptor instead.
+func (*Event_MessagePinned) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 7}
+}
+
+func (x *Event_MessagePinned) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_MessagePinned) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_MessagePinned) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Event_MessagePinned) GetPinnedBy() uint64 {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return 0
+}
+
+type Event_MessageUnpinned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *Event_MessageUnpinned) Reset() {
+	*x = Event_MessageUnpinned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_MessageUnpinned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MessageUnpinned) ProtoMessage() {}
+
+func (x *Event_MessageUnpinned) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_MessageUnpinned.ProtoReflect.Descriptor instead.
+func (*Event_MessageUnpinned) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 8}
+}
+
+func (x *Event_MessageUnpinned) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_MessageUnpinned) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_MessageUnpinned) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type Event_ReactionAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserId    uint64 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the reaction with its updated count
+	Reaction *v1.Reaction `protobuf:"bytes,5,opt,name=reaction,proto3" json:"reaction,omitempty"`
+}
+
+func (x *Event_ReactionAdded) Reset() {
+	*x = Event_ReactionAdded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ReactionAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ReactionAdded) ProtoMessage() {}
+
+func (x *Event_ReactionAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ReactionAdded.ProtoReflect.Descriptor instead.
+func (*Event_ReactionAdded) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 9}
+}
+
+func (x *Event_ReactionAdded) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_ReactionAdded) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ReactionAdded) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Event_ReactionAdded) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Event_ReactionAdded) GetReaction() *v1.Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type Event_ThreadCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thread *Thread `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+}
+
+func (x *Event_ThreadCreated) Reset() {
+	*x = Event_ThreadCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ThreadCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ThreadCreated) ProtoMessage() {}
+
+func (x *Event_ThreadCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ThreadCreated.ProtoReflect.Descriptor instead.
+func (*Event_ThreadCreated) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 10}
+}
+
+func (x *Event_ThreadCreated) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+// Sent when a thread had no messages for a while, posting in it
+// unarchives it
+type Event_ThreadArchived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ThreadId  uint64 `protobuf:"varint,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+}
+
+func (x *Event_ThreadArchived) Reset() {
+	*x = Event_ThreadArchived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ThreadArchived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ThreadArchived) ProtoMessage() {}
+
+func (x *Event_ThreadArchived) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ThreadArchived.ProtoReflect.Descriptor instead.
+func (*Event_ThreadArchived) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 11}
+}
+
+func (x *Event_ThreadArchived) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_ThreadArchived) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ThreadArchived) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+type Event_ThreadUnarchived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ThreadId  uint64 `protobuf:"varint,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+}
+
+func (x *Event_ThreadUnarchived) Reset() {
+	*x = Event_ThreadUnarchived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ThreadUnarchived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ThreadUnarchived) ProtoMessage() {}
+
+func (x *Event_ThreadUnarchived) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ThreadUnarchived.ProtoReflect.Descriptor instead.
+func (*Event_ThreadUnarchived) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 12}
+}
+
+func (x *Event_ThreadUnarchived) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_ThreadUnarchived) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ThreadUnarchived) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+type Event_ReactionRemoved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserId    uint64 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the reaction with its updated count
+	Reaction *v1.Reaction `protobuf:"bytes,5,opt,name=reaction,proto3" json:"reaction,omitempty"`
+}
+
+func (x *Event_ReactionRemoved) Reset() {
+	*x = Event_ReactionRemoved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ReactionRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ReactionRemoved) ProtoMessage() {}
+
+func (x *Event_ReactionRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ReactionRemoved.ProtoReflect.Descriptor instead.
+func (*Event_ReactionRemoved) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 13}
+}
+
+func (x *Event_ReactionRemoved) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *Event_ReactionRemoved) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ReactionRemoved) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Event_ReactionRemoved) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Event_ReactionRemoved) GetReaction() *v1.Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type Event_ChannelCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId  uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PreviousId uint64 `protobuf:"varint,4,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	NextId     uint64 `protobuf:"varint,5,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	IsCategory bool   `protobuf:"varint,6,opt,name=is_category,json=isCategory,proto3" json:"is_category,omitempty"`
+}
+
+func (x *Event_ChannelCreated) Reset() {
+	*x = Event_ChannelCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ChannelCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ChannelCreated) ProtoMessage() {}
+
+func (x *Event_ChannelCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ChannelCreated.ProtoReflect.Descriptor instead.
+func (*Event_ChannelCreated) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 14}
+}
+
+func (x *Event_ChannelCreated) GetGuildId() uint64 {
+	if x != nil {
+		return x.GuildId
 	}
-	return nil
+	return 0
 }
 
-func (x *Event_MessageUpdated) GetContent() string {
+func (x *Event_ChannelCreated) GetChannelId() uint64 {
 	if x != nil {
-		return x.Content
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ChannelCreated) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Event_MessageUpdated) GetUpdateContent() bool {
+func (x *Event_ChannelCreated) GetPreviousId() uint64 {
 	if x != nil {
-		return x.UpdateContent
+		return x.PreviousId
 	}
-	return false
+	return 0
 }
 
-func (x *Event_MessageUpdated) GetEmbeds() []*v1.Embed {
+func (x *Event_ChannelCreated) GetNextId() uint64 {
 	if x != nil {
-		return x.Embeds
+		return x.NextId
 	}
-	return nil
+	return 0
 }
 
-func (x *Event_MessageUpdated) GetUpdateEmbeds() bool {
+func (x *Event_ChannelCreated) GetIsCategory() bool {
 	if x != nil {
-		return x.UpdateEmbeds
+		return x.IsCategory
 	}
 	return false
 }
 
-func (x *Event_MessageUpdated) GetActions() []*v1.Action {
+type Event_ChannelUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId        uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId      uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UpdateName     bool   `protobuf:"varint,4,opt,name=update_name,json=updateName,proto3" json:"update_name,omitempty"`
+	PreviousId     uint64 `protobuf:"varint,5,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	NextId         uint64 `protobuf:"varint,6,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	UpdateOrder    bool   `protobuf:"varint,7,opt,name=update_order,json=updateOrder,proto3" json:"update_order,omitempty"`
+	SlowMode       uint32 `protobuf:"varint,8,opt,name=slow_mode,json=slowMode,proto3" json:"slow_mode,omitempty"`
+	UpdateSlowMode bool   `protobuf:"varint,9,opt,name=update_slow_mode,json=updateSlowMode,proto3" json:"update_slow_mode,omitempty"`
+}
+
+func (x *Event_ChannelUpdated) Reset() {
+	*x = Event_ChannelUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ChannelUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ChannelUpdated) ProtoMessage() {}
+
+func (x *Event_ChannelUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ChannelUpdated.ProtoReflect.Descriptor instead.
+func (*Event_ChannelUpdated) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 15}
+}
+
+func (x *Event_ChannelUpdated) GetGuildId() uint64 {
 	if x != nil {
-		return x.Actions
+		return x.GuildId
 	}
-	return nil
+	return 0
 }
 
-func (x *Event_MessageUpdated) GetUpdateActions() bool {
+func (x *Event_ChannelUpdated) GetChannelId() uint64 {
 	if x != nil {
-		return x.UpdateActions
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ChannelUpdated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event_ChannelUpdated) GetUpdateName() bool {
+	if x != nil {
+		return x.UpdateName
 	}
 	return false
 }
 
-func (x *Event_MessageUpdated) GetAttachments() []*v1.Attachment {
+func (x *Event_ChannelUpdated) GetPreviousId() uint64 {
 	if x != nil {
-		return x.Attachments
+		return x.PreviousId
 	}
-	return nil
+	return 0
 }
 
-func (x *Event_MessageUpdated) GetUpdateAttachments() bool {
+func (x *Event_ChannelUpdated) GetNextId() uint64 {
 	if x != nil {
-		return x.UpdateAttachments
+		return x.NextId
+	}
+	return 0
+}
+
+func (x *Event_ChannelUpdated) GetUpdateOrder() bool {
+	if x != nil {
+		return x.UpdateOrder
 	}
 	return false
 }
 
-func (x *Event_MessageUpdated) GetOverrides() *v1.Override {
+func (x *Event_ChannelUpdated) GetSlowMode() uint32 {
 	if x != nil {
-		return x.Overrides
+		return x.SlowMode
 	}
-	return nil
+	return 0
 }
 
-func (x *Event_MessageUpdated) GetUpdateOverrides() bool {
+func (x *Event_ChannelUpdated) GetUpdateSlowMode() bool {
 	if x != nil {
-		return x.UpdateOverrides
+		return x.UpdateSlowMode
 	}
 	return false
 }
 
-type Event_MessageDeleted struct {
+type Event_ChannelDeleted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 }
 
-func (x *Event_MessageDeleted) Reset() {
-	*x = Event_MessageDeleted{}
+func (x *Event_ChannelDeleted) Reset() {
+	*x = Event_ChannelDeleted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[7]
+		mi := &file_chat_v1_streaming_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_MessageDeleted) String() string {
+func (x *Event_ChannelDeleted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_MessageDeleted) ProtoMessage() {}
+func (*Event_ChannelDeleted) ProtoMessage() {}
 
-func (x *Event_MessageDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[7]
+func (x *Event_ChannelDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,62 +2370,52 @@ func (x *Event_MessageDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_MessageDeleted.ProtoReflect.Descriptor instead.
-func (*Event_MessageDeleted) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 2}
+// Deprecated: Use Event_ChannelDeleted.ProtoReflect.Descriptor instead.
+func (*Event_ChannelDeleted) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 16}
 }
 
-func (x *Event_MessageDeleted) GetGuildId() uint64 {
+func (x *Event_ChannelDeleted) GetGuildId() uint64 {
 	if x != nil {
 		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_MessageDeleted) GetChannelId() uint64 {
+func (x *Event_ChannelDeleted) GetChannelId() uint64 {
 	if x != nil {
 		return x.ChannelId
 	}
 	return 0
 }
 
-func (x *Event_MessageDeleted) GetMessageId() uint64 {
-	if x != nil {
-		return x.MessageId
-	}
-	return 0
-}
-
-type Event_ChannelCreated struct {
+type Event_GuildUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	ChannelId  uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	PreviousId uint64 `protobuf:"varint,4,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
-	NextId     uint64 `protobuf:"varint,5,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
-	IsCategory bool   `protobuf:"varint,6,opt,name=is_category,json=isCategory,proto3" json:"is_category,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UpdateName bool   `protobuf:"varint,3,opt,name=update_name,json=updateName,proto3" json:"update_name,omitempty"`
 }
 
-func (x *Event_ChannelCreated) Reset() {
-	*x = Event_ChannelCreated{}
+func (x *Event_GuildUpdated) Reset() {
+	*x = Event_GuildUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[8]
+		mi := &file_chat_v1_streaming_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_ChannelCreated) String() string {
+func (x *Event_GuildUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ChannelCreated) ProtoMessage() {}
+func (*Event_GuildUpdated) ProtoMessage() {}
 
-func (x *Event_ChannelCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[8]
+func (x *Event_GuildUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,84 +2426,105 @@ func (x *Event_ChannelCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ChannelCreated.ProtoReflect.Descriptor instead.
-func (*Event_ChannelCreated) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 3}
+// Deprecated: Use Event_GuildUpdated.ProtoReflect.Descriptor instead.
+func (*Event_GuildUpdated) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 17}
 }
 
-func (x *Event_ChannelCreated) GetGuildId() uint64 {
+func (x *Event_GuildUpdated) GetGuildId() uint64 {
 	if x != nil {
 		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_ChannelCreated) GetChannelId() uint64 {
+func (x *Event_GuildUpdated) GetName() string {
 	if x != nil {
-		return x.ChannelId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Event_ChannelCreated) GetName() string {
+func (x *Event_GuildUpdated) GetUpdateName() bool {
 	if x != nil {
-		return x.Name
+		return x.UpdateName
 	}
-	return ""
+	return false
 }
 
-func (x *Event_ChannelCreated) GetPreviousId() uint64 {
-	if x != nil {
-		return x.PreviousId
+type Event_GuildDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+}
+
+func (x *Event_GuildDeleted) Reset() {
+	*x = Event_GuildDeleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Event_ChannelCreated) GetNextId() uint64 {
-	if x != nil {
-		return x.NextId
+func (x *Event_GuildDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_GuildDeleted) ProtoMessage() {}
+
+func (x *Event_GuildDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_GuildDeleted.ProtoReflect.Descriptor instead.
+func (*Event_GuildDeleted) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 18}
 }
 
-func (x *Event_ChannelCreated) GetIsCategory() bool {
+func (x *Event_GuildDeleted) GetGuildId() uint64 {
 	if x != nil {
-		return x.IsCategory
+		return x.GuildId
 	}
-	return false
+	return 0
 }
 
-type Event_ChannelUpdated struct {
+type Event_MemberJoined struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId     uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	ChannelId   uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	UpdateName  bool   `protobuf:"varint,4,opt,name=update_name,json=updateName,proto3" json:"update_name,omitempty"`
-	PreviousId  uint64 `protobuf:"varint,5,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
-	NextId      uint64 `protobuf:"varint,6,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
-	UpdateOrder bool   `protobuf:"varint,7,opt,name=update_order,json=updateOrder,proto3" json:"update_order,omitempty"`
+	MemberId uint64 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	GuildId  uint64 `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 }
 
-func (x *Event_ChannelUpdated) Reset() {
-	*x = Event_ChannelUpdated{}
+func (x *Event_MemberJoined) Reset() {
+	*x = Event_MemberJoined{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[9]
+		mi := &file_chat_v1_streaming_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_ChannelUpdated) String() string {
+func (x *Event_MemberJoined) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ChannelUpdated) ProtoMessage() {}
+func (*Event_MemberJoined) ProtoMessage() {}
 
-func (x *Event_ChannelUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[9]
+func (x *Event_MemberJoined) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,86 +2535,106 @@ func (x *Event_ChannelUpdated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ChannelUpdated.ProtoReflect.Descriptor instead.
-func (*Event_ChannelUpdated) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 4}
+// Deprecated: Use Event_MemberJoined.ProtoReflect.Descriptor instead.
+func (*Event_MemberJoined) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 19}
 }
 
-func (x *Event_ChannelUpdated) GetGuildId() uint64 {
+func (x *Event_MemberJoined) GetMemberId() uint64 {
 	if x != nil {
-		return x.GuildId
+		return x.MemberId
 	}
 	return 0
 }
 
-func (x *Event_ChannelUpdated) GetChannelId() uint64 {
+func (x *Event_MemberJoined) GetGuildId() uint64 {
 	if x != nil {
-		return x.ChannelId
+		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_ChannelUpdated) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type Event_MemberLeft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId uint64 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	GuildId  uint64 `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 }
 
-func (x *Event_ChannelUpdated) GetUpdateName() bool {
-	if x != nil {
-		return x.UpdateName
+func (x *Event_MemberLeft) Reset() {
+	*x = Event_MemberLeft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_v1_streaming_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Event_ChannelUpdated) GetPreviousId() uint64 {
-	if x != nil {
-		return x.PreviousId
+func (x *Event_MemberLeft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_MemberLeft) ProtoMessage() {}
+
+func (x *Event_MemberLeft) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Event_ChannelUpdated) GetNextId() uint64 {
+// Deprecated: Use Event_MemberLeft.ProtoReflect.Descriptor instead.
+func (*Event_MemberLeft) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 20}
+}
+
+func (x *Event_MemberLeft) GetMemberId() uint64 {
 	if x != nil {
-		return x.NextId
+		return x.MemberId
 	}
 	return 0
 }
 
-func (x *Event_ChannelUpdated) GetUpdateOrder() bool {
+func (x *Event_MemberLeft) GetGuildId() uint64 {
 	if x != nil {
-		return x.UpdateOrder
+		return x.GuildId
 	}
-	return false
+	return 0
 }
 
-type Event_ChannelDeleted struct {
+type Event_GuildAddedToList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Homeserver string `protobuf:"bytes,2,opt,name=homeserver,proto3" json:"homeserver,omitempty"`
 }
 
-func (x *Event_ChannelDeleted) Reset() {
-	*x = Event_ChannelDeleted{}
+func (x *Event_GuildAddedToList) Reset() {
+	*x = Event_GuildAddedToList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[10]
+		mi := &file_chat_v1_streaming_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_ChannelDeleted) String() string {
+func (x *Event_GuildAddedToList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ChannelDeleted) ProtoMessage() {}
+func (*Event_GuildAddedToList) ProtoMessage() {}
 
-func (x *Event_ChannelDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[10]
+func (x *Event_GuildAddedToList) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,52 +2645,51 @@ func (x *Event_ChannelDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ChannelDeleted.ProtoReflect.Descriptor instead.
-func (*Event_ChannelDeleted) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 5}
+// Deprecated: Use Event_GuildAddedToList.ProtoReflect.Descriptor instead.
+func (*Event_GuildAddedToList) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 21}
 }
 
-func (x *Event_ChannelDeleted) GetGuildId() uint64 {
+func (x *Event_GuildAddedToList) GetGuildId() uint64 {
 	if x != nil {
 		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_ChannelDeleted) GetChannelId() uint64 {
+func (x *Event_GuildAddedToList) GetHomeserver() string {
 	if x != nil {
-		return x.ChannelId
+		return x.Homeserver
 	}
-	return 0
+	return ""
 }
 
-type Event_GuildUpdated struct {
+type Event_GuildRemovedFromList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	UpdateName bool   `protobuf:"varint,3,opt,name=update_name,json=updateName,proto3" json:"update_name,omitempty"`
+	Homeserver string `protobuf:"bytes,2,opt,name=homeserver,proto3" json:"homeserver,omitempty"`
 }
 
-func (x *Event_GuildUpdated) Reset() {
-	*x = Event_GuildUpdated{}
+func (x *Event_GuildRemovedFromList) Reset() {
+	*x = Event_GuildRemovedFromList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[11]
+		mi := &file_chat_v1_streaming_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_GuildUpdated) String() string {
+func (x *Event_GuildRemovedFromList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_GuildUpdated) ProtoMessage() {}
+func (*Event_GuildRemovedFromList) ProtoMessage() {}
 
-func (x *Event_GuildUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[11]
+func (x *Event_GuildRemovedFromList) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,57 +2700,54 @@ func (x *Event_GuildUpdated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_GuildUpdated.ProtoReflect.Descriptor instead.
-func (*Event_GuildUpdated) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 6}
+// Deprecated: Use Event_GuildRemovedFromList.ProtoReflect.Descriptor instead.
+func (*Event_GuildRemovedFromList) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 22}
 }
 
-func (x *Event_GuildUpdated) GetGuildId() uint64 {
+func (x *Event_GuildRemovedFromList) GetGuildId() uint64 {
 	if x != nil {
 		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_GuildUpdated) GetName() string {
+func (x *Event_GuildRemovedFromList) GetHomeserver() string {
 	if x != nil {
-		return x.Name
+		return x.Homeserver
 	}
 	return ""
 }
 
-func (x *Event_GuildUpdated) GetUpdateName() bool {
-	if x != nil {
-		return x.UpdateName
-	}
-	return false
-}
-
-type Event_GuildDeleted struct {
+type Event_ActionPerformed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId  uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId  uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ActionId   string `protobuf:"bytes,4,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	ActionData string `protobuf:"bytes,5,opt,name=action_data,json=actionData,proto3" json:"action_data,omitempty"`
 }
 
-func (x *Event_GuildDeleted) Reset() {
-	*x = Event_GuildDeleted{}
+func (x *Event_ActionPerformed) Reset() {
+	*x = Event_ActionPerformed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[12]
+		mi := &file_chat_v1_streaming_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_GuildDeleted) String() string {
+func (x *Event_ActionPerformed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_GuildDeleted) ProtoMessage() {}
+func (*Event_ActionPerformed) ProtoMessage() {}
 
-func (x *Event_GuildDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[12]
+func (x *Event_ActionPerformed) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,44 +2758,72 @@ func (x *Event_GuildDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_GuildDeleted.ProtoReflect.Descriptor instead.
-func (*Event_GuildDeleted) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 7}
+// Deprecated: Use Event_ActionPerformed.ProtoReflect.Descriptor instead.
+func (*Event_ActionPerformed) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 23}
 }
 
-func (x *Event_GuildDeleted) GetGuildId() uint64 {
+func (x *Event_ActionPerformed) GetGuildId() uint64 {
 	if x != nil {
 		return x.GuildId
 	}
 	return 0
 }
 
-type Event_MemberJoined struct {
+func (x *Event_ActionPerformed) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Event_ActionPerformed) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Event_ActionPerformed) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *Event_ActionPerformed) GetActionData() string {
+	if x != nil {
+		return x.ActionData
+	}
+	return ""
+}
+
+type Event_RoleMoved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MemberId uint64 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	GuildId  uint64 `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	RoleId  uint64 `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
 }
 
-func (x *Event_MemberJoined) Reset() {
-	*x = Event_MemberJoined{}
+func (x *Event_RoleMoved) Reset() {
+	*x = Event_RoleMoved{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[13]
+		mi := &file_chat_v1_streaming_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_MemberJoined) String() string {
+func (x *Event_RoleMoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_MemberJoined) ProtoMessage() {}
+func (*Event_RoleMoved) ProtoMessage() {}
 
-func (x *Event_MemberJoined) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[13]
+func (x *Event_RoleMoved) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,51 +2834,56 @@ func (x *Event_MemberJoined) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_MemberJoined.ProtoReflect.Descriptor instead.
-func (*Event_MemberJoined) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 8}
+// Deprecated: Use Event_RoleMoved.ProtoReflect.Descriptor instead.
+func (*Event_RoleMoved) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 24}
 }
 
-func (x *Event_MemberJoined) GetMemberId() uint64 {
+func (x *Event_RoleMoved) GetGuildId() uint64 {
 	if x != nil {
-		return x.MemberId
+		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_MemberJoined) GetGuildId() uint64 {
+func (x *Event_RoleMoved) GetRoleId() uint64 {
 	if x != nil {
-		return x.GuildId
+		return x.RoleId
 	}
 	return 0
 }
 
-type Event_MemberLeft struct {
+type Event_ProfileUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MemberId uint64 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	GuildId  uint64 `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	NewUsername    string        `protobuf:"bytes,1,opt,name=new_username,json=newUsername,proto3" json:"new_username,omitempty"`
+	UpdateUsername bool          `protobuf:"varint,2,opt,name=update_username,json=updateUsername,proto3" json:"update_username,omitempty"`
+	NewAvatar      string        `protobuf:"bytes,3,opt,name=new_avatar,json=newAvatar,proto3" json:"new_avatar,omitempty"`
+	UpdateAvatar   bool          `protobuf:"varint,4,opt,name=update_avatar,json=updateAvatar,proto3" json:"update_avatar,omitempty"`
+	NewStatus      v1.UserStatus `protobuf:"varint,5,opt,name=new_status,json=newStatus,proto3,enum=protocol.harmonytypes.v1.UserStatus" json:"new_status,omitempty"`
+	UpdateStatus   bool          `protobuf:"varint,6,opt,name=update_status,json=updateStatus,proto3" json:"update_status,omitempty"`
+	UserId         uint64        `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *Event_MemberLeft) Reset() {
-	*x = Event_MemberLeft{}
+func (x *Event_ProfileUpdated) Reset() {
+	*x = Event_ProfileUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[14]
+		mi := &file_chat_v1_streaming_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_MemberLeft) String() string {
+func (x *Event_ProfileUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_MemberLeft) ProtoMessage() {}
+func (*Event_ProfileUpdated) ProtoMessage() {}
 
-func (x *Event_MemberLeft) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[14]
+func (x *Event_ProfileUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,51 +2894,87 @@ func (x *Event_MemberLeft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_MemberLeft.ProtoReflect.Descriptor instead.
-func (*Event_MemberLeft) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 9}
+// Deprecated: Use Event_ProfileUpdated.ProtoReflect.Descriptor instead.
+func (*Event_ProfileUpdated) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 25}
 }
 
-func (x *Event_MemberLeft) GetMemberId() uint64 {
+func (x *Event_ProfileUpdated) GetNewUsername() string {
 	if x != nil {
-		return x.MemberId
+		return x.NewUsername
 	}
-	return 0
+	return ""
 }
 
-func (x *Event_MemberLeft) GetGuildId() uint64 {
+func (x *Event_ProfileUpdated) GetUpdateUsername() bool {
 	if x != nil {
-		return x.GuildId
+		return x.UpdateUsername
+	}
+	return false
+}
+
+func (x *Event_ProfileUpdated) GetNewAvatar() string {
+	if x != nil {
+		return x.NewAvatar
+	}
+	return ""
+}
+
+func (x *Event_ProfileUpdated) GetUpdateAvatar() bool {
+	if x != nil {
+		return x.UpdateAvatar
+	}
+	return false
+}
+
+func (x *Event_ProfileUpdated) GetNewStatus() v1.UserStatus {
+	if x != nil {
+		return x.NewStatus
+	}
+	return v1.UserStatus_USER_STATUS_ONLINE_UNSPECIFIED
+}
+
+func (x *Event_ProfileUpdated) GetUpdateStatus() bool {
+	if x != nil {
+		return x.UpdateStatus
+	}
+	return false
+}
+
+func (x *Event_ProfileUpdated) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-type Event_GuildAddedToList struct {
+// Sent when a request made over the stream couldn't be fulfilled
+type Event_RequestFailed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Homeserver string `protobuf:"bytes,2,opt,name=homeserver,proto3" json:"homeserver,omitempty"`
+	Request *StreamEventsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Error   string               `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *Event_GuildAddedToList) Reset() {
-	*x = Event_GuildAddedToList{}
+func (x *Event_RequestFailed) Reset() {
+	*x = Event_RequestFailed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[15]
+		mi := &file_chat_v1_streaming_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_GuildAddedToList) String() string {
+func (x *Event_RequestFailed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_GuildAddedToList) ProtoMessage() {}
+func (*Event_RequestFailed) ProtoMessage() {}
 
-func (x *Event_GuildAddedToList) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[15]
+func (x *Event_RequestFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,51 +2985,50 @@ func (x *Event_GuildAddedToList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_GuildAddedToList.ProtoReflect.Descriptor instead.
-func (*Event_GuildAddedToList) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 10}
+// Deprecated: Use Event_RequestFailed.ProtoReflect.Descriptor instead.
+func (*Event_RequestFailed) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 26}
 }
 
-func (x *Event_GuildAddedToList) GetGuildId() uint64 {
+func (x *Event_RequestFailed) GetRequest() *StreamEventsRequest {
 	if x != nil {
-		return x.GuildId
+		return x.Request
 	}
-	return 0
+	return nil
 }
 
-func (x *Event_GuildAddedToList) GetHomeserver() string {
+func (x *Event_RequestFailed) GetError() string {
 	if x != nil {
-		return x.Homeserver
+		return x.Error
 	}
 	return ""
 }
 
-type Event_GuildRemovedFromList struct {
+// Sent periodically by the server, the client should answer with an
+// AckHeartbeat request
+type Event_Heartbeat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Homeserver string `protobuf:"bytes,2,opt,name=homeserver,proto3" json:"homeserver,omitempty"`
 }
 
-func (x *Event_GuildRemovedFromList) Reset() {
-	*x = Event_GuildRemovedFromList{}
+func (x *Event_Heartbeat) Reset() {
+	*x = Event_Heartbeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[16]
+		mi := &file_chat_v1_streaming_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_GuildRemovedFromList) String() string {
+func (x *Event_Heartbeat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_GuildRemovedFromList) ProtoMessage() {}
+func (*Event_Heartbeat) ProtoMessage() {}
 
-func (x *Event_GuildRemovedFromList) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[16]
+func (x *Event_Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,54 +3039,38 @@ func (x *Event_GuildRemovedFromList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_GuildRemovedFromList.ProtoReflect.Descriptor instead.
-func (*Event_GuildRemovedFromList) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 11}
-}
-
-func (x *Event_GuildRemovedFromList) GetGuildId() uint64 {
-	if x != nil {
-		return x.GuildId
-	}
-	return 0
-}
-
-func (x *Event_GuildRemovedFromList) GetHomeserver() string {
-	if x != nil {
-		return x.Homeserver
-	}
-	return ""
+// Deprecated: Use Event_Heartbeat.ProtoReflect.Descriptor instead.
+func (*Event_Heartbeat) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 27}
 }
 
-type Event_ActionPerformed struct {
+type Event_Typing struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId    uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	ChannelId  uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	MessageId  uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	ActionId   string `protobuf:"bytes,4,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
-	ActionData string `protobuf:"bytes,5,opt,name=action_data,json=actionData,proto3" json:"action_data,omitempty"`
+	UserId    uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuildId   uint64 `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *Event_ActionPerformed) Reset() {
-	*x = Event_ActionPerformed{}
+func (x *Event_Typing) Reset() {
+	*x = Event_Typing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[17]
+		mi := &file_chat_v1_streaming_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_ActionPerformed) String() string {
+func (x *Event_Typing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ActionPerformed) ProtoMessage() {}
+func (*Event_Typing) ProtoMessage() {}
 
-func (x *Event_ActionPerformed) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[17]
+func (x *Event_Typing) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,72 +3081,60 @@ func (x *Event_ActionPerformed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ActionPerformed.ProtoReflect.Descriptor instead.
-func (*Event_ActionPerformed) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 12}
+// Deprecated: Use Event_Typing.ProtoReflect.Descriptor instead.
+func (*Event_Typing) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 28}
 }
 
-func (x *Event_ActionPerformed) GetGuildId() uint64 {
+func (x *Event_Typing) GetUserId() uint64 {
 	if x != nil {
-		return x.GuildId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Event_ActionPerformed) GetChannelId() uint64 {
+func (x *Event_Typing) GetGuildId() uint64 {
 	if x != nil {
-		return x.ChannelId
+		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_ActionPerformed) GetMessageId() uint64 {
+func (x *Event_Typing) GetChannelId() uint64 {
 	if x != nil {
-		return x.MessageId
+		return x.ChannelId
 	}
 	return 0
 }
 
-func (x *Event_ActionPerformed) GetActionId() string {
-	if x != nil {
-		return x.ActionId
-	}
-	return ""
-}
-
-func (x *Event_ActionPerformed) GetActionData() string {
-	if x != nil {
-		return x.ActionData
-	}
-	return ""
-}
-
-type Event_RoleMoved struct {
+// Sent when a user stops typing or their typing indicator expires
+type Event_TypingStopped struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	RoleId  uint64 `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId    uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuildId   uint64 `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *Event_RoleMoved) Reset() {
-	*x = Event_RoleMoved{}
+func (x *Event_TypingStopped) Reset() {
+	*x = Event_TypingStopped{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[18]
+		mi := &file_chat_v1_streaming_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_RoleMoved) String() string {
+func (x *Event_TypingStopped) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_RoleMoved) ProtoMessage() {}
+func (*Event_TypingStopped) ProtoMessage() {}
 
-func (x *Event_RoleMoved) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[18]
+func (x *Event_TypingStopped) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,55 +3145,64 @@ func (x *Event_RoleMoved) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_RoleMoved.ProtoReflect.Descriptor instead.
-func (*Event_RoleMoved) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 13}
+// Deprecated: Use Event_TypingStopped.ProtoReflect.Descriptor instead.
+func (*Event_TypingStopped) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 29}
 }
 
-func (x *Event_RoleMoved) GetGuildId() uint64 {
+func (x *Event_TypingStopped) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Event_TypingStopped) GetGuildId() uint64 {
 	if x != nil {
 		return x.GuildId
 	}
 	return 0
 }
 
-func (x *Event_RoleMoved) GetRoleId() uint64 {
+func (x *Event_TypingStopped) GetChannelId() uint64 {
 	if x != nil {
-		return x.RoleId
+		return x.ChannelId
 	}
 	return 0
 }
 
-type Event_ProfileUpdated struct {
+// Sent instead of the missed events when they are no longer available.
+// The client should refetch the state of the subscription and resume
+// after the sequence of this event.
+type Event_ResyncRequired struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewUsername    string        `protobuf:"bytes,1,opt,name=new_username,json=newUsername,proto3" json:"new_username,omitempty"`
-	UpdateUsername bool          `protobuf:"varint,2,opt,name=update_username,json=updateUsername,proto3" json:"update_username,omitempty"`
-	NewAvatar      string        `protobuf:"bytes,3,opt,name=new_avatar,json=newAvatar,proto3" json:"new_avatar,omitempty"`
-	UpdateAvatar   bool          `protobuf:"varint,4,opt,name=update_avatar,json=updateAvatar,proto3" json:"update_avatar,omitempty"`
-	NewStatus      v1.UserStatus `protobuf:"varint,5,opt,name=new_status,json=newStatus,proto3,enum=protocol.harmonytypes.v1.UserStatus" json:"new_status,omitempty"`
-	UpdateStatus   bool          `protobuf:"varint,6,opt,name=update_status,json=updateStatus,proto3" json:"update_status,omitempty"`
+	// Types that are assignable to Subscription:
+	//	*Event_ResyncRequired_Guild
+	//	*Event_ResyncRequired_Actions
+	//	*Event_ResyncRequired_HomeserverEvents
+	Subscription isEvent_ResyncRequired_Subscription `protobuf_oneof:"subscription"`
 }
 
-func (x *Event_ProfileUpdated) Reset() {
-	*x = Event_ProfileUpdated{}
+func (x *Event_ResyncRequired) Reset() {
+	*x = Event_ResyncRequired{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_v1_streaming_proto_msgTypes[19]
+		mi := &file_chat_v1_streaming_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_ProfileUpdated) String() string {
+func (x *Event_ResyncRequired) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ProfileUpdated) ProtoMessage() {}
+func (*Event_ResyncRequired) ProtoMessage() {}
 
-func (x *Event_ProfileUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_streaming_proto_msgTypes[19]
+func (x *Event_ResyncRequired) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_streaming_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,53 +3213,61 @@ func (x *Event_ProfileUpdated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ProfileUpdated.ProtoReflect.Descriptor instead.
-func (*Event_ProfileUpdated) Descriptor() ([]byte, []int) {
-	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 14}
+// Deprecated: Use Event_ResyncRequired.ProtoReflect.Descriptor instead.
+func (*Event_ResyncRequired) Descriptor() ([]byte, []int) {
+	return file_chat_v1_streaming_proto_rawDescGZIP(), []int{1, 30}
 }
 
-func (x *Event_ProfileUpdated) GetNewUsername() string {
-	if x != nil {
-		return x.NewUsername
+func (m *Event_ResyncRequired) GetSubscription() isEvent_ResyncRequired_Subscription {
+	if m != nil {
+		return m.Subscription
 	}
-	return ""
+	return nil
 }
 
-func (x *Event_ProfileUpdated) GetUpdateUsername() bool {
-	if x != nil {
-		return x.UpdateUsername
+func (x *Event_ResyncRequired) GetGuild() *StreamEventsRequest_SubscribeToGuild {
+	if x, ok := x.GetSubscription().(*Event_ResyncRequired_Guild); ok {
+		return x.Guild
 	}
-	return false
+	return nil
 }
 
-func (x *Event_ProfileUpdated) GetNewAvatar() string {
-	if x != nil {
-		return x.NewAvatar
+func (x *Event_ResyncRequired) GetActions() *StreamEventsRequest_SubscribeToActions {
+	if x, ok := x.GetSubscription().(*Event_ResyncRequired_Actions); ok {
+		return x.Actions
 	}
-	return ""
+	return nil
 }
 
-func (x *Event_ProfileUpdated) GetUpdateAvatar() bool {
-	if x != nil {
-		return x.UpdateAvatar
+func (x *Event_ResyncRequired) GetHomeserverEvents() *StreamEventsRequest_SubscribeToHomeserverEvents {
+	if x, ok := x.GetSubscription().(*Event_ResyncRequired_HomeserverEvents); ok {
+		return x.HomeserverEvents
 	}
-	return false
+	return nil
 }
 
-func (x *Event_ProfileUpdated) GetNewStatus() v1.UserStatus {
-	if x != nil {
-		return x.NewStatus
-	}
-	return v1.UserStatus_USER_STATUS_ONLINE_UNSPECIFIED
+type isEvent_ResyncRequired_Subscription interface {
+	isEvent_ResyncRequired_Subscription()
 }
 
-func (x *Event_ProfileUpdated) GetUpdateStatus() bool {
-	if x != nil {
-		return x.UpdateStatus
-	}
-	return false
+type Event_ResyncRequired_Guild struct {
+	Guild *StreamEventsRequest_SubscribeToGuild `protobuf:"bytes,1,opt,name=guild,proto3,oneof"`
+}
+
+type Event_ResyncRequired_Actions struct {
+	Actions *StreamEventsRequest_SubscribeToActions `protobuf:"bytes,2,opt,name=actions,proto3,oneof"`
+}
+
+type Event_ResyncRequired_HomeserverEvents struct {
+	HomeserverEvents *StreamEventsRequest_SubscribeToHomeserverEvents `protobuf:"bytes,3,opt,name=homeserver_events,json=homeserverEvents,proto3,oneof"`
 }
 
+func (*Event_ResyncRequired_Guild) isEvent_ResyncRequired_Subscription() {}
+
+func (*Event_ResyncRequired_Actions) isEvent_ResyncRequired_Subscription() {}
+
+func (*Event_ResyncRequired_HomeserverEvents) isEvent_ResyncRequired_Subscription() {}
+
 var File_chat_v1_streaming_proto protoreflect.FileDescriptor
 
 var file_chat_v1_streaming_proto_rawDesc = []byte{
@@ -1570,123 +3275,283 @@ var file_chat_v1_streaming_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x68, 0x61, 0x72,
 	0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x03, 0x0a, 0x13, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x66, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74,
-	0x6f, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x6c, 0x0a, 0x14, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x48, 0x00, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x1e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x41, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x54, 0x6f, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x1b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x54, 0x6f, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x1a, 0x31, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75,
-	0x69, 0x6c, 0x64, 0x49, 0x64, 0x1a, 0x14, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1d, 0x0a, 0x1b, 0x53,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x9d, 0x0d, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x66, 0x0a, 0x12, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52,
+	0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x12, 0x6c, 0x0a, 0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x12, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x88, 0x01, 0x0a, 0x1e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f,
+	0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x48, 0x6f, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x1b, 0x73,
 	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xba, 0x1c, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x59, 0x0a, 0x13, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x74,
-	0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x15, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x59, 0x0a, 0x0d, 0x61,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x72, 0x0a, 0x16, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x48, 0x00, 0x52, 0x14, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x78, 0x0a, 0x18, 0x75, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x64, 0x64, 0x65, 0x64,
-	0x54, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x41,
-	0x64, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x17, 0x67, 0x75,
-	0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x54, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x74, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x16, 0x75, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x22, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x45, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x1f, 0x75, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x6f, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6c, 0x0a, 0x14, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x48, 0x00, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x1a, 0xa0, 0x01, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1d,
+	0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0a, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x73, 0x1a, 0x3b, 0x0a, 0x12,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x1a, 0x44, 0x0a, 0x1b, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x1a,
+	0x35, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x1a, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x21, 0x0a, 0x1f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x1a, 0x0e, 0x0a, 0x0c, 0x41, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x1a, 0x59, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x1a, 0x56,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xbc, 0x38, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x13, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x64, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x54, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x17, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x54, 0x0a,
+	0x10, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4f, 0x0a,
+	0x0e, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0d, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x51,
+	0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x51, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x4f, 0x0a, 0x0e, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x4f, 0x0a, 0x0e, 0x65, 0x64, 0x69, 0x74,
-	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x64, 0x69, 0x74,
-	0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x49, 0x0a, 0x0c,
-	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x69, 0x6c,
-	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x64, 0x69, 0x74,
-	0x65, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x49, 0x0a, 0x0c, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x47,
-	0x75, 0x69, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x12, 0x4b, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x45, 0x0a,
+	0x0b, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x65, 0x66, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x72,
+	0x6f, 0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0f, 0x72,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e,
+	0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x38,
+	0x0a, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
-	0x64, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x45, 0x0a, 0x0b, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x65,
-	0x66, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65,
-	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x48,
-	0x00, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0f,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52,
-	0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x1a,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00,
+	0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x4e, 0x0a, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x10, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x12, 0x4e, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x12, 0x54, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x75, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x10, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x12, 0x61, 0x0a, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x13, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x10, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x10, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x1a,
 	0x63, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x17,
 	0x0a, 0x07, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x06, 0x65, 0x63, 0x68, 0x6f, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x94, 0x05, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0xb5, 0x05, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67,
 	0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
@@ -1727,114 +3592,255 @@ var file_chat_v1_streaming_proto_rawDesc = []byte{
 	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
 	0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
 	0x69, 0x64, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x75, 0x0a, 0x0e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x1a, 0x96, 0x01, 0x0a,
+	0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x1a, 0x9d, 0x01, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a,
 	0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
 	0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a,
 	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
 	0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x1a, 0xc9, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x23, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x1a, 0x4e, 0x0a, 0x0f, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x73, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x41, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x1a, 0x4e, 0x0a, 0x0f, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x3b, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e,
+	0x79, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x95, 0x01, 0x0a, 0x0d, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x42, 0x79, 0x1a, 0x76, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x70,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69,
 	0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
 	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0b, 0x70,
-	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x73, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a, 0xec,
-	0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x1a, 0xd1, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e,
+	0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x68, 0x61, 0x72, 0x6d,
+	0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x41,
+	0x0a, 0x0d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x30, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x1a, 0x73, 0x0a, 0x0e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x1a, 0x75, 0x0a, 0x10, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x55, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x1a, 0xd3, 0x01,
+	0x0a, 0x0f, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
 	0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64,
 	0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76,
-	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
-	0x01, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
-	0x30, 0x01, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x52, 0x0a,
-	0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
-	0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21,
-	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x64, 0x1a, 0x62, 0x0a, 0x0c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x2d, 0x0a, 0x0c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x64, 0x1a, 0x4e, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4a, 0x6f,
-	0x69, 0x6e, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x64, 0x1a, 0x4c, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65,
-	0x66, 0x74, 0x12, 0x1f, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64,
-	0x49, 0x64, 0x1a, 0x51, 0x0a, 0x10, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x64, 0x64, 0x65, 0x64,
-	0x54, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0xc9, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75,
-	0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0x55, 0x0a, 0x14, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a,
+	0xb3, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0b, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x6c, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f,
+	0x77, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x52, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x1a, 0x62, 0x0a, 0x0c, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x2d, 0x0a,
+	0x0c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a,
 	0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0xb4, 0x01, 0x0a,
-	0x0f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64,
-	0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x1a, 0x47, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x64,
-	0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x1a, 0x8a, 0x02, 0x0a,
-	0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
-	0x65, 0x77, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6e, 0x65, 0x77, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12,
-	0x43, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x68,
-	0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70,
-	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x63, 0x68, 0x61, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x1a, 0x4e, 0x0a, 0x0c,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x09,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x1a, 0x4c, 0x0a, 0x0a,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1f, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x1a, 0x51, 0x0a, 0x10, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x41, 0x64, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0x55, 0x0a,
+	0x14, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x1a, 0xb4, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x47, 0x0a, 0x09, 0x52,
+	0x6f, 0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x1a, 0xa7, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x41, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x66,
+	0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x3f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x0b, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x1a, 0x67, 0x0a, 0x06, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x1a, 0x6e, 0x0a, 0x0d,
+	0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x1a, 0xb8, 0x02, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x4e, 0x0a, 0x05, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x05, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x12,
+	0x54, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x70, 0x0a, 0x11, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x41, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x6f, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x10, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
+	0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x68,
+	0x61, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1849,67 +3855,122 @@ func file_chat_v1_streaming_proto_rawDescGZIP() []byte {
 	return file_chat_v1_streaming_proto_rawDescData
 }
 
-var file_chat_v1_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_chat_v1_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_chat_v1_streaming_proto_goTypes = []interface{}{
-	(*StreamEventsRequest)(nil),                             // 0: protocol.chat.v1.StreamEventsRequest
-	(*Event)(nil),                                           // 1: protocol.chat.v1.Event
-	(*StreamEventsRequest_SubscribeToGuild)(nil),            // 2: protocol.chat.v1.StreamEventsRequest.SubscribeToGuild
-	(*StreamEventsRequest_SubscribeToActions)(nil),          // 3: protocol.chat.v1.StreamEventsRequest.SubscribeToActions
-	(*StreamEventsRequest_SubscribeToHomeserverEvents)(nil), // 4: protocol.chat.v1.StreamEventsRequest.SubscribeToHomeserverEvents
-	(*Event_MessageSent)(nil),                               // 5: protocol.chat.v1.Event.MessageSent
-	(*Event_MessageUpdated)(nil),                            // 6: protocol.chat.v1.Event.MessageUpdated
-	(*Event_MessageDeleted)(nil),                            // 7: protocol.chat.v1.Event.MessageDeleted
-	(*Event_ChannelCreated)(nil),                            // 8: protocol.chat.v1.Event.ChannelCreated
-	(*Event_ChannelUpdated)(nil),                            // 9: protocol.chat.v1.Event.ChannelUpdated
-	(*Event_ChannelDeleted)(nil),                            // 10: protocol.chat.v1.Event.ChannelDeleted
-	(*Event_GuildUpdated)(nil),                              // 11: protocol.chat.v1.Event.GuildUpdated
-	(*Event_GuildDeleted)(nil),                              // 12: protocol.chat.v1.Event.GuildDeleted
-	(*Event_MemberJoined)(nil),                              // 13: protocol.chat.v1.Event.MemberJoined
-	(*Event_MemberLeft)(nil),                                // 14: protocol.chat.v1.Event.MemberLeft
-	(*Event_GuildAddedToList)(nil),                          // 15: protocol.chat.v1.Event.GuildAddedToList
-	(*Event_GuildRemovedFromList)(nil),                      // 16: protocol.chat.v1.Event.GuildRemovedFromList
-	(*Event_ActionPerformed)(nil),                           // 17: protocol.chat.v1.Event.ActionPerformed
-	(*Event_RoleMoved)(nil),                                 // 18: protocol.chat.v1.Event.RoleMoved
-	(*Event_ProfileUpdated)(nil),                            // 19: protocol.chat.v1.Event.ProfileUpdated
-	(*v1.Message)(nil),                                      // 20: protocol.harmonytypes.v1.Message
-	(*timestamp.Timestamp)(nil),                             // 21: google.protobuf.Timestamp
-	(*v1.Embed)(nil),                                        // 22: protocol.harmonytypes.v1.Embed
-	(*v1.Action)(nil),                                       // 23: protocol.harmonytypes.v1.Action
-	(*v1.Attachment)(nil),                                   // 24: protocol.harmonytypes.v1.Attachment
-	(*v1.Override)(nil),                                     // 25: protocol.harmonytypes.v1.Override
-	(v1.UserStatus)(0),                                      // 26: protocol.harmonytypes.v1.UserStatus
+	(*StreamEventsRequest)(nil),                                 // 0: protocol.chat.v1.StreamEventsRequest
+	(*Event)(nil),                                               // 1: protocol.chat.v1.Event
+	(*StreamEventsRequest_SubscribeToGuild)(nil),                // 2: protocol.chat.v1.StreamEventsRequest.SubscribeToGuild
+	(*StreamEventsRequest_SubscribeToActions)(nil),              // 3: protocol.chat.v1.StreamEventsRequest.SubscribeToActions
+	(*StreamEventsRequest_SubscribeToHomeserverEvents)(nil),     // 4: protocol.chat.v1.StreamEventsRequest.SubscribeToHomeserverEvents
+	(*StreamEventsRequest_UnsubscribeFromGuild)(nil),            // 5: protocol.chat.v1.StreamEventsRequest.UnsubscribeFromGuild
+	(*StreamEventsRequest_UnsubscribeFromActions)(nil),          // 6: protocol.chat.v1.StreamEventsRequest.UnsubscribeFromActions
+	(*StreamEventsRequest_UnsubscribeFromHomeserverEvents)(nil), // 7: protocol.chat.v1.StreamEventsRequest.UnsubscribeFromHomeserverEvents
+	(*StreamEventsRequest_AckHeartbeat)(nil),                    // 8: protocol.chat.v1.StreamEventsRequest.AckHeartbeat
+	(*StreamEventsRequest_UpdateGuildChannels)(nil),             // 9: protocol.chat.v1.StreamEventsRequest.UpdateGuildChannels
+	(*StreamEventsRequest_UpdateGuildThreads)(nil),              // 10: protocol.chat.v1.StreamEventsRequest.UpdateGuildThreads
+	(*Event_MessageSent)(nil),                                   // 11: protocol.chat.v1.Event.MessageSent
+	(*Event_MessageUpdated)(nil),                                // 12: protocol.chat.v1.Event.MessageUpdated
+	(*Event_MessageDeleted)(nil),                                // 13: protocol.chat.v1.Event.MessageDeleted
+	(*Event_MessagesBulkDeleted)(nil),                           // 14: protocol.chat.v1.Event.MessagesBulkDeleted
+	(*Event_MentionReceived)(nil),                               // 15: protocol.chat.v1.Event.MentionReceived
+	(*Event_ChannelAcked)(nil),                                  // 16: protocol.chat.v1.Event.ChannelAcked
+	(*Event_MessageRestored)(nil),                               // 17: protocol.chat.v1.Event.MessageRestored
+	(*Event_MessagePinned)(nil),                                 // 18: protocol.chat.v1.Event.MessagePinned
+	(*Event_MessageUnpinned)(nil),                               // 19: protocol.chat.v1.Event.MessageUnpinned
+	(*Event_ReactionAdded)(nil),                                 // 20: protocol.chat.v1.Event.ReactionAdded
+	(*Event_ThreadCreated)(nil),                                 // 21: protocol.chat.v1.Event.ThreadCreated
+	(*Event_ThreadArchived)(nil),                                // 22: protocol.chat.v1.Event.ThreadArchived
+	(*Event_ThreadUnarchived)(nil),                              // 23: protocol.chat.v1.Event.ThreadUnarchived
+	(*Event_ReactionRemoved)(nil),                               // 24: protocol.chat.v1.Event.ReactionRemoved
+	(*Event_ChannelCreated)(nil),                                // 25: protocol.chat.v1.Event.ChannelCreated
+	(*Event_ChannelUpdated)(nil),                                // 26: protocol.chat.v1.Event.ChannelUpdated
+	(*Event_ChannelDeleted)(nil),                                // 27: protocol.chat.v1.Event.ChannelDeleted
+	(*Event_GuildUpdated)(nil),                                  // 28: protocol.chat.v1.Event.GuildUpdated
+	(*Event_GuildDeleted)(nil),                                  // 29: protocol.chat.v1.Event.GuildDeleted
+	(*Event_MemberJoined)(nil),                                  // 30: protocol.chat.v1.Event.MemberJoined
+	(*Event_MemberLeft)(nil),                                    // 31: protocol.chat.v1.Event.MemberLeft
+	(*Event_GuildAddedToList)(nil),                              // 32: protocol.chat.v1.Event.GuildAddedToList
+	(*Event_GuildRemovedFromList)(nil),                          // 33: protocol.chat.v1.Event.GuildRemovedFromList
+	(*Event_ActionPerformed)(nil),                               // 34: protocol.chat.v1.Event.ActionPerformed
+	(*Event_RoleMoved)(nil),                                     // 35: protocol.chat.v1.Event.RoleMoved
+	(*Event_ProfileUpdated)(nil),                                // 36: protocol.chat.v1.Event.ProfileUpdated
+	(*Event_RequestFailed)(nil),                                 // 37: protocol.chat.v1.Event.RequestFailed
+	(*Event_Heartbeat)(nil),                                     // 38: protocol.chat.v1.Event.Heartbeat
+	(*Event_Typing)(nil),                                        // 39: protocol.chat.v1.Event.Typing
+	(*Event_TypingStopped)(nil),                                 // 40: protocol.chat.v1.Event.TypingStopped
+	(*Event_ResyncRequired)(nil),                                // 41: protocol.chat.v1.Event.ResyncRequired
+	(*v1.Message)(nil),                                          // 42: protocol.harmonytypes.v1.Message
+	(*timestamp.Timestamp)(nil),                                 // 43: google.protobuf.Timestamp
+	(*v1.Embed)(nil),                                            // 44: protocol.harmonytypes.v1.Embed
+	(*v1.Action)(nil),                                           // 45: protocol.harmonytypes.v1.Action
+	(*v1.Attachment)(nil),                                       // 46: protocol.harmonytypes.v1.Attachment
+	(*v1.Override)(nil),                                         // 47: protocol.harmonytypes.v1.Override
+	(*v1.Reaction)(nil),                                         // 48: protocol.harmonytypes.v1.Reaction
+	(*Thread)(nil),                                              // 49: protocol.chat.v1.Thread
+	(v1.UserStatus)(0),                                          // 50: protocol.harmonytypes.v1.UserStatus
 }
 var file_chat_v1_streaming_proto_depIdxs = []int32{
 	2,  // 0: protocol.chat.v1.StreamEventsRequest.subscribe_to_guild:type_name -> protocol.chat.v1.StreamEventsRequest.SubscribeToGuild
 	3,  // 1: protocol.chat.v1.StreamEventsRequest.subscribe_to_actions:type_name -> protocol.chat.v1.StreamEventsRequest.SubscribeToActions
 	4,  // 2: protocol.chat.v1.StreamEventsRequest.subscribe_to_homeserver_events:type_name -> protocol.chat.v1.StreamEventsRequest.SubscribeToHomeserverEvents
-	15, // 3: protocol.chat.v1.Event.guild_added_to_list:type_name -> protocol.chat.v1.Event.GuildAddedToList
-	16, // 4: protocol.chat.v1.Event.guild_removed_from_list:type_name -> protocol.chat.v1.Event.GuildRemovedFromList
-	17, // 5: protocol.chat.v1.Event.action_performed:type_name -> protocol.chat.v1.Event.ActionPerformed
-	5,  // 6: protocol.chat.v1.Event.sent_message:type_name -> protocol.chat.v1.Event.MessageSent
-	6,  // 7: protocol.chat.v1.Event.edited_message:type_name -> protocol.chat.v1.Event.MessageUpdated
-	7,  // 8: protocol.chat.v1.Event.deleted_message:type_name -> protocol.chat.v1.Event.MessageDeleted
-	8,  // 9: protocol.chat.v1.Event.created_channel:type_name -> protocol.chat.v1.Event.ChannelCreated
-	9,  // 10: protocol.chat.v1.Event.edited_channel:type_name -> protocol.chat.v1.Event.ChannelUpdated
-	10, // 11: protocol.chat.v1.Event.deleted_channel:type_name -> protocol.chat.v1.Event.ChannelDeleted
-	11, // 12: protocol.chat.v1.Event.edited_guild:type_name -> protocol.chat.v1.Event.GuildUpdated
-	12, // 13: protocol.chat.v1.Event.deleted_guild:type_name -> protocol.chat.v1.Event.GuildDeleted
-	13, // 14: protocol.chat.v1.Event.joined_member:type_name -> protocol.chat.v1.Event.MemberJoined
-	14, // 15: protocol.chat.v1.Event.left_member:type_name -> protocol.chat.v1.Event.MemberLeft
-	18, // 16: protocol.chat.v1.Event.role_moved:type_name -> protocol.chat.v1.Event.RoleMoved
-	19, // 17: protocol.chat.v1.Event.profile_updated:type_name -> protocol.chat.v1.Event.ProfileUpdated
-	20, // 18: protocol.chat.v1.Event.MessageSent.message:type_name -> protocol.harmonytypes.v1.Message
-	21, // 19: protocol.chat.v1.Event.MessageUpdated.edited_at:type_name -> google.protobuf.Timestamp
-	22, // 20: protocol.chat.v1.Event.MessageUpdated.embeds:type_name -> protocol.harmonytypes.v1.Embed
-	23, // 21: protocol.chat.v1.Event.MessageUpdated.actions:type_name -> protocol.harmonytypes.v1.Action
-	24, // 22: protocol.chat.v1.Event.MessageUpdated.attachments:type_name -> protocol.harmonytypes.v1.Attachment
-	25, // 23: protocol.chat.v1.Event.MessageUpdated.overrides:type_name -> protocol.harmonytypes.v1.Override
-	26, // 24: protocol.chat.v1.Event.ProfileUpdated.new_status:type_name -> protocol.harmonytypes.v1.UserStatus
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	9,  // 3: protocol.chat.v1.StreamEventsRequest.update_guild_channels:type_name -> protocol.chat.v1.StreamEventsRequest.UpdateGuildChannels
+	8,  // 4: protocol.chat.v1.StreamEventsRequest.ack_heartbeat:type_name -> protocol.chat.v1.StreamEventsRequest.AckHeartbeat
+	5,  // 5: protocol.chat.v1.StreamEventsRequest.unsubscribe_from_guild:type_name -> protocol.chat.v1.StreamEventsRequest.UnsubscribeFromGuild
+	6,  // 6: protocol.chat.v1.StreamEventsRequest.unsubscribe_from_actions:type_name -> protocol.chat.v1.StreamEventsRequest.UnsubscribeFromActions
+	7,  // 7: protocol.chat.v1.StreamEventsRequest.unsubscribe_from_homeserver_events:type_name -> protocol.chat.v1.StreamEventsRequest.UnsubscribeFromHomeserverEvents
+	10, // 8: protocol.chat.v1.StreamEventsRequest.update_guild_threads:type_name -> protocol.chat.v1.StreamEventsRequest.UpdateGuildThreads
+	32, // 9: protocol.chat.v1.Event.guild_added_to_list:type_name -> protocol.chat.v1.Event.GuildAddedToList
+	33, // 10: protocol.chat.v1.Event.guild_removed_from_list:type_name -> protocol.chat.v1.Event.GuildRemovedFromList
+	34, // 11: protocol.chat.v1.Event.action_performed:type_name -> protocol.chat.v1.Event.ActionPerformed
+	11, // 12: protocol.chat.v1.Event.sent_message:type_name -> protocol.chat.v1.Event.MessageSent
+	12, // 13: protocol.chat.v1.Event.edited_message:type_name -> protocol.chat.v1.Event.MessageUpdated
+	13, // 14: protocol.chat.v1.Event.deleted_message:type_name -> protocol.chat.v1.Event.MessageDeleted
+	25, // 15: protocol.chat.v1.Event.created_channel:type_name -> protocol.chat.v1.Event.ChannelCreated
+	26, // 16: protocol.chat.v1.Event.edited_channel:type_name -> protocol.chat.v1.Event.ChannelUpdated
+	27, // 17: protocol.chat.v1.Event.deleted_channel:type_name -> protocol.chat.v1.Event.ChannelDeleted
+	28, // 18: protocol.chat.v1.Event.edited_guild:type_name -> protocol.chat.v1.Event.GuildUpdated
+	29, // 19: protocol.chat.v1.Event.deleted_guild:type_name -> protocol.chat.v1.Event.GuildDeleted
+	30, // 20: protocol.chat.v1.Event.joined_member:type_name -> protocol.chat.v1.Event.MemberJoined
+	31, // 21: protocol.chat.v1.Event.left_member:type_name -> protocol.chat.v1.Event.MemberLeft
+	35, // 22: protocol.chat.v1.Event.role_moved:type_name -> protocol.chat.v1.Event.RoleMoved
+	36, // 23: protocol.chat.v1.Event.profile_updated:type_name -> protocol.chat.v1.Event.ProfileUpdated
+	41, // 24: protocol.chat.v1.Event.resync_required:type_name -> protocol.chat.v1.Event.ResyncRequired
+	39, // 25: protocol.chat.v1.Event.typing:type_name -> protocol.chat.v1.Event.Typing
+	40, // 26: protocol.chat.v1.Event.typing_stopped:type_name -> protocol.chat.v1.Event.TypingStopped
+	38, // 27: protocol.chat.v1.Event.heartbeat:type_name -> protocol.chat.v1.Event.Heartbeat
+	37, // 28: protocol.chat.v1.Event.request_failed:type_name -> protocol.chat.v1.Event.RequestFailed
+	18, // 29: protocol.chat.v1.Event.message_pinned:type_name -> protocol.chat.v1.Event.MessagePinned
+	19, // 30: protocol.chat.v1.Event.message_unpinned:type_name -> protocol.chat.v1.Event.MessageUnpinned
+	20, // 31: protocol.chat.v1.Event.reaction_added:type_name -> protocol.chat.v1.Event.ReactionAdded
+	24, // 32: protocol.chat.v1.Event.reaction_removed:type_name -> protocol.chat.v1.Event.ReactionRemoved
+	21, // 33: protocol.chat.v1.Event.thread_created:type_name -> protocol.chat.v1.Event.ThreadCreated
+	22, // 34: protocol.chat.v1.Event.thread_archived:type_name -> protocol.chat.v1.Event.ThreadArchived
+	23, // 35: protocol.chat.v1.Event.thread_unarchived:type_name -> protocol.chat.v1.Event.ThreadUnarchived
+	14, // 36: protocol.chat.v1.Event.messages_bulk_deleted:type_name -> protocol.chat.v1.Event.MessagesBulkDeleted
+	15, // 37: protocol.chat.v1.Event.mention_received:type_name -> protocol.chat.v1.Event.MentionReceived
+	16, // 38: protocol.chat.v1.Event.channel_acked:type_name -> protocol.chat.v1.Event.ChannelAcked
+	17, // 39: protocol.chat.v1.Event.message_restored:type_name -> protocol.chat.v1.Event.MessageRestored
+	42, // 40: protocol.chat.v1.Event.MessageSent.message:type_name -> protocol.harmonytypes.v1.Message
+	43, // 41: protocol.chat.v1.Event.MessageUpdated.edited_at:type_name -> google.protobuf.Timestamp
+	44, // 42: protocol.chat.v1.Event.MessageUpdated.embeds:type_name -> protocol.harmonytypes.v1.Embed
+	45, // 43: protocol.chat.v1.Event.MessageUpdated.actions:type_name -> protocol.harmonytypes.v1.Action
+	46, // 44: protocol.chat.v1.Event.MessageUpdated.attachments:type_name -> protocol.harmonytypes.v1.Attachment
+	47, // 45: protocol.chat.v1.Event.MessageUpdated.overrides:type_name -> protocol.harmonytypes.v1.Override
+	42, // 46: protocol.chat.v1.Event.MentionReceived.message:type_name -> protocol.harmonytypes.v1.Message
+	42, // 47: protocol.chat.v1.Event.MessageRestored.message:type_name -> protocol.harmonytypes.v1.Message
+	48, // 48: protocol.chat.v1.Event.ReactionAdded.reaction:type_name -> protocol.harmonytypes.v1.Reaction
+	49, // 49: protocol.chat.v1.Event.ThreadCreated.thread:type_name -> protocol.chat.v1.Thread
+	48, // 50: protocol.chat.v1.Event.ReactionRemoved.reaction:type_name -> protocol.harmonytypes.v1.Reaction
+	50, // 51: protocol.chat.v1.Event.ProfileUpdated.new_status:type_name -> protocol.harmonytypes.v1.UserStatus
+	0,  // 52: protocol.chat.v1.Event.RequestFailed.request:type_name -> protocol.chat.v1.StreamEventsRequest
+	2,  // 53: protocol.chat.v1.Event.ResyncRequired.guild:type_name -> protocol.chat.v1.StreamEventsRequest.SubscribeToGuild
+	3,  // 54: protocol.chat.v1.Event.ResyncRequired.actions:type_name -> protocol.chat.v1.StreamEventsRequest.SubscribeToActions
+	4,  // 55: protocol.chat.v1.Event.ResyncRequired.homeserver_events:type_name -> protocol.chat.v1.StreamEventsRequest.SubscribeToHomeserverEvents
+	56, // [56:56] is the sub-list for method output_type
+	56, // [56:56] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_chat_v1_streaming_proto_init() }
@@ -1917,6 +3978,7 @@ func file_chat_v1_streaming_proto_init() {
 	if File_chat_v1_streaming_proto != nil {
 		return
 	}
+	file_chat_v1_messages_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_chat_v1_streaming_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamEventsRequest); i {
@@ -1979,7 +4041,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_MessageSent); i {
+			switch v := v.(*StreamEventsRequest_UnsubscribeFromGuild); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1991,7 +4053,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_MessageUpdated); i {
+			switch v := v.(*StreamEventsRequest_UnsubscribeFromActions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2003,7 +4065,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_MessageDeleted); i {
+			switch v := v.(*StreamEventsRequest_UnsubscribeFromHomeserverEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2015,7 +4077,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_ChannelCreated); i {
+			switch v := v.(*StreamEventsRequest_AckHeartbeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2027,7 +4089,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_ChannelUpdated); i {
+			switch v := v.(*StreamEventsRequest_UpdateGuildChannels); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2039,7 +4101,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_ChannelDeleted); i {
+			switch v := v.(*StreamEventsRequest_UpdateGuildThreads); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2051,7 +4113,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_GuildUpdated); i {
+			switch v := v.(*Event_MessageSent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2063,7 +4125,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_GuildDeleted); i {
+			switch v := v.(*Event_MessageUpdated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2075,7 +4137,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_MemberJoined); i {
+			switch v := v.(*Event_MessageDeleted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2087,7 +4149,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_MemberLeft); i {
+			switch v := v.(*Event_MessagesBulkDeleted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2099,7 +4161,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_GuildAddedToList); i {
+			switch v := v.(*Event_MentionReceived); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2111,7 +4173,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_GuildRemovedFromList); i {
+			switch v := v.(*Event_ChannelAcked); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2123,7 +4185,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_ActionPerformed); i {
+			switch v := v.(*Event_MessageRestored); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2135,7 +4197,7 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event_RoleMoved); i {
+			switch v := v.(*Event_MessagePinned); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2147,6 +4209,210 @@ func file_chat_v1_streaming_proto_init() {
 			}
 		}
 		file_chat_v1_streaming_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_MessageUnpinned); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ReactionAdded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ThreadCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ThreadArchived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ThreadUnarchived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ReactionRemoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ChannelCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ChannelUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ChannelDeleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_GuildUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_GuildDeleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_MemberJoined); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_MemberLeft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_GuildAddedToList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_GuildRemovedFromList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ActionPerformed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_RoleMoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event_ProfileUpdated); i {
 			case 0:
 				return &v.state
@@ -2158,11 +4424,77 @@ func file_chat_v1_streaming_proto_init() {
 				return nil
 			}
 		}
+		file_chat_v1_streaming_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_RequestFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_Heartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_Typing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_TypingStopped); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_v1_streaming_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ResyncRequired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_chat_v1_streaming_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*StreamEventsRequest_SubscribeToGuild_)(nil),
 		(*StreamEventsRequest_SubscribeToActions_)(nil),
 		(*StreamEventsRequest_SubscribeToHomeserverEvents_)(nil),
+		(*StreamEventsRequest_UpdateGuildChannels_)(nil),
+		(*StreamEventsRequest_AckHeartbeat_)(nil),
+		(*StreamEventsRequest_UnsubscribeFromGuild_)(nil),
+		(*StreamEventsRequest_UnsubscribeFromActions_)(nil),
+		(*StreamEventsRequest_UnsubscribeFromHomeserverEvents_)(nil),
+		(*StreamEventsRequest_UpdateGuildThreads_)(nil),
 	}
 	file_chat_v1_streaming_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*Event_GuildAddedToList_)(nil),
@@ -2180,6 +4512,27 @@ func file_chat_v1_streaming_proto_init() {
 		(*Event_LeftMember)(nil),
 		(*Event_RoleMoved_)(nil),
 		(*Event_ProfileUpdated_)(nil),
+		(*Event_ResyncRequired_)(nil),
+		(*Event_Typing_)(nil),
+		(*Event_TypingStopped_)(nil),
+		(*Event_Heartbeat_)(nil),
+		(*Event_RequestFailed_)(nil),
+		(*Event_MessagePinned_)(nil),
+		(*Event_MessageUnpinned_)(nil),
+		(*Event_ReactionAdded_)(nil),
+		(*Event_ReactionRemoved_)(nil),
+		(*Event_ThreadCreated_)(nil),
+		(*Event_ThreadArchived_)(nil),
+		(*Event_ThreadUnarchived_)(nil),
+		(*Event_MessagesBulkDeleted_)(nil),
+		(*Event_MentionReceived_)(nil),
+		(*Event_ChannelAcked_)(nil),
+		(*Event_MessageRestored_)(nil),
+	}
+	file_chat_v1_streaming_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*Event_ResyncRequired_Guild)(nil),
+		(*Event_ResyncRequired_Actions)(nil),
+		(*Event_ResyncRequired_HomeserverEvents)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2187,7 +4540,7 @@ func file_chat_v1_streaming_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chat_v1_streaming_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -82,6 +82,78 @@ func (m *StreamEventsRequest) Validate() error {
 			}
 		}
 
+	case *StreamEventsRequest_UpdateGuildChannels_:
+
+		if v, ok := interface{}(m.GetUpdateGuildChannels()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamEventsRequestValidationError{
+					field:  "UpdateGuildChannels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StreamEventsRequest_AckHeartbeat_:
+
+		if v, ok := interface{}(m.GetAckHeartbeat()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamEventsRequestValidationError{
+					field:  "AckHeartbeat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StreamEventsRequest_UnsubscribeFromGuild_:
+
+		if v, ok := interface{}(m.GetUnsubscribeFromGuild()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamEventsRequestValidationError{
+					field:  "UnsubscribeFromGuild",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StreamEventsRequest_UnsubscribeFromActions_:
+
+		if v, ok := interface{}(m.GetUnsubscribeFromActions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamEventsRequestValidationError{
+					field:  "UnsubscribeFromActions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StreamEventsRequest_UnsubscribeFromHomeserverEvents_:
+
+		if v, ok := interface{}(m.GetUnsubscribeFromHomeserverEvents()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamEventsRequestValidationError{
+					field:  "UnsubscribeFromHomeserverEvents",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StreamEventsRequest_UpdateGuildThreads_:
+
+		if v, ok := interface{}(m.GetUpdateGuildThreads()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamEventsRequestValidationError{
+					field:  "UpdateGuildThreads",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	return nil
@@ -150,6 +222,8 @@ func (m *Event) Validate() error {
 		return nil
 	}
 
+	// no validation rules for Sequence
+
 	switch m.Event.(type) {
 
 	case *Event_GuildAddedToList_:
@@ -332,6 +406,198 @@ func (m *Event) Validate() error {
 			}
 		}
 
+	case *Event_ResyncRequired_:
+
+		if v, ok := interface{}(m.GetResyncRequired()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "ResyncRequired",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_Typing_:
+
+		if v, ok := interface{}(m.GetTyping()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "Typing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_TypingStopped_:
+
+		if v, ok := interface{}(m.GetTypingStopped()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "TypingStopped",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_Heartbeat_:
+
+		if v, ok := interface{}(m.GetHeartbeat()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "Heartbeat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_RequestFailed_:
+
+		if v, ok := interface{}(m.GetRequestFailed()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "RequestFailed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_MessagePinned_:
+
+		if v, ok := interface{}(m.GetMessagePinned()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "MessagePinned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_MessageUnpinned_:
+
+		if v, ok := interface{}(m.GetMessageUnpinned()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "MessageUnpinned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_ReactionAdded_:
+
+		if v, ok := interface{}(m.GetReactionAdded()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "ReactionAdded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_ReactionRemoved_:
+
+		if v, ok := interface{}(m.GetReactionRemoved()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "ReactionRemoved",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_ThreadCreated_:
+
+		if v, ok := interface{}(m.GetThreadCreated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "ThreadCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_ThreadArchived_:
+
+		if v, ok := interface{}(m.GetThreadArchived()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "ThreadArchived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_ThreadUnarchived_:
+
+		if v, ok := interface{}(m.GetThreadUnarchived()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "ThreadUnarchived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_MessagesBulkDeleted_:
+
+		if v, ok := interface{}(m.GetMessagesBulkDeleted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "MessagesBulkDeleted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_MentionReceived_:
+
+		if v, ok := interface{}(m.GetMentionReceived()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "MentionReceived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_ChannelAcked_:
+
+		if v, ok := interface{}(m.GetChannelAcked()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "ChannelAcked",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_MessageRestored_:
+
+		if v, ok := interface{}(m.GetMessageRestored()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "MessageRestored",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	return nil
@@ -401,6 +667,8 @@ func (m *StreamEventsRequest_SubscribeToGuild) Validate() error {
 
 	// no validation rules for GuildId
 
+	// no validation rules for ResumeAfter
+
 	return nil
 }
 
@@ -469,6 +737,8 @@ func (m *StreamEventsRequest_SubscribeToActions) Validate() error {
 		return nil
 	}
 
+	// no validation rules for ResumeAfter
+
 	return nil
 }
 
@@ -538,6 +808,8 @@ func (m *StreamEventsRequest_SubscribeToHomeserverEvents) Validate() error {
 		return nil
 	}
 
+	// no validation rules for ResumeAfter
+
 	return nil
 }
 
@@ -603,32 +875,23 @@ var _ interface {
 	ErrorName() string
 } = StreamEventsRequest_SubscribeToHomeserverEventsValidationError{}
 
-// Validate checks the field values on Event_MessageSent with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *Event_MessageSent) Validate() error {
+// Validate checks the field values on StreamEventsRequest_UnsubscribeFromGuild
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *StreamEventsRequest_UnsubscribeFromGuild) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for EchoId
-
-	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Event_MessageSentValidationError{
-				field:  "Message",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for GuildId
 
 	return nil
 }
 
-// Event_MessageSentValidationError is the validation error returned by
-// Event_MessageSent.Validate if the designated constraints aren't met.
-type Event_MessageSentValidationError struct {
+// StreamEventsRequest_UnsubscribeFromGuildValidationError is the validation
+// error returned by StreamEventsRequest_UnsubscribeFromGuild.Validate if the
+// designated constraints aren't met.
+type StreamEventsRequest_UnsubscribeFromGuildValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -636,24 +899,24 @@ type Event_MessageSentValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_MessageSentValidationError) Field() string { return e.field }
+func (e StreamEventsRequest_UnsubscribeFromGuildValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_MessageSentValidationError) Reason() string { return e.reason }
+func (e StreamEventsRequest_UnsubscribeFromGuildValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_MessageSentValidationError) Cause() error { return e.cause }
+func (e StreamEventsRequest_UnsubscribeFromGuildValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_MessageSentValidationError) Key() bool { return e.key }
+func (e StreamEventsRequest_UnsubscribeFromGuildValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_MessageSentValidationError) ErrorName() string {
-	return "Event_MessageSentValidationError"
+func (e StreamEventsRequest_UnsubscribeFromGuildValidationError) ErrorName() string {
+	return "StreamEventsRequest_UnsubscribeFromGuildValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_MessageSentValidationError) Error() string {
+func (e StreamEventsRequest_UnsubscribeFromGuildValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -665,14 +928,14 @@ func (e Event_MessageSentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_MessageSent.%s: %s%s",
+		"invalid %sStreamEventsRequest_UnsubscribeFromGuild.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_MessageSentValidationError{}
+var _ error = StreamEventsRequest_UnsubscribeFromGuildValidationError{}
 
 var _ interface {
 	Field() string
@@ -680,105 +943,1762 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_MessageSentValidationError{}
+} = StreamEventsRequest_UnsubscribeFromGuildValidationError{}
 
-// Validate checks the field values on Event_MessageUpdated with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *Event_MessageUpdated) Validate() error {
+// Validate checks the field values on
+// StreamEventsRequest_UnsubscribeFromActions with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *StreamEventsRequest_UnsubscribeFromActions) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for GuildId
+	return nil
+}
 
-	// no validation rules for ChannelId
+// StreamEventsRequest_UnsubscribeFromActionsValidationError is the validation
+// error returned by StreamEventsRequest_UnsubscribeFromActions.Validate if
+// the designated constraints aren't met.
+type StreamEventsRequest_UnsubscribeFromActionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for MessageId
+// Field function returns field value.
+func (e StreamEventsRequest_UnsubscribeFromActionsValidationError) Field() string { return e.field }
 
-	if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Event_MessageUpdatedValidationError{
-				field:  "EditedAt",
+// Reason function returns reason value.
+func (e StreamEventsRequest_UnsubscribeFromActionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamEventsRequest_UnsubscribeFromActionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamEventsRequest_UnsubscribeFromActionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamEventsRequest_UnsubscribeFromActionsValidationError) ErrorName() string {
+	return "StreamEventsRequest_UnsubscribeFromActionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamEventsRequest_UnsubscribeFromActionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamEventsRequest_UnsubscribeFromActions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamEventsRequest_UnsubscribeFromActionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamEventsRequest_UnsubscribeFromActionsValidationError{}
+
+// Validate checks the field values on
+// StreamEventsRequest_UnsubscribeFromHomeserverEvents with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *StreamEventsRequest_UnsubscribeFromHomeserverEvents) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError is the
+// validation error returned by
+// StreamEventsRequest_UnsubscribeFromHomeserverEvents.Validate if the
+// designated constraints aren't met.
+type StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError) ErrorName() string {
+	return "StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamEventsRequest_UnsubscribeFromHomeserverEvents.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamEventsRequest_UnsubscribeFromHomeserverEventsValidationError{}
+
+// Validate checks the field values on StreamEventsRequest_AckHeartbeat with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *StreamEventsRequest_AckHeartbeat) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// StreamEventsRequest_AckHeartbeatValidationError is the validation error
+// returned by StreamEventsRequest_AckHeartbeat.Validate if the designated
+// constraints aren't met.
+type StreamEventsRequest_AckHeartbeatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamEventsRequest_AckHeartbeatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamEventsRequest_AckHeartbeatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamEventsRequest_AckHeartbeatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamEventsRequest_AckHeartbeatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamEventsRequest_AckHeartbeatValidationError) ErrorName() string {
+	return "StreamEventsRequest_AckHeartbeatValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamEventsRequest_AckHeartbeatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamEventsRequest_AckHeartbeat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamEventsRequest_AckHeartbeatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamEventsRequest_AckHeartbeatValidationError{}
+
+// Validate checks the field values on StreamEventsRequest_UpdateGuildChannels
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *StreamEventsRequest_UpdateGuildChannels) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	return nil
+}
+
+// StreamEventsRequest_UpdateGuildChannelsValidationError is the validation
+// error returned by StreamEventsRequest_UpdateGuildChannels.Validate if the
+// designated constraints aren't met.
+type StreamEventsRequest_UpdateGuildChannelsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamEventsRequest_UpdateGuildChannelsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamEventsRequest_UpdateGuildChannelsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamEventsRequest_UpdateGuildChannelsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamEventsRequest_UpdateGuildChannelsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamEventsRequest_UpdateGuildChannelsValidationError) ErrorName() string {
+	return "StreamEventsRequest_UpdateGuildChannelsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamEventsRequest_UpdateGuildChannelsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamEventsRequest_UpdateGuildChannels.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamEventsRequest_UpdateGuildChannelsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamEventsRequest_UpdateGuildChannelsValidationError{}
+
+// Validate checks the field values on StreamEventsRequest_UpdateGuildThreads
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *StreamEventsRequest_UpdateGuildThreads) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	return nil
+}
+
+// StreamEventsRequest_UpdateGuildThreadsValidationError is the validation
+// error returned by StreamEventsRequest_UpdateGuildThreads.Validate if the
+// designated constraints aren't met.
+type StreamEventsRequest_UpdateGuildThreadsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamEventsRequest_UpdateGuildThreadsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamEventsRequest_UpdateGuildThreadsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamEventsRequest_UpdateGuildThreadsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamEventsRequest_UpdateGuildThreadsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamEventsRequest_UpdateGuildThreadsValidationError) ErrorName() string {
+	return "StreamEventsRequest_UpdateGuildThreadsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamEventsRequest_UpdateGuildThreadsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamEventsRequest_UpdateGuildThreads.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamEventsRequest_UpdateGuildThreadsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamEventsRequest_UpdateGuildThreadsValidationError{}
+
+// Validate checks the field values on Event_MessageSent with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *Event_MessageSent) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for EchoId
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_MessageSentValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Event_MessageSentValidationError is the validation error returned by
+// Event_MessageSent.Validate if the designated constraints aren't met.
+type Event_MessageSentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MessageSentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MessageSentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MessageSentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MessageSentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MessageSentValidationError) ErrorName() string {
+	return "Event_MessageSentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MessageSentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MessageSent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MessageSentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MessageSentValidationError{}
+
+// Validate checks the field values on Event_MessageUpdated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_MessageUpdated) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_MessageUpdatedValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for UpdateContent
+
+	for idx, item := range m.GetEmbeds() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Event_MessageUpdatedValidationError{
+					field:  fmt.Sprintf("Embeds[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UpdateEmbeds
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Event_MessageUpdatedValidationError{
+					field:  fmt.Sprintf("Actions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UpdateActions
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Event_MessageUpdatedValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UpdateAttachments
+
+	if v, ok := interface{}(m.GetOverrides()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_MessageUpdatedValidationError{
+				field:  "Overrides",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdateOverrides
+
+	// no validation rules for ThreadId
+
+	return nil
+}
+
+// Event_MessageUpdatedValidationError is the validation error returned by
+// Event_MessageUpdated.Validate if the designated constraints aren't met.
+type Event_MessageUpdatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MessageUpdatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MessageUpdatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MessageUpdatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MessageUpdatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MessageUpdatedValidationError) ErrorName() string {
+	return "Event_MessageUpdatedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MessageUpdatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MessageUpdated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MessageUpdatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MessageUpdatedValidationError{}
+
+// Validate checks the field values on Event_MessageDeleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_MessageDeleted) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for ThreadId
+
+	return nil
+}
+
+// Event_MessageDeletedValidationError is the validation error returned by
+// Event_MessageDeleted.Validate if the designated constraints aren't met.
+type Event_MessageDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MessageDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MessageDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MessageDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MessageDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MessageDeletedValidationError) ErrorName() string {
+	return "Event_MessageDeletedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MessageDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MessageDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MessageDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MessageDeletedValidationError{}
+
+// Validate checks the field values on Event_MessagesBulkDeleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_MessagesBulkDeleted) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for ThreadId
+
+	return nil
+}
+
+// Event_MessagesBulkDeletedValidationError is the validation error returned by
+// Event_MessagesBulkDeleted.Validate if the designated constraints aren't met.
+type Event_MessagesBulkDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MessagesBulkDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MessagesBulkDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MessagesBulkDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MessagesBulkDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MessagesBulkDeletedValidationError) ErrorName() string {
+	return "Event_MessagesBulkDeletedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MessagesBulkDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MessagesBulkDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MessagesBulkDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MessagesBulkDeletedValidationError{}
+
+// Validate checks the field values on Event_MentionReceived with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_MentionReceived) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_MentionReceivedValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Event_MentionReceivedValidationError is the validation error returned by
+// Event_MentionReceived.Validate if the designated constraints aren't met.
+type Event_MentionReceivedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MentionReceivedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MentionReceivedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MentionReceivedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MentionReceivedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MentionReceivedValidationError) ErrorName() string {
+	return "Event_MentionReceivedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MentionReceivedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MentionReceived.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MentionReceivedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MentionReceivedValidationError{}
+
+// Validate checks the field values on Event_ChannelAcked with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ChannelAcked) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	return nil
+}
+
+// Event_ChannelAckedValidationError is the validation error returned by
+// Event_ChannelAcked.Validate if the designated constraints aren't met.
+type Event_ChannelAckedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ChannelAckedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ChannelAckedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ChannelAckedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ChannelAckedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ChannelAckedValidationError) ErrorName() string {
+	return "Event_ChannelAckedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ChannelAckedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ChannelAcked.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_ChannelAckedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ChannelAckedValidationError{}
+
+// Validate checks the field values on Event_MessageRestored with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_MessageRestored) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_MessageRestoredValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Event_MessageRestoredValidationError is the validation error returned by
+// Event_MessageRestored.Validate if the designated constraints aren't met.
+type Event_MessageRestoredValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MessageRestoredValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MessageRestoredValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MessageRestoredValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MessageRestoredValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MessageRestoredValidationError) ErrorName() string {
+	return "Event_MessageRestoredValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MessageRestoredValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MessageRestored.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MessageRestoredValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MessageRestoredValidationError{}
+
+// Validate checks the field values on Event_MessagePinned with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_MessagePinned) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for PinnedBy
+
+	return nil
+}
+
+// Event_MessagePinnedValidationError is the validation error returned by
+// Event_MessagePinned.Validate if the designated constraints aren't met.
+type Event_MessagePinnedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MessagePinnedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MessagePinnedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MessagePinnedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MessagePinnedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MessagePinnedValidationError) ErrorName() string {
+	return "Event_MessagePinnedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MessagePinnedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MessagePinned.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MessagePinnedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MessagePinnedValidationError{}
+
+// Validate checks the field values on Event_MessageUnpinned with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_MessageUnpinned) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	return nil
+}
+
+// Event_MessageUnpinnedValidationError is the validation error returned by
+// Event_MessageUnpinned.Validate if the designated constraints aren't met.
+type Event_MessageUnpinnedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_MessageUnpinnedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_MessageUnpinnedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_MessageUnpinnedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_MessageUnpinnedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_MessageUnpinnedValidationError) ErrorName() string {
+	return "Event_MessageUnpinnedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_MessageUnpinnedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_MessageUnpinned.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_MessageUnpinnedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_MessageUnpinnedValidationError{}
+
+// Validate checks the field values on Event_ReactionAdded with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ReactionAdded) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for UserId
+
+	if v, ok := interface{}(m.GetReaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_ReactionAddedValidationError{
+				field:  "Reaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Event_ReactionAddedValidationError is the validation error returned by
+// Event_ReactionAdded.Validate if the designated constraints aren't met.
+type Event_ReactionAddedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ReactionAddedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ReactionAddedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ReactionAddedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ReactionAddedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ReactionAddedValidationError) ErrorName() string {
+	return "Event_ReactionAddedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ReactionAddedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ReactionAdded.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_ReactionAddedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ReactionAddedValidationError{}
+
+// Validate checks the field values on Event_ThreadCreated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ThreadCreated) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetThread()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_ThreadCreatedValidationError{
+				field:  "Thread",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Event_ThreadCreatedValidationError is the validation error returned by
+// Event_ThreadCreated.Validate if the designated constraints aren't met.
+type Event_ThreadCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ThreadCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ThreadCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ThreadCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ThreadCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ThreadCreatedValidationError) ErrorName() string {
+	return "Event_ThreadCreatedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ThreadCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ThreadCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_ThreadCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ThreadCreatedValidationError{}
+
+// Validate checks the field values on Event_ThreadArchived with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ThreadArchived) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for ThreadId
+
+	return nil
+}
+
+// Event_ThreadArchivedValidationError is the validation error returned by
+// Event_ThreadArchived.Validate if the designated constraints aren't met.
+type Event_ThreadArchivedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ThreadArchivedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ThreadArchivedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ThreadArchivedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ThreadArchivedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ThreadArchivedValidationError) ErrorName() string {
+	return "Event_ThreadArchivedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ThreadArchivedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ThreadArchived.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_ThreadArchivedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ThreadArchivedValidationError{}
+
+// Validate checks the field values on Event_ThreadUnarchived with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ThreadUnarchived) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for ThreadId
+
+	return nil
+}
+
+// Event_ThreadUnarchivedValidationError is the validation error returned by
+// Event_ThreadUnarchived.Validate if the designated constraints aren't met.
+type Event_ThreadUnarchivedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ThreadUnarchivedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ThreadUnarchivedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ThreadUnarchivedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ThreadUnarchivedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ThreadUnarchivedValidationError) ErrorName() string {
+	return "Event_ThreadUnarchivedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ThreadUnarchivedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ThreadUnarchived.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_ThreadUnarchivedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ThreadUnarchivedValidationError{}
+
+// Validate checks the field values on Event_ReactionRemoved with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ReactionRemoved) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for UserId
+
+	if v, ok := interface{}(m.GetReaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_ReactionRemovedValidationError{
+				field:  "Reaction",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Content
+	return nil
+}
+
+// Event_ReactionRemovedValidationError is the validation error returned by
+// Event_ReactionRemoved.Validate if the designated constraints aren't met.
+type Event_ReactionRemovedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ReactionRemovedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ReactionRemovedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ReactionRemovedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ReactionRemovedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ReactionRemovedValidationError) ErrorName() string {
+	return "Event_ReactionRemovedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ReactionRemovedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ReactionRemoved.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_ReactionRemovedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ReactionRemovedValidationError{}
+
+// Validate checks the field values on Event_ChannelCreated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ChannelCreated) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
 
-	// no validation rules for UpdateContent
+	// no validation rules for ChannelId
 
-	for idx, item := range m.GetEmbeds() {
-		_, _ = idx, item
+	// no validation rules for Name
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return Event_MessageUpdatedValidationError{
-					field:  fmt.Sprintf("Embeds[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for PreviousId
+
+	// no validation rules for NextId
+
+	// no validation rules for IsCategory
+
+	return nil
+}
+
+// Event_ChannelCreatedValidationError is the validation error returned by
+// Event_ChannelCreated.Validate if the designated constraints aren't met.
+type Event_ChannelCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ChannelCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ChannelCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ChannelCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ChannelCreatedValidationError) Key() bool { return e.key }
 
+// ErrorName returns error name.
+func (e Event_ChannelCreatedValidationError) ErrorName() string {
+	return "Event_ChannelCreatedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ChannelCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	// no validation rules for UpdateEmbeds
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	for idx, item := range m.GetActions() {
-		_, _ = idx, item
+	return fmt.Sprintf(
+		"invalid %sEvent_ChannelCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return Event_MessageUpdatedValidationError{
-					field:  fmt.Sprintf("Actions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+var _ error = Event_ChannelCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ChannelCreatedValidationError{}
 
+// Validate checks the field values on Event_ChannelUpdated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ChannelUpdated) Validate() error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for UpdateActions
+	// no validation rules for GuildId
 
-	for idx, item := range m.GetAttachments() {
-		_, _ = idx, item
+	// no validation rules for ChannelId
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return Event_MessageUpdatedValidationError{
-					field:  fmt.Sprintf("Attachments[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Name
+
+	// no validation rules for UpdateName
+
+	// no validation rules for PreviousId
+
+	// no validation rules for NextId
+
+	// no validation rules for UpdateOrder
+
+	// no validation rules for SlowMode
+
+	// no validation rules for UpdateSlowMode
+
+	return nil
+}
+
+// Event_ChannelUpdatedValidationError is the validation error returned by
+// Event_ChannelUpdated.Validate if the designated constraints aren't met.
+type Event_ChannelUpdatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ChannelUpdatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ChannelUpdatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ChannelUpdatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ChannelUpdatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ChannelUpdatedValidationError) ErrorName() string {
+	return "Event_ChannelUpdatedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ChannelUpdatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ChannelUpdated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Event_ChannelUpdatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ChannelUpdatedValidationError{}
+
+// Validate checks the field values on Event_ChannelDeleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ChannelDeleted) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	return nil
+}
+
+// Event_ChannelDeletedValidationError is the validation error returned by
+// Event_ChannelDeleted.Validate if the designated constraints aren't met.
+type Event_ChannelDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Event_ChannelDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Event_ChannelDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Event_ChannelDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Event_ChannelDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Event_ChannelDeletedValidationError) ErrorName() string {
+	return "Event_ChannelDeletedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Event_ChannelDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent_ChannelDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	}
+var _ error = Event_ChannelDeletedValidationError{}
 
-	// no validation rules for UpdateAttachments
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Event_ChannelDeletedValidationError{}
 
-	if v, ok := interface{}(m.GetOverrides()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Event_MessageUpdatedValidationError{
-				field:  "Overrides",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Validate checks the field values on Event_GuildUpdated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_GuildUpdated) Validate() error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for UpdateOverrides
+	// no validation rules for GuildId
+
+	// no validation rules for Name
+
+	// no validation rules for UpdateName
 
 	return nil
 }
 
-// Event_MessageUpdatedValidationError is the validation error returned by
-// Event_MessageUpdated.Validate if the designated constraints aren't met.
-type Event_MessageUpdatedValidationError struct {
+// Event_GuildUpdatedValidationError is the validation error returned by
+// Event_GuildUpdated.Validate if the designated constraints aren't met.
+type Event_GuildUpdatedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -786,24 +2706,24 @@ type Event_MessageUpdatedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_MessageUpdatedValidationError) Field() string { return e.field }
+func (e Event_GuildUpdatedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_MessageUpdatedValidationError) Reason() string { return e.reason }
+func (e Event_GuildUpdatedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_MessageUpdatedValidationError) Cause() error { return e.cause }
+func (e Event_GuildUpdatedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_MessageUpdatedValidationError) Key() bool { return e.key }
+func (e Event_GuildUpdatedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_MessageUpdatedValidationError) ErrorName() string {
-	return "Event_MessageUpdatedValidationError"
+func (e Event_GuildUpdatedValidationError) ErrorName() string {
+	return "Event_GuildUpdatedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_MessageUpdatedValidationError) Error() string {
+func (e Event_GuildUpdatedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -815,14 +2735,14 @@ func (e Event_MessageUpdatedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_MessageUpdated.%s: %s%s",
+		"invalid %sEvent_GuildUpdated.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_MessageUpdatedValidationError{}
+var _ error = Event_GuildUpdatedValidationError{}
 
 var _ interface {
 	Field() string
@@ -830,28 +2750,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_MessageUpdatedValidationError{}
+} = Event_GuildUpdatedValidationError{}
 
-// Validate checks the field values on Event_MessageDeleted with the rules
+// Validate checks the field values on Event_GuildDeleted with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *Event_MessageDeleted) Validate() error {
+func (m *Event_GuildDeleted) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for GuildId
 
-	// no validation rules for ChannelId
-
-	// no validation rules for MessageId
-
 	return nil
 }
 
-// Event_MessageDeletedValidationError is the validation error returned by
-// Event_MessageDeleted.Validate if the designated constraints aren't met.
-type Event_MessageDeletedValidationError struct {
+// Event_GuildDeletedValidationError is the validation error returned by
+// Event_GuildDeleted.Validate if the designated constraints aren't met.
+type Event_GuildDeletedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -859,24 +2775,24 @@ type Event_MessageDeletedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_MessageDeletedValidationError) Field() string { return e.field }
+func (e Event_GuildDeletedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_MessageDeletedValidationError) Reason() string { return e.reason }
+func (e Event_GuildDeletedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_MessageDeletedValidationError) Cause() error { return e.cause }
+func (e Event_GuildDeletedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_MessageDeletedValidationError) Key() bool { return e.key }
+func (e Event_GuildDeletedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_MessageDeletedValidationError) ErrorName() string {
-	return "Event_MessageDeletedValidationError"
+func (e Event_GuildDeletedValidationError) ErrorName() string {
+	return "Event_GuildDeletedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_MessageDeletedValidationError) Error() string {
+func (e Event_GuildDeletedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -888,14 +2804,14 @@ func (e Event_MessageDeletedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_MessageDeleted.%s: %s%s",
+		"invalid %sEvent_GuildDeleted.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_MessageDeletedValidationError{}
+var _ error = Event_GuildDeletedValidationError{}
 
 var _ interface {
 	Field() string
@@ -903,34 +2819,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_MessageDeletedValidationError{}
+} = Event_GuildDeletedValidationError{}
 
-// Validate checks the field values on Event_ChannelCreated with the rules
+// Validate checks the field values on Event_MemberJoined with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *Event_ChannelCreated) Validate() error {
+func (m *Event_MemberJoined) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for GuildId
-
-	// no validation rules for ChannelId
-
-	// no validation rules for Name
-
-	// no validation rules for PreviousId
-
-	// no validation rules for NextId
+	// no validation rules for MemberId
 
-	// no validation rules for IsCategory
+	// no validation rules for GuildId
 
 	return nil
 }
 
-// Event_ChannelCreatedValidationError is the validation error returned by
-// Event_ChannelCreated.Validate if the designated constraints aren't met.
-type Event_ChannelCreatedValidationError struct {
+// Event_MemberJoinedValidationError is the validation error returned by
+// Event_MemberJoined.Validate if the designated constraints aren't met.
+type Event_MemberJoinedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -938,24 +2846,24 @@ type Event_ChannelCreatedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_ChannelCreatedValidationError) Field() string { return e.field }
+func (e Event_MemberJoinedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_ChannelCreatedValidationError) Reason() string { return e.reason }
+func (e Event_MemberJoinedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_ChannelCreatedValidationError) Cause() error { return e.cause }
+func (e Event_MemberJoinedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_ChannelCreatedValidationError) Key() bool { return e.key }
+func (e Event_MemberJoinedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_ChannelCreatedValidationError) ErrorName() string {
-	return "Event_ChannelCreatedValidationError"
+func (e Event_MemberJoinedValidationError) ErrorName() string {
+	return "Event_MemberJoinedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_ChannelCreatedValidationError) Error() string {
+func (e Event_MemberJoinedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -967,14 +2875,14 @@ func (e Event_ChannelCreatedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_ChannelCreated.%s: %s%s",
+		"invalid %sEvent_MemberJoined.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_ChannelCreatedValidationError{}
+var _ error = Event_MemberJoinedValidationError{}
 
 var _ interface {
 	Field() string
@@ -982,36 +2890,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_ChannelCreatedValidationError{}
+} = Event_MemberJoinedValidationError{}
 
-// Validate checks the field values on Event_ChannelUpdated with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *Event_ChannelUpdated) Validate() error {
+// Validate checks the field values on Event_MemberLeft with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *Event_MemberLeft) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for GuildId
-
-	// no validation rules for ChannelId
-
-	// no validation rules for Name
-
-	// no validation rules for UpdateName
-
-	// no validation rules for PreviousId
-
-	// no validation rules for NextId
+	// no validation rules for MemberId
 
-	// no validation rules for UpdateOrder
+	// no validation rules for GuildId
 
 	return nil
 }
 
-// Event_ChannelUpdatedValidationError is the validation error returned by
-// Event_ChannelUpdated.Validate if the designated constraints aren't met.
-type Event_ChannelUpdatedValidationError struct {
+// Event_MemberLeftValidationError is the validation error returned by
+// Event_MemberLeft.Validate if the designated constraints aren't met.
+type Event_MemberLeftValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1019,24 +2917,22 @@ type Event_ChannelUpdatedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_ChannelUpdatedValidationError) Field() string { return e.field }
+func (e Event_MemberLeftValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_ChannelUpdatedValidationError) Reason() string { return e.reason }
+func (e Event_MemberLeftValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_ChannelUpdatedValidationError) Cause() error { return e.cause }
+func (e Event_MemberLeftValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_ChannelUpdatedValidationError) Key() bool { return e.key }
+func (e Event_MemberLeftValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_ChannelUpdatedValidationError) ErrorName() string {
-	return "Event_ChannelUpdatedValidationError"
-}
+func (e Event_MemberLeftValidationError) ErrorName() string { return "Event_MemberLeftValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Event_ChannelUpdatedValidationError) Error() string {
+func (e Event_MemberLeftValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1048,14 +2944,14 @@ func (e Event_ChannelUpdatedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_ChannelUpdated.%s: %s%s",
+		"invalid %sEvent_MemberLeft.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_ChannelUpdatedValidationError{}
+var _ error = Event_MemberLeftValidationError{}
 
 var _ interface {
 	Field() string
@@ -1063,26 +2959,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_ChannelUpdatedValidationError{}
+} = Event_MemberLeftValidationError{}
 
-// Validate checks the field values on Event_ChannelDeleted with the rules
+// Validate checks the field values on Event_GuildAddedToList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *Event_ChannelDeleted) Validate() error {
+func (m *Event_GuildAddedToList) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for GuildId
 
-	// no validation rules for ChannelId
+	// no validation rules for Homeserver
 
 	return nil
 }
 
-// Event_ChannelDeletedValidationError is the validation error returned by
-// Event_ChannelDeleted.Validate if the designated constraints aren't met.
-type Event_ChannelDeletedValidationError struct {
+// Event_GuildAddedToListValidationError is the validation error returned by
+// Event_GuildAddedToList.Validate if the designated constraints aren't met.
+type Event_GuildAddedToListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1090,24 +2986,24 @@ type Event_ChannelDeletedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_ChannelDeletedValidationError) Field() string { return e.field }
+func (e Event_GuildAddedToListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_ChannelDeletedValidationError) Reason() string { return e.reason }
+func (e Event_GuildAddedToListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_ChannelDeletedValidationError) Cause() error { return e.cause }
+func (e Event_GuildAddedToListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_ChannelDeletedValidationError) Key() bool { return e.key }
+func (e Event_GuildAddedToListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_ChannelDeletedValidationError) ErrorName() string {
-	return "Event_ChannelDeletedValidationError"
+func (e Event_GuildAddedToListValidationError) ErrorName() string {
+	return "Event_GuildAddedToListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_ChannelDeletedValidationError) Error() string {
+func (e Event_GuildAddedToListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1119,14 +3015,14 @@ func (e Event_ChannelDeletedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_ChannelDeleted.%s: %s%s",
+		"invalid %sEvent_GuildAddedToList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_ChannelDeletedValidationError{}
+var _ error = Event_GuildAddedToListValidationError{}
 
 var _ interface {
 	Field() string
@@ -1134,28 +3030,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_ChannelDeletedValidationError{}
+} = Event_GuildAddedToListValidationError{}
 
-// Validate checks the field values on Event_GuildUpdated with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on Event_GuildRemovedFromList with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *Event_GuildUpdated) Validate() error {
+func (m *Event_GuildRemovedFromList) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for GuildId
 
-	// no validation rules for Name
-
-	// no validation rules for UpdateName
+	// no validation rules for Homeserver
 
 	return nil
 }
 
-// Event_GuildUpdatedValidationError is the validation error returned by
-// Event_GuildUpdated.Validate if the designated constraints aren't met.
-type Event_GuildUpdatedValidationError struct {
+// Event_GuildRemovedFromListValidationError is the validation error returned
+// by Event_GuildRemovedFromList.Validate if the designated constraints aren't met.
+type Event_GuildRemovedFromListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1163,24 +3057,24 @@ type Event_GuildUpdatedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_GuildUpdatedValidationError) Field() string { return e.field }
+func (e Event_GuildRemovedFromListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_GuildUpdatedValidationError) Reason() string { return e.reason }
+func (e Event_GuildRemovedFromListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_GuildUpdatedValidationError) Cause() error { return e.cause }
+func (e Event_GuildRemovedFromListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_GuildUpdatedValidationError) Key() bool { return e.key }
+func (e Event_GuildRemovedFromListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_GuildUpdatedValidationError) ErrorName() string {
-	return "Event_GuildUpdatedValidationError"
+func (e Event_GuildRemovedFromListValidationError) ErrorName() string {
+	return "Event_GuildRemovedFromListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_GuildUpdatedValidationError) Error() string {
+func (e Event_GuildRemovedFromListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1192,14 +3086,14 @@ func (e Event_GuildUpdatedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_GuildUpdated.%s: %s%s",
+		"invalid %sEvent_GuildRemovedFromList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_GuildUpdatedValidationError{}
+var _ error = Event_GuildRemovedFromListValidationError{}
 
 var _ interface {
 	Field() string
@@ -1207,24 +3101,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_GuildUpdatedValidationError{}
+} = Event_GuildRemovedFromListValidationError{}
 
-// Validate checks the field values on Event_GuildDeleted with the rules
+// Validate checks the field values on Event_ActionPerformed with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *Event_GuildDeleted) Validate() error {
+func (m *Event_ActionPerformed) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for GuildId
+	// no validation rules for GuildId
+
+	// no validation rules for ChannelId
+
+	// no validation rules for MessageId
+
+	// no validation rules for ActionId
+
+	// no validation rules for ActionData
 
 	return nil
 }
 
-// Event_GuildDeletedValidationError is the validation error returned by
-// Event_GuildDeleted.Validate if the designated constraints aren't met.
-type Event_GuildDeletedValidationError struct {
+// Event_ActionPerformedValidationError is the validation error returned by
+// Event_ActionPerformed.Validate if the designated constraints aren't met.
+type Event_ActionPerformedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1232,24 +3134,24 @@ type Event_GuildDeletedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_GuildDeletedValidationError) Field() string { return e.field }
+func (e Event_ActionPerformedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_GuildDeletedValidationError) Reason() string { return e.reason }
+func (e Event_ActionPerformedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_GuildDeletedValidationError) Cause() error { return e.cause }
+func (e Event_ActionPerformedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_GuildDeletedValidationError) Key() bool { return e.key }
+func (e Event_ActionPerformedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_GuildDeletedValidationError) ErrorName() string {
-	return "Event_GuildDeletedValidationError"
+func (e Event_ActionPerformedValidationError) ErrorName() string {
+	return "Event_ActionPerformedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_GuildDeletedValidationError) Error() string {
+func (e Event_ActionPerformedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1261,14 +3163,14 @@ func (e Event_GuildDeletedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_GuildDeleted.%s: %s%s",
+		"invalid %sEvent_ActionPerformed.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_GuildDeletedValidationError{}
+var _ error = Event_ActionPerformedValidationError{}
 
 var _ interface {
 	Field() string
@@ -1276,26 +3178,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_GuildDeletedValidationError{}
+} = Event_ActionPerformedValidationError{}
 
-// Validate checks the field values on Event_MemberJoined with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *Event_MemberJoined) Validate() error {
+// Validate checks the field values on Event_RoleMoved with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *Event_RoleMoved) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for MemberId
-
 	// no validation rules for GuildId
 
+	// no validation rules for RoleId
+
 	return nil
 }
 
-// Event_MemberJoinedValidationError is the validation error returned by
-// Event_MemberJoined.Validate if the designated constraints aren't met.
-type Event_MemberJoinedValidationError struct {
+// Event_RoleMovedValidationError is the validation error returned by
+// Event_RoleMoved.Validate if the designated constraints aren't met.
+type Event_RoleMovedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1303,24 +3205,22 @@ type Event_MemberJoinedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_MemberJoinedValidationError) Field() string { return e.field }
+func (e Event_RoleMovedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_MemberJoinedValidationError) Reason() string { return e.reason }
+func (e Event_RoleMovedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_MemberJoinedValidationError) Cause() error { return e.cause }
+func (e Event_RoleMovedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_MemberJoinedValidationError) Key() bool { return e.key }
+func (e Event_RoleMovedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_MemberJoinedValidationError) ErrorName() string {
-	return "Event_MemberJoinedValidationError"
-}
+func (e Event_RoleMovedValidationError) ErrorName() string { return "Event_RoleMovedValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Event_MemberJoinedValidationError) Error() string {
+func (e Event_RoleMovedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1332,14 +3232,14 @@ func (e Event_MemberJoinedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_MemberJoined.%s: %s%s",
+		"invalid %sEvent_RoleMoved.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_MemberJoinedValidationError{}
+var _ error = Event_RoleMovedValidationError{}
 
 var _ interface {
 	Field() string
@@ -1347,26 +3247,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_MemberJoinedValidationError{}
+} = Event_RoleMovedValidationError{}
 
-// Validate checks the field values on Event_MemberLeft with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *Event_MemberLeft) Validate() error {
+// Validate checks the field values on Event_ProfileUpdated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_ProfileUpdated) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for MemberId
+	// no validation rules for NewUsername
 
-	// no validation rules for GuildId
+	// no validation rules for UpdateUsername
+
+	// no validation rules for NewAvatar
+
+	// no validation rules for UpdateAvatar
+
+	// no validation rules for NewStatus
+
+	// no validation rules for UpdateStatus
+
+	// no validation rules for UserId
 
 	return nil
 }
 
-// Event_MemberLeftValidationError is the validation error returned by
-// Event_MemberLeft.Validate if the designated constraints aren't met.
-type Event_MemberLeftValidationError struct {
+// Event_ProfileUpdatedValidationError is the validation error returned by
+// Event_ProfileUpdated.Validate if the designated constraints aren't met.
+type Event_ProfileUpdatedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1374,22 +3284,24 @@ type Event_MemberLeftValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_MemberLeftValidationError) Field() string { return e.field }
+func (e Event_ProfileUpdatedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_MemberLeftValidationError) Reason() string { return e.reason }
+func (e Event_ProfileUpdatedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_MemberLeftValidationError) Cause() error { return e.cause }
+func (e Event_ProfileUpdatedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_MemberLeftValidationError) Key() bool { return e.key }
+func (e Event_ProfileUpdatedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_MemberLeftValidationError) ErrorName() string { return "Event_MemberLeftValidationError" }
+func (e Event_ProfileUpdatedValidationError) ErrorName() string {
+	return "Event_ProfileUpdatedValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Event_MemberLeftValidationError) Error() string {
+func (e Event_ProfileUpdatedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1401,14 +3313,14 @@ func (e Event_MemberLeftValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_MemberLeft.%s: %s%s",
+		"invalid %sEvent_ProfileUpdated.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_MemberLeftValidationError{}
+var _ error = Event_ProfileUpdatedValidationError{}
 
 var _ interface {
 	Field() string
@@ -1416,26 +3328,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_MemberLeftValidationError{}
+} = Event_ProfileUpdatedValidationError{}
 
-// Validate checks the field values on Event_GuildAddedToList with the rules
+// Validate checks the field values on Event_RequestFailed with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *Event_GuildAddedToList) Validate() error {
+func (m *Event_RequestFailed) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for GuildId
+	if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Event_RequestFailedValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Homeserver
+	// no validation rules for Error
 
 	return nil
 }
 
-// Event_GuildAddedToListValidationError is the validation error returned by
-// Event_GuildAddedToList.Validate if the designated constraints aren't met.
-type Event_GuildAddedToListValidationError struct {
+// Event_RequestFailedValidationError is the validation error returned by
+// Event_RequestFailed.Validate if the designated constraints aren't met.
+type Event_RequestFailedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1443,24 +3363,24 @@ type Event_GuildAddedToListValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_GuildAddedToListValidationError) Field() string { return e.field }
+func (e Event_RequestFailedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_GuildAddedToListValidationError) Reason() string { return e.reason }
+func (e Event_RequestFailedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_GuildAddedToListValidationError) Cause() error { return e.cause }
+func (e Event_RequestFailedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_GuildAddedToListValidationError) Key() bool { return e.key }
+func (e Event_RequestFailedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_GuildAddedToListValidationError) ErrorName() string {
-	return "Event_GuildAddedToListValidationError"
+func (e Event_RequestFailedValidationError) ErrorName() string {
+	return "Event_RequestFailedValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_GuildAddedToListValidationError) Error() string {
+func (e Event_RequestFailedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1472,14 +3392,14 @@ func (e Event_GuildAddedToListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_GuildAddedToList.%s: %s%s",
+		"invalid %sEvent_RequestFailed.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_GuildAddedToListValidationError{}
+var _ error = Event_RequestFailedValidationError{}
 
 var _ interface {
 	Field() string
@@ -1487,26 +3407,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_GuildAddedToListValidationError{}
+} = Event_RequestFailedValidationError{}
 
-// Validate checks the field values on Event_GuildRemovedFromList with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *Event_GuildRemovedFromList) Validate() error {
+// Validate checks the field values on Event_Heartbeat with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *Event_Heartbeat) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for GuildId
-
-	// no validation rules for Homeserver
-
 	return nil
 }
 
-// Event_GuildRemovedFromListValidationError is the validation error returned
-// by Event_GuildRemovedFromList.Validate if the designated constraints aren't met.
-type Event_GuildRemovedFromListValidationError struct {
+// Event_HeartbeatValidationError is the validation error returned by
+// Event_Heartbeat.Validate if the designated constraints aren't met.
+type Event_HeartbeatValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1514,24 +3430,22 @@ type Event_GuildRemovedFromListValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_GuildRemovedFromListValidationError) Field() string { return e.field }
+func (e Event_HeartbeatValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_GuildRemovedFromListValidationError) Reason() string { return e.reason }
+func (e Event_HeartbeatValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_GuildRemovedFromListValidationError) Cause() error { return e.cause }
+func (e Event_HeartbeatValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_GuildRemovedFromListValidationError) Key() bool { return e.key }
+func (e Event_HeartbeatValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_GuildRemovedFromListValidationError) ErrorName() string {
-	return "Event_GuildRemovedFromListValidationError"
-}
+func (e Event_HeartbeatValidationError) ErrorName() string { return "Event_HeartbeatValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Event_GuildRemovedFromListValidationError) Error() string {
+func (e Event_HeartbeatValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1543,14 +3457,14 @@ func (e Event_GuildRemovedFromListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_GuildRemovedFromList.%s: %s%s",
+		"invalid %sEvent_Heartbeat.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_GuildRemovedFromListValidationError{}
+var _ error = Event_HeartbeatValidationError{}
 
 var _ interface {
 	Field() string
@@ -1558,32 +3472,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_GuildRemovedFromListValidationError{}
+} = Event_HeartbeatValidationError{}
 
-// Validate checks the field values on Event_ActionPerformed with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *Event_ActionPerformed) Validate() error {
+// Validate checks the field values on Event_Typing with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Event_Typing) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for UserId
+
 	// no validation rules for GuildId
 
 	// no validation rules for ChannelId
 
-	// no validation rules for MessageId
-
-	// no validation rules for ActionId
-
-	// no validation rules for ActionData
-
 	return nil
 }
 
-// Event_ActionPerformedValidationError is the validation error returned by
-// Event_ActionPerformed.Validate if the designated constraints aren't met.
-type Event_ActionPerformedValidationError struct {
+// Event_TypingValidationError is the validation error returned by
+// Event_Typing.Validate if the designated constraints aren't met.
+type Event_TypingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1591,24 +3501,22 @@ type Event_ActionPerformedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_ActionPerformedValidationError) Field() string { return e.field }
+func (e Event_TypingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_ActionPerformedValidationError) Reason() string { return e.reason }
+func (e Event_TypingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_ActionPerformedValidationError) Cause() error { return e.cause }
+func (e Event_TypingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_ActionPerformedValidationError) Key() bool { return e.key }
+func (e Event_TypingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_ActionPerformedValidationError) ErrorName() string {
-	return "Event_ActionPerformedValidationError"
-}
+func (e Event_TypingValidationError) ErrorName() string { return "Event_TypingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Event_ActionPerformedValidationError) Error() string {
+func (e Event_TypingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1620,14 +3528,14 @@ func (e Event_ActionPerformedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_ActionPerformed.%s: %s%s",
+		"invalid %sEvent_Typing.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_ActionPerformedValidationError{}
+var _ error = Event_TypingValidationError{}
 
 var _ interface {
 	Field() string
@@ -1635,26 +3543,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_ActionPerformedValidationError{}
+} = Event_TypingValidationError{}
 
-// Validate checks the field values on Event_RoleMoved with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *Event_RoleMoved) Validate() error {
+// Validate checks the field values on Event_TypingStopped with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Event_TypingStopped) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for UserId
+
 	// no validation rules for GuildId
 
-	// no validation rules for RoleId
+	// no validation rules for ChannelId
 
 	return nil
 }
 
-// Event_RoleMovedValidationError is the validation error returned by
-// Event_RoleMoved.Validate if the designated constraints aren't met.
-type Event_RoleMovedValidationError struct {
+// Event_TypingStoppedValidationError is the validation error returned by
+// Event_TypingStopped.Validate if the designated constraints aren't met.
+type Event_TypingStoppedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1662,22 +3572,24 @@ type Event_RoleMovedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_RoleMovedValidationError) Field() string { return e.field }
+func (e Event_TypingStoppedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_RoleMovedValidationError) Reason() string { return e.reason }
+func (e Event_TypingStoppedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_RoleMovedValidationError) Cause() error { return e.cause }
+func (e Event_TypingStoppedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_RoleMovedValidationError) Key() bool { return e.key }
+func (e Event_TypingStoppedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_RoleMovedValidationError) ErrorName() string { return "Event_RoleMovedValidationError" }
+func (e Event_TypingStoppedValidationError) ErrorName() string {
+	return "Event_TypingStoppedValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Event_RoleMovedValidationError) Error() string {
+func (e Event_TypingStoppedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1689,14 +3601,14 @@ func (e Event_RoleMovedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_RoleMoved.%s: %s%s",
+		"invalid %sEvent_TypingStopped.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_RoleMovedValidationError{}
+var _ error = Event_TypingStoppedValidationError{}
 
 var _ interface {
 	Field() string
@@ -1704,34 +3616,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_RoleMovedValidationError{}
+} = Event_TypingStoppedValidationError{}
 
-// Validate checks the field values on Event_ProfileUpdated with the rules
+// Validate checks the field values on Event_ResyncRequired with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *Event_ProfileUpdated) Validate() error {
+func (m *Event_ResyncRequired) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for NewUsername
+	switch m.Subscription.(type) {
 
-	// no validation rules for UpdateUsername
+	case *Event_ResyncRequired_Guild:
 
-	// no validation rules for NewAvatar
+		if v, ok := interface{}(m.GetGuild()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Event_ResyncRequiredValidationError{
+					field:  "Guild",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for UpdateAvatar
+	case *Event_ResyncRequired_Actions:
 
-	// no validation rules for NewStatus
+		if v, ok := interface{}(m.GetActions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Event_ResyncRequiredValidationError{
+					field:  "Actions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for UpdateStatus
+	case *Event_ResyncRequired_HomeserverEvents:
+
+		if v, ok := interface{}(m.GetHomeserverEvents()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Event_ResyncRequiredValidationError{
+					field:  "HomeserverEvents",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// Event_ProfileUpdatedValidationError is the validation error returned by
-// Event_ProfileUpdated.Validate if the designated constraints aren't met.
-type Event_ProfileUpdatedValidationError struct {
+// Event_ResyncRequiredValidationError is the validation error returned by
+// Event_ResyncRequired.Validate if the designated constraints aren't met.
+type Event_ResyncRequiredValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1739,24 +3679,24 @@ type Event_ProfileUpdatedValidationError struct {
 }
 
 // Field function returns field value.
-func (e Event_ProfileUpdatedValidationError) Field() string { return e.field }
+func (e Event_ResyncRequiredValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Event_ProfileUpdatedValidationError) Reason() string { return e.reason }
+func (e Event_ResyncRequiredValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Event_ProfileUpdatedValidationError) Cause() error { return e.cause }
+func (e Event_ResyncRequiredValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Event_ProfileUpdatedValidationError) Key() bool { return e.key }
+func (e Event_ResyncRequiredValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Event_ProfileUpdatedValidationError) ErrorName() string {
-	return "Event_ProfileUpdatedValidationError"
+func (e Event_ResyncRequiredValidationError) ErrorName() string {
+	return "Event_ResyncRequiredValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Event_ProfileUpdatedValidationError) Error() string {
+func (e Event_ResyncRequiredValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1768,14 +3708,14 @@ func (e Event_ProfileUpdatedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent_ProfileUpdated.%s: %s%s",
+		"invalid %sEvent_ResyncRequired.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Event_ProfileUpdatedValidationError{}
+var _ error = Event_ResyncRequiredValidationError{}
 
 var _ interface {
 	Field() string
@@ -1783,4 +3723,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Event_ProfileUpdatedValidationError{}
+} = Event_ResyncRequiredValidationError{}
@@ -797,9 +797,11 @@ type Message struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId     uint64               `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	ChannelId   uint64               `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	MessageId   uint64               `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	GuildId   uint64 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ChannelId uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// 0 for system messages posted by the server itself, which also have a
+	// system_message override
 	AuthorId    uint64               `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	EditedAt    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
@@ -809,6 +811,10 @@ type Message struct {
 	Attachments []*Attachment        `protobuf:"bytes,10,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	InReplyTo   uint64               `protobuf:"varint,11,opt,name=in_reply_to,json=inReplyTo,proto3" json:"in_reply_to,omitempty"`
 	Overrides   *Override            `protobuf:"bytes,12,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	Reactions   []*Reaction          `protobuf:"bytes,13,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// the thread the message was posted in, unset for messages posted
+	// directly in the channel
+	ThreadId uint64 `protobuf:"varint,14,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
 }
 
 func (x *Message) Reset() {
@@ -927,6 +933,86 @@ func (x *Message) GetOverrides() *Override {
 	return nil
 }
 
+func (x *Message) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Message) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+// Reaction is the number of users who reacted to a message with an emoji or
+// an emote. Exactly one of emoji and emote is set.
+type Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji string `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// the image ID of the emote
+	Emote string `protobuf:"bytes,2,opt,name=emote,proto3" json:"emote,omitempty"`
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_harmonytypes_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_harmonytypes_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_harmonytypes_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetEmote() string {
+	if x != nil {
+		return x.Emote
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_harmonytypes_v1_types_proto protoreflect.FileDescriptor
 
 var file_harmonytypes_v1_types_proto_rawDesc = []byte{
@@ -1023,7 +1109,7 @@ var file_harmonytypes_v1_types_proto_rawDesc = []byte{
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
 	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xc0, 0x04, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xa3, 0x05, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x1d, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64,
 	0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
@@ -1059,34 +1145,45 @@ var file_harmonytypes_v1_types_proto_rawDesc = []byte{
 	0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x6f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2a, 0x9a, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x55,
-	0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41,
-	0x4d, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x49, 0x53,
-	0x54, 0x55, 0x52, 0x42, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13,
-	0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x4c,
-	0x49, 0x4e, 0x45, 0x10, 0x04, 0x2a, 0x36, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
-	0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x02, 0x2a, 0x58, 0x0a,
-	0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x10, 0x00, 0x12,
-	0x0c, 0x0a, 0x08, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x6d, 0x61, 0x6c, 0x6c,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x61, 0x72, 0x67, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x10, 0x04, 0x2a, 0x3a, 0x0a, 0x11, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x6f,
-	0x77, 0x10, 0x02, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f,
-	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x08, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x9a, 0x01, 0x0a, 0x0a, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x49,
+	0x53, 0x54, 0x55, 0x52, 0x42, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a,
+	0x13, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x46, 0x46,
+	0x4c, 0x49, 0x4e, 0x45, 0x10, 0x04, 0x2a, 0x36, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x02, 0x2a, 0x58,
+	0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x6d, 0x61, 0x6c,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x61, 0x72, 0x67,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x10, 0x04, 0x2a, 0x3a, 0x0a, 0x11, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x61, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52,
+	0x6f, 0x77, 0x10, 0x02, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x2d, 0x64, 0x65, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x68, 0x61, 0x72, 0x6d, 0x6f, 0x6e, 0x79, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1102,7 +1199,7 @@ func file_harmonytypes_v1_types_proto_rawDescGZIP() []byte {
 }
 
 var file_harmonytypes_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_harmonytypes_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_harmonytypes_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_harmonytypes_v1_types_proto_goTypes = []interface{}{
 	(UserStatus)(0),             // 0: protocol.harmonytypes.v1.UserStatus
 	(ActionType)(0),             // 1: protocol.harmonytypes.v1.ActionType
@@ -1115,14 +1212,15 @@ var file_harmonytypes_v1_types_proto_goTypes = []interface{}{
 	(*Embed)(nil),               // 8: protocol.harmonytypes.v1.Embed
 	(*Attachment)(nil),          // 9: protocol.harmonytypes.v1.Attachment
 	(*Message)(nil),             // 10: protocol.harmonytypes.v1.Message
-	(*empty.Empty)(nil),         // 11: google.protobuf.Empty
-	(*timestamp.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*Reaction)(nil),            // 11: protocol.harmonytypes.v1.Reaction
+	(*empty.Empty)(nil),         // 12: google.protobuf.Empty
+	(*timestamp.Timestamp)(nil), // 13: google.protobuf.Timestamp
 }
 var file_harmonytypes_v1_types_proto_depIdxs = []int32{
-	11, // 0: protocol.harmonytypes.v1.Override.webhook:type_name -> google.protobuf.Empty
-	11, // 1: protocol.harmonytypes.v1.Override.system_plurality:type_name -> google.protobuf.Empty
-	11, // 2: protocol.harmonytypes.v1.Override.system_message:type_name -> google.protobuf.Empty
-	11, // 3: protocol.harmonytypes.v1.Override.bridge:type_name -> google.protobuf.Empty
+	12, // 0: protocol.harmonytypes.v1.Override.webhook:type_name -> google.protobuf.Empty
+	12, // 1: protocol.harmonytypes.v1.Override.system_plurality:type_name -> google.protobuf.Empty
+	12, // 2: protocol.harmonytypes.v1.Override.system_message:type_name -> google.protobuf.Empty
+	12, // 3: protocol.harmonytypes.v1.Override.bridge:type_name -> google.protobuf.Empty
 	1,  // 4: protocol.harmonytypes.v1.Action.type:type_name -> protocol.harmonytypes.v1.ActionType
 	2,  // 5: protocol.harmonytypes.v1.Action.presentation:type_name -> protocol.harmonytypes.v1.ActionPresentation
 	5,  // 6: protocol.harmonytypes.v1.Action.children:type_name -> protocol.harmonytypes.v1.Action
@@ -1132,17 +1230,18 @@ var file_harmonytypes_v1_types_proto_depIdxs = []int32{
 	6,  // 10: protocol.harmonytypes.v1.Embed.footer:type_name -> protocol.harmonytypes.v1.EmbedHeading
 	7,  // 11: protocol.harmonytypes.v1.Embed.fields:type_name -> protocol.harmonytypes.v1.EmbedField
 	5,  // 12: protocol.harmonytypes.v1.Embed.actions:type_name -> protocol.harmonytypes.v1.Action
-	12, // 13: protocol.harmonytypes.v1.Message.created_at:type_name -> google.protobuf.Timestamp
-	12, // 14: protocol.harmonytypes.v1.Message.edited_at:type_name -> google.protobuf.Timestamp
+	13, // 13: protocol.harmonytypes.v1.Message.created_at:type_name -> google.protobuf.Timestamp
+	13, // 14: protocol.harmonytypes.v1.Message.edited_at:type_name -> google.protobuf.Timestamp
 	8,  // 15: protocol.harmonytypes.v1.Message.embeds:type_name -> protocol.harmonytypes.v1.Embed
 	5,  // 16: protocol.harmonytypes.v1.Message.actions:type_name -> protocol.harmonytypes.v1.Action
 	9,  // 17: protocol.harmonytypes.v1.Message.attachments:type_name -> protocol.harmonytypes.v1.Attachment
 	4,  // 18: protocol.harmonytypes.v1.Message.overrides:type_name -> protocol.harmonytypes.v1.Override
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	11, // 19: protocol.harmonytypes.v1.Message.reactions:type_name -> protocol.harmonytypes.v1.Reaction
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_harmonytypes_v1_types_proto_init() }
@@ -1235,6 +1334,18 @@ func file_harmonytypes_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_harmonytypes_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_harmonytypes_v1_types_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Override_UserDefined)(nil),
@@ -1249,7 +1360,7 @@ func file_harmonytypes_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_harmonytypes_v1_types_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -696,6 +696,23 @@ func (m *Message) Validate() error {
 		}
 	}
 
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ThreadId
+
 	return nil
 }
 
@@ -752,3 +769,73 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MessageValidationError{}
+
+// Validate checks the field values on Reaction with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Reaction) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Emoji
+
+	// no validation rules for Emote
+
+	// no validation rules for Count
+
+	return nil
+}
+
+// ReactionValidationError is the validation error returned by
+// Reaction.Validate if the designated constraints aren't met.
+type ReactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionValidationError) ErrorName() string { return "ReactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionValidationError{}
@@ -8,6 +8,7 @@ import (
 	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
 	"github.com/harmony-development/legato/server/api/authsvc"
 	"github.com/harmony-development/legato/server/api/chat"
+	v1 "github.com/harmony-development/legato/server/api/chat/v1"
 	"github.com/harmony-development/legato/server/api/chat/v1/permissions"
 	"github.com/harmony-development/legato/server/api/middleware"
 	"github.com/harmony-development/legato/server/auth"
@@ -34,6 +35,7 @@ type Dependencies struct {
 	Config         *config.Config
 	Permissions    *permissions.Manager
 	StorageBackend backend.AttachmentBackend
+	PubSub         v1.SubscriptionManager
 }
 
 // API contains the component of the server responsible for APIs
@@ -86,14 +88,16 @@ func New(deps Dependencies) *API {
 		Handler: prometheusMux,
 	}
 
-	chatv1.RegisterChatServiceServer(api.GrpcServer, chat.New(&chat.Dependencies{
+	api.ChatSvc = chat.New(&chat.Dependencies{
 		DB:             api.DB,
 		Logger:         api.Logger,
 		Sonyflake:      api.Sonyflake,
 		Perms:          api.Permissions,
 		Config:         deps.Config,
 		StorageBackend: deps.StorageBackend,
-	}).V1)
+		PubSub:         deps.PubSub,
+	})
+	chatv1.RegisterChatServiceServer(api.GrpcServer, api.ChatSvc.V1)
 	authv1.RegisterAuthServiceServer(api.GrpcServer, authsvc.New(&authsvc.Dependencies{
 		DB:          api.DB,
 		Logger:      api.Logger,
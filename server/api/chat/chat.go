@@ -3,7 +3,12 @@ package chat
 import (
 	v1 "github.com/harmony-development/legato/server/api/chat/v1"
 	"github.com/harmony-development/legato/server/api/chat/v1/permissions"
-	"github.com/harmony-development/legato/server/api/chat/v1/pubsub_backends/integrated"
+	"github.com/harmony-development/legato/server/api/chat/v1/presence"
+	"github.com/harmony-development/legato/server/api/chat/v1/retention"
+	"github.com/harmony-development/legato/server/api/chat/v1/scheduler"
+	"github.com/harmony-development/legato/server/api/chat/v1/threads"
+	"github.com/harmony-development/legato/server/api/chat/v1/typing"
+	"github.com/harmony-development/legato/server/api/chat/v1/unfurl"
 	"github.com/harmony-development/legato/server/config"
 	"github.com/harmony-development/legato/server/db"
 	"github.com/harmony-development/legato/server/http/attachments/backend"
@@ -19,6 +24,7 @@ type Dependencies struct {
 	Perms          *permissions.Manager
 	Config         *config.Config
 	StorageBackend backend.AttachmentBackend
+	PubSub         v1.SubscriptionManager
 }
 
 // Service contains the chat service
@@ -32,20 +38,55 @@ func New(deps *Dependencies) *Service {
 	chat := &Service{
 		Dependencies: deps,
 	}
+	presenceManager := presence.New(presence.Dependencies{
+		DB:     deps.DB,
+		Logger: deps.Logger,
+		Config: deps.Config,
+		Guild:  deps.PubSub.Guild,
+	})
+	go presenceManager.Run()
+	go threads.New(threads.Dependencies{
+		DB:     deps.DB,
+		Logger: deps.Logger,
+		Config: deps.Config,
+		Guild:  deps.PubSub.Guild,
+	}).Run()
+	unfurler := unfurl.New(unfurl.Dependencies{
+		DB:             deps.DB,
+		Logger:         deps.Logger,
+		Config:         deps.Config,
+		Guild:          deps.PubSub.Guild,
+		StorageBackend: deps.StorageBackend,
+	})
+	go unfurler.Run()
 	chat.V1 = &v1.V1{
 		Dependencies: v1.Dependencies{
-			DB:        deps.DB,
-			Logger:    deps.Logger,
-			Sonyflake: deps.Sonyflake,
-			Perms:     deps.Perms,
-			PubSub: v1.SubscriptionManager{
-				Actions:    (&integrated.ActionState{}).Initialize(),
-				Guild:      (&integrated.GuildState{}).Initialize(),
-				Homeserver: (&integrated.HomeserverEventState{}).Initialize(),
-			},
+			DB:             deps.DB,
+			Logger:         deps.Logger,
+			Sonyflake:      deps.Sonyflake,
+			Perms:          deps.Perms,
+			PubSub:         deps.PubSub,
+			Presence:       presenceManager,
+			TypingManager:  typing.New(deps.PubSub.Guild),
+			Unfurler:       unfurler,
 			Config:         deps.Config,
 			StorageBackend: deps.StorageBackend,
 		},
 	}
+	go scheduler.New(scheduler.Dependencies{
+		DB:        deps.DB,
+		Logger:    deps.Logger,
+		Config:    deps.Config,
+		Sonyflake: deps.Sonyflake,
+		Perms:     deps.Perms,
+		Sender:    chat.V1,
+	}).Run()
+	go retention.New(retention.Dependencies{
+		DB:             deps.DB,
+		Logger:         deps.Logger,
+		Config:         deps.Config,
+		Guild:          deps.PubSub.Guild,
+		StorageBackend: deps.StorageBackend,
+	}).Run()
 	return chat
 }
@@ -0,0 +1,114 @@
+package automod
+
+import (
+	"errors"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+)
+
+var (
+	// ErrNoTrigger is returned for rules that don't say what they match
+	ErrNoTrigger = errors.New("rule has no trigger")
+	// ErrNoActions is returned for rules that don't do anything
+	ErrNoActions = errors.New("rule has no actions")
+	// ErrBadAction is returned for actions missing their parameters
+	ErrBadAction = errors.New("rule has an invalid action")
+)
+
+// Message is the part of a message rules look at
+type Message struct {
+	Content         string
+	AttachmentTypes []string
+}
+
+// Flag is a report of a message to post in a moderator channel
+type Flag struct {
+	ChannelID uint64
+	Rule      *chatv1.AutomodRule
+}
+
+// Verdict is what should happen to a message, combining the actions of
+// every rule it broke
+type Verdict struct {
+	Block   bool
+	Delete  bool
+	Flags   []Flag
+	Timeout time.Duration
+}
+
+// Broken returns whether the message broke any rule
+func (v Verdict) Broken() bool {
+	return v.Block || v.Delete || len(v.Flags) > 0 || v.Timeout > 0
+}
+
+// Validate checks that a rule has a trigger and actions that make sense,
+// and that its patterns compile
+func Validate(rule *chatv1.AutomodRule) error {
+	if rule.GetTrigger().GetKind() == nil {
+		return ErrNoTrigger
+	}
+	if len(rule.Actions) == 0 {
+		return ErrNoActions
+	}
+	for _, action := range rule.Actions {
+		switch kind := action.Kind.(type) {
+		case *chatv1.AutomodAction_Block_, *chatv1.AutomodAction_Delete_:
+		case *chatv1.AutomodAction_Flag_:
+			if kind.Flag.ChannelId == 0 {
+				return ErrBadAction
+			}
+		case *chatv1.AutomodAction_Timeout_:
+			if kind.Timeout.Duration == 0 {
+				return ErrBadAction
+			}
+		default:
+			return ErrBadAction
+		}
+	}
+	return validateTrigger(rule.Trigger)
+}
+
+// Exempt returns whether a member with the given roles is exempt from a rule
+func Exempt(rule *chatv1.AutomodRule, roles []uint64) bool {
+	for _, exempt := range rule.ExemptRoles {
+		for _, role := range roles {
+			if role == exempt {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Evaluate runs the enabled rules a member with the given roles isn't
+// exempt from against a message. Blocking wins over deleting, and the
+// longest timeout is kept.
+func Evaluate(rules []*chatv1.AutomodRule, roles []uint64, message Message) (verdict Verdict) {
+	for _, rule := range rules {
+		if !rule.Enabled || Exempt(rule, roles) || !Matches(rule.Trigger, message) {
+			continue
+		}
+		for _, action := range rule.Actions {
+			switch kind := action.Kind.(type) {
+			case *chatv1.AutomodAction_Block_:
+				verdict.Block = true
+			case *chatv1.AutomodAction_Delete_:
+				verdict.Delete = true
+			case *chatv1.AutomodAction_Flag_:
+				verdict.Flags = append(verdict.Flags, Flag{
+					ChannelID: kind.Flag.ChannelId,
+					Rule:      rule,
+				})
+			case *chatv1.AutomodAction_Timeout_:
+				if d := time.Duration(kind.Timeout.Duration) * time.Second; d > verdict.Timeout {
+					verdict.Timeout = d
+				}
+			}
+		}
+	}
+	if verdict.Block {
+		verdict.Delete = false
+	}
+	return
+}
@@ -0,0 +1,139 @@
+package automod
+
+import (
+	"testing"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+)
+
+func regex(patterns ...string) *chatv1.AutomodTrigger {
+	return &chatv1.AutomodTrigger{Kind: &chatv1.AutomodTrigger_Regex_{Regex: &chatv1.AutomodTrigger_Regex{Patterns: patterns}}}
+}
+
+func words(words ...string) *chatv1.AutomodTrigger {
+	return &chatv1.AutomodTrigger{Kind: &chatv1.AutomodTrigger_Words_{Words: &chatv1.AutomodTrigger_Words{Words: words}}}
+}
+
+func links(invitesOnly bool, allowed ...string) *chatv1.AutomodTrigger {
+	return &chatv1.AutomodTrigger{Kind: &chatv1.AutomodTrigger_Links_{Links: &chatv1.AutomodTrigger_Links{AllowedDomains: allowed, InvitesOnly: invitesOnly}}}
+}
+
+func mentionLimit(maximum uint32) *chatv1.AutomodTrigger {
+	return &chatv1.AutomodTrigger{Kind: &chatv1.AutomodTrigger_Mentions_{Mentions: &chatv1.AutomodTrigger_Mentions{Maximum: maximum}}}
+}
+
+func attachments(blocked ...string) *chatv1.AutomodTrigger {
+	return &chatv1.AutomodTrigger{Kind: &chatv1.AutomodTrigger_Attachments_{Attachments: &chatv1.AutomodTrigger_Attachments{BlockedTypes: blocked}}}
+}
+
+func TestMatches(t *testing.T) {
+	cases := []struct {
+		name    string
+		trigger *chatv1.AutomodTrigger
+		message Message
+		want    bool
+	}{
+		{"regex is case sensitive", regex(`fr[e3]{2} nitro`), Message{Content: "get FREE nitro"}, false},
+		{"regex case", regex(`(?i)fr[e3]{2} nitro`), Message{Content: "get FR33 nitro"}, true},
+		{"word", words("heck"), Message{Content: "what the HECK."}, true},
+		{"word inside another", words("heck"), Message{Content: "check this"}, false},
+		{"empty words", words(" "), Message{Content: "anything"}, false},
+		{"link not allowed", links(false, "example.com"), Message{Content: "see https://evil.test/x"}, true},
+		{"subdomain allowed", links(false, "example.com"), Message{Content: "see https://docs.example.com/x"}, false},
+		{"lookalike domain", links(false, "example.com"), Message{Content: "https://notexample.com"}, true},
+		{"no links", links(false), Message{Content: "just text"}, false},
+		{"harmony invite", links(true), Message{Content: "join harmony://chat.example/abc"}, true},
+		{"web invite", links(true), Message{Content: "join https://chat.example/invite/abc"}, true},
+		{"link that isn't an invite", links(true), Message{Content: "https://example.com"}, false},
+		{"mentions under limit", mentionLimit(2), Message{Content: "<@1> <@2> <@1>"}, false},
+		{"mentions over limit", mentionLimit(2), Message{Content: "<@1> <@2> <@&3>"}, true},
+		{"everyone counts", mentionLimit(0), Message{Content: "@everyone"}, true},
+		{"blocked type", attachments("application/x-msdownload"), Message{AttachmentTypes: []string{"application/x-msdownload"}}, true},
+		{"blocked category", attachments("video/*"), Message{AttachmentTypes: []string{"image/png", "Video/MP4"}}, true},
+		{"allowed type", attachments("video/*"), Message{AttachmentTypes: []string{"text/plain; charset=utf-8"}}, false},
+	}
+	for _, c := range cases {
+		if got := Matches(c.trigger, c.message); got != c.want {
+			t.Errorf("%s: Matches = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	block := &chatv1.AutomodAction{Kind: &chatv1.AutomodAction_Block_{Block: &chatv1.AutomodAction_Block{}}}
+	cases := []struct {
+		name string
+		rule *chatv1.AutomodRule
+		ok   bool
+	}{
+		{"valid", &chatv1.AutomodRule{Trigger: regex(`a+`), Actions: []*chatv1.AutomodAction{block}}, true},
+		{"no trigger", &chatv1.AutomodRule{Actions: []*chatv1.AutomodAction{block}}, false},
+		{"no actions", &chatv1.AutomodRule{Trigger: regex(`a+`)}, false},
+		{"bad regex", &chatv1.AutomodRule{Trigger: regex(`(`), Actions: []*chatv1.AutomodAction{block}}, false},
+		{"flag without channel", &chatv1.AutomodRule{Trigger: regex(`a`), Actions: []*chatv1.AutomodAction{
+			{Kind: &chatv1.AutomodAction_Flag_{Flag: &chatv1.AutomodAction_Flag{}}},
+		}}, false},
+		{"empty action", &chatv1.AutomodRule{Trigger: regex(`a`), Actions: []*chatv1.AutomodAction{{}}}, false},
+	}
+	for _, c := range cases {
+		if err := Validate(c.rule); (err == nil) != c.ok {
+			t.Errorf("%s: Validate = %v, want ok %v", c.name, err, c.ok)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	rules := []*chatv1.AutomodRule{
+		{
+			RuleId:  1,
+			Enabled: true,
+			Trigger: words("spam"),
+			Actions: []*chatv1.AutomodAction{
+				{Kind: &chatv1.AutomodAction_Delete_{Delete: &chatv1.AutomodAction_Delete{}}},
+				{Kind: &chatv1.AutomodAction_Flag_{Flag: &chatv1.AutomodAction_Flag{ChannelId: 9}}},
+				{Kind: &chatv1.AutomodAction_Timeout_{Timeout: &chatv1.AutomodAction_Timeout{Duration: 60}}},
+			},
+			ExemptRoles: []uint64{5},
+		},
+		{
+			RuleId:  2,
+			Enabled: true,
+			Trigger: links(false),
+			Actions: []*chatv1.AutomodAction{
+				{Kind: &chatv1.AutomodAction_Block_{Block: &chatv1.AutomodAction_Block{}}},
+				{Kind: &chatv1.AutomodAction_Timeout_{Timeout: &chatv1.AutomodAction_Timeout{Duration: 30}}},
+			},
+		},
+		{
+			RuleId:  3,
+			Trigger: words("spam"),
+			Actions: []*chatv1.AutomodAction{
+				{Kind: &chatv1.AutomodAction_Block_{Block: &chatv1.AutomodAction_Block{}}},
+			},
+		},
+	}
+
+	v := Evaluate(rules, nil, Message{Content: "hello"})
+	if v.Broken() {
+		t.Errorf("expected no rule to be broken, got %+v", v)
+	}
+
+	v = Evaluate(rules, nil, Message{Content: "spam"})
+	if v.Block || !v.Delete || v.Timeout != time.Minute {
+		t.Errorf("unexpected verdict %+v", v)
+	}
+	if len(v.Flags) != 1 || v.Flags[0].ChannelID != 9 || v.Flags[0].Rule.RuleId != 1 {
+		t.Errorf("unexpected flags %+v", v.Flags)
+	}
+
+	v = Evaluate(rules, nil, Message{Content: "spam https://example.com"})
+	if !v.Block || v.Delete || v.Timeout != time.Minute {
+		t.Errorf("expected blocking to win, got %+v", v)
+	}
+
+	v = Evaluate(rules, []uint64{4, 5}, Message{Content: "spam"})
+	if v.Broken() {
+		t.Errorf("expected exempt roles to skip the rule, got %+v", v)
+	}
+}
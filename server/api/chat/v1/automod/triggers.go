@@ -0,0 +1,138 @@
+package automod
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/api/chat/v1/mentions"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\b[a-z][a-z0-9+.-]*://[^\s<>"]+`)
+
+// rules are evaluated on every message, so their patterns are only
+// compiled once
+var compiled, _ = lru.New(4096)
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := compiled.Get(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	compiled.Add(pattern, re)
+	return re, nil
+}
+
+// wordsPattern matches any of the words on its own, ignoring case
+func wordsPattern(words []string) string {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return ""
+	}
+	return `(?i)(^|\W)(` + strings.Join(quoted, "|") + `)($|\W)`
+}
+
+func validateTrigger(trigger *chatv1.AutomodTrigger) error {
+	switch kind := trigger.Kind.(type) {
+	case *chatv1.AutomodTrigger_Regex_:
+		for _, pattern := range kind.Regex.Patterns {
+			if _, err := compile(pattern); err != nil {
+				return err
+			}
+		}
+	case *chatv1.AutomodTrigger_Words_:
+		if pattern := wordsPattern(kind.Words.Words); pattern != "" {
+			if _, err := compile(pattern); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Matches returns whether a trigger matches a message
+func Matches(trigger *chatv1.AutomodTrigger, message Message) bool {
+	switch kind := trigger.GetKind().(type) {
+	case *chatv1.AutomodTrigger_Regex_:
+		for _, pattern := range kind.Regex.Patterns {
+			if re, err := compile(pattern); err == nil && re.MatchString(message.Content) {
+				return true
+			}
+		}
+	case *chatv1.AutomodTrigger_Words_:
+		pattern := wordsPattern(kind.Words.Words)
+		if pattern == "" {
+			return false
+		}
+		re, err := compile(pattern)
+		return err == nil && re.MatchString(message.Content)
+	case *chatv1.AutomodTrigger_Links_:
+		for _, link := range linkPattern.FindAllString(message.Content, -1) {
+			parsed, err := url.Parse(link)
+			if err != nil {
+				continue
+			}
+			if kind.Links.InvitesOnly {
+				if isInvite(parsed) {
+					return true
+				}
+			} else if !allowedDomain(parsed.Hostname(), kind.Links.AllowedDomains) {
+				return true
+			}
+		}
+	case *chatv1.AutomodTrigger_Mentions_:
+		found := mentions.Parse(message.Content)
+		count := len(found.Users) + len(found.Roles)
+		if found.Everyone {
+			count++
+		}
+		return count > int(kind.Mentions.Maximum)
+	case *chatv1.AutomodTrigger_Attachments_:
+		for _, contentType := range message.AttachmentTypes {
+			for _, blocked := range kind.Attachments.BlockedTypes {
+				if typeMatches(strings.ToLower(contentType), strings.ToLower(blocked)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isInvite(link *url.URL) bool {
+	return strings.EqualFold(link.Scheme, "harmony") || strings.Contains(link.Path, "/invite/")
+}
+
+func allowedDomain(host string, allowed []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, domain := range allowed {
+		domain = strings.ToLower(strings.TrimPrefix(domain, "."))
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeMatches matches a content type against a blocked type, ignoring
+// parameters like charset
+func typeMatches(contentType, blocked string) bool {
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.TrimSpace(contentType)
+	if strings.HasSuffix(blocked, "/*") {
+		return strings.HasPrefix(contentType, strings.TrimSuffix(blocked, "*"))
+	}
+	return contentType == blocked
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/responses"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eventStream wraps a StreamEvents stream so that it can be written to
+// from several goroutines and ended from the server side
+type eventStream struct {
+	chatv1.ChatService_StreamEventsServer
+	ctx       context.Context
+	cancel    context.CancelFunc
+	err       error
+	missed    int32
+	tasks     sync.WaitGroup
+	sendLock  sync.Mutex
+	finished  bool // guarded by sendLock
+	closeLock sync.Mutex
+}
+
+func newEventStream(s chatv1.ChatService_StreamEventsServer) *eventStream {
+	ctx, cancel := context.WithCancel(s.Context())
+	return &eventStream{
+		ChatService_StreamEventsServer: s,
+		ctx:                            ctx,
+		cancel:                         cancel,
+	}
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) Send(e *chatv1.Event) error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+
+	if s.finished {
+		return context.Canceled
+	}
+	return s.ChatService_StreamEventsServer.Send(e)
+}
+
+// spawn runs a goroutine writing to the stream which finish waits for
+func (s *eventStream) spawn(f func()) {
+	s.tasks.Add(1)
+	go func() {
+		defer s.tasks.Done()
+		f()
+	}()
+}
+
+// finish ends the stream once StreamEvents returns. It waits for the
+// goroutines writing to the stream, and for any send in progress, so that
+// the transport isn't written to after its handler has returned. Pub/sub
+// backends may still hold on to the stream, their sends fail from now on.
+func (s *eventStream) finish() {
+	s.cancel()
+	s.tasks.Wait()
+	s.sendLock.Lock()
+	s.finished = true
+	s.sendLock.Unlock()
+}
+
+// Close ends the stream, pub/sub backends use it to drop streams that
+// can't keep up
+func (s *eventStream) Close() {
+	s.closeWith(status.Error(codes.ResourceExhausted, responses.StreamTooSlow))
+}
+
+func (s *eventStream) closeWith(err error) {
+	s.closeLock.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.closeLock.Unlock()
+	s.cancel()
+}
+
+func (s *eventStream) closeErr() error {
+	s.closeLock.Lock()
+	defer s.closeLock.Unlock()
+
+	return s.err
+}
+
+func (s *eventStream) sentHeartbeat() {
+	atomic.AddInt32(&s.missed, 1)
+}
+
+func (s *eventStream) ackHeartbeat() {
+	atomic.StoreInt32(&s.missed, 0)
+}
+
+func (s *eventStream) unacked() int32 {
+	return atomic.LoadInt32(&s.missed)
+}
@@ -0,0 +1,50 @@
+package mentions
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Users are mentioned with <@userID>, roles with <@&roleID> and everyone
+// in the guild with @everyone
+var (
+	userPattern     = regexp.MustCompile(`<@(\d+)>`)
+	rolePattern     = regexp.MustCompile(`<@&(\d+)>`)
+	everyonePattern = regexp.MustCompile(`(^|[^\w@])@everyone\b`)
+)
+
+// Mentions are the mentions found in a message
+type Mentions struct {
+	Users    []uint64
+	Roles    []uint64
+	Everyone bool
+}
+
+// Empty returns whether the message doesn't mention anyone
+func (m Mentions) Empty() bool {
+	return len(m.Users) == 0 && len(m.Roles) == 0 && !m.Everyone
+}
+
+// Parse finds the mentions in the content of a message, ignoring
+// duplicates and IDs that don't fit in a uint64
+func Parse(content string) Mentions {
+	return Mentions{
+		Users:    ids(userPattern, content),
+		Roles:    ids(rolePattern, content),
+		Everyone: everyonePattern.MatchString(content),
+	}
+}
+
+func ids(pattern *regexp.Regexp, content string) []uint64 {
+	var ret []uint64
+	seen := map[uint64]bool{}
+	for _, match := range pattern.FindAllStringSubmatch(content, -1) {
+		id, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ret = append(ret, id)
+	}
+	return ret
+}
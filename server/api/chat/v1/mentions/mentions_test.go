@@ -0,0 +1,26 @@
+package mentions
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		content string
+		want    Mentions
+	}{
+		{"hello", Mentions{}},
+		{"hi <@12> and <@34>, <@12>", Mentions{Users: []uint64{12, 34}}},
+		{"<@&56> <@&56> <@78>", Mentions{Users: []uint64{78}, Roles: []uint64{56}}},
+		{"@everyone look", Mentions{Everyone: true}},
+		{"mail me at me@everyone.com or @@everyone", Mentions{}},
+		{"<@99999999999999999999>", Mentions{}},
+	}
+	for _, c := range cases {
+		got := Parse(c.content)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Parse(%q) = %+v, want %+v", c.content, got, c.want)
+		}
+	}
+}
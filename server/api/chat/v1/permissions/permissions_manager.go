@@ -1,18 +1,35 @@
 package permissions
 
 import (
+	"time"
+
 	"github.com/alecthomas/repr"
 	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
 	"github.com/harmony-development/legato/server/db"
 	lru "github.com/hashicorp/golang-lru"
 )
 
+// rolesTTL is how long the roles of a member are cached for, changes
+// made through another instance are picked up after it
+const rolesTTL = time.Minute
+
 // Manager manages permissions
 type Manager struct {
 	states *lru.Cache
+	roles  *lru.Cache
 	db     db.IHarmonyDB
 }
 
+type member struct {
+	guildID uint64
+	userID  uint64
+}
+
+type cachedRoles struct {
+	roles     []uint64
+	fetchedAt time.Time
+}
+
 // NewManager creates a new permissions manager
 func NewManager(db db.IHarmonyDB) *Manager {
 	man := &Manager{
@@ -23,6 +40,11 @@ func NewManager(db db.IHarmonyDB) *Manager {
 		panic(err)
 	}
 	man.states = cache
+	roles, err := lru.New(50_000)
+	if err != nil {
+		panic(err)
+	}
+	man.roles = roles
 	return man
 }
 
@@ -123,6 +145,83 @@ func (p *Manager) Check(permission string, userRoles []uint64, inGuild uint64, i
 	return state.Check(permission, userRoles, ChannelID(inChannel))
 }
 
+// CanView checks whether a user can see the messages of a channel
+func (p *Manager) CanView(guildID, channelID, userID uint64) bool {
+	return p.ChannelViewers(guildID, channelID)(userID)
+}
+
+// ChannelViewers returns a check for whether users can see the messages of
+// a channel, the owner and permissions of the guild are only looked up once
+// so that it can be used for every subscriber of an event
+func (p *Manager) ChannelViewers(guildID, channelID uint64) func(userID uint64) bool {
+	owner, err := p.db.GetOwner(guildID)
+	if err != nil {
+		return func(uint64) bool { return false }
+	}
+	state := p.ensureGuild(guildID)
+	return func(userID uint64) bool {
+		if owner == userID {
+			return true
+		}
+		roles, err := p.rolesFor(guildID, userID)
+		if err != nil {
+			return false
+		}
+		return state.Check("messages.view", roles, ChannelID(channelID))
+	}
+}
+
+// ViewableChannels returns a check for whether a user can see the messages
+// of channels in a guild, it doesn't query the database once it's returned
+func (p *Manager) ViewableChannels(guildID, userID uint64) func(channelID uint64) bool {
+	owner, err := p.db.GetOwner(guildID)
+	if err != nil {
+		return func(uint64) bool { return false }
+	}
+	if owner == userID {
+		return func(uint64) bool { return true }
+	}
+	roles, err := p.rolesFor(guildID, userID)
+	if err != nil {
+		return func(uint64) bool { return false }
+	}
+	state := p.ensureGuild(guildID)
+	return func(channelID uint64) bool {
+		return state.Check("messages.view", roles, ChannelID(channelID))
+	}
+}
+
+// ForgetRoles drops the cached roles of a member after they change
+func (p *Manager) ForgetRoles(guildID, userID uint64) {
+	p.roles.Remove(member{guildID, userID})
+}
+
+// ForgetGuildRoles drops the cached roles of every member of a guild
+func (p *Manager) ForgetGuildRoles(guildID uint64) {
+	for _, key := range p.roles.Keys() {
+		if key.(member).guildID == guildID {
+			p.roles.Remove(key)
+		}
+	}
+}
+
+func (p *Manager) rolesFor(guildID, userID uint64) ([]uint64, error) {
+	if data, ok := p.roles.Get(member{guildID, userID}); ok {
+		if cached := data.(cachedRoles); time.Since(cached.fetchedAt) < rolesTTL {
+			return cached.roles, nil
+		}
+	}
+	roles, err := p.db.RolesForUser(guildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	p.roles.Add(member{guildID, userID}, cachedRoles{
+		roles:     roles,
+		fetchedAt: time.Now(),
+	})
+	return roles, nil
+}
+
 func (p *Manager) ensureGuild(guildID uint64) *GuildState {
 	if !p.states.Contains(guildID) {
 		p.states.Add(guildID, p.obtainGuild(guildID))
@@ -0,0 +1,272 @@
+package presence
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	harmonytypesv1 "github.com/harmony-development/legato/gen/harmonytypes/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/logger"
+)
+
+// Broadcaster delivers events to the subscribers of a guild
+type Broadcaster interface {
+	Broadcast(to uint64, event *chatv1.Event)
+}
+
+// Dependencies are the backend services this package needs
+type Dependencies struct {
+	DB     db.IHarmonyDB
+	Logger logger.ILogger
+	Config *config.Config
+	Guild  Broadcaster
+}
+
+// Manager keeps the status of users in sync with their event streams. The
+// streams each instance has open are counted in the database, so a user
+// stays online while they're connected to any instance.
+type Manager struct {
+	Dependencies
+	instanceID string
+	users      map[uint64]*user
+	sync.Mutex
+}
+
+// user is what an instance knows about the presence of a user. Changes to a
+// user, along with the database writes and broadcasts they cause, are made
+// while holding its lock, so they're applied in order without holding up the
+// changes of other users.
+type user struct {
+	sync.Mutex
+	// waiting is how many goroutines hold or wait for the lock, and is
+	// guarded by the lock of the manager
+	waiting int
+	streams int
+	// idle takes the user offline once their last stream has been closed
+	// for the idle timeout
+	idle *time.Timer
+	// stale is set when the streams couldn't be stored, so they're stored
+	// again on the next check in
+	stale bool
+}
+
+// New creates a new presence manager
+func New(deps Dependencies) *Manager {
+	m := &Manager{
+		Dependencies: deps,
+		instanceID:   uuid.New().String(),
+		users:        make(map[uint64]*user),
+	}
+	// with the integrated pubsub every stream lives on this instance,
+	// so nobody can be online before it starts
+	if deps.Config.Server.PubSubBackend == "Integrated" {
+		if _, err := deps.DB.ExpirePresenceInstances(time.Now().UTC()); err != nil {
+			deps.Logger.Exception(err)
+		}
+		if err := deps.DB.ResetStatuses(); err != nil {
+			deps.Logger.Exception(err)
+		}
+	}
+	if err := deps.DB.AddPresenceInstance(m.instanceID); err != nil {
+		deps.Logger.Exception(err)
+	}
+	return m
+}
+
+// acquire locks a user
+func (m *Manager) acquire(userID uint64) *user {
+	m.Lock()
+	u, ok := m.users[userID]
+	if !ok {
+		u = &user{}
+		m.users[userID] = u
+	}
+	u.waiting++
+	m.Unlock()
+
+	u.Lock()
+	return u
+}
+
+// release unlocks a user, and forgets them once there's nothing left to
+// know about them
+func (m *Manager) release(userID uint64, u *user) {
+	u.Unlock()
+
+	m.Lock()
+	defer m.Unlock()
+
+	u.waiting--
+	if u.waiting == 0 && u.streams == 0 && u.idle == nil && !u.stale {
+		delete(m.users, userID)
+	}
+}
+
+// store writes the streams of a user on this instance to the database. The
+// row of a user with no streams is kept until they've been idle for long
+// enough, so they stay online in the meantime.
+func (m *Manager) store(userID uint64, u *user) error {
+	var err error
+	if u.streams == 0 && u.idle == nil {
+		err = m.DB.RemovePresenceStreams(m.instanceID, userID)
+	} else {
+		err = m.DB.SetPresenceStreams(m.instanceID, userID, int32(u.streams))
+	}
+	u.stale = err != nil
+	return err
+}
+
+// Run checks this instance in, and takes users offline whose streams were
+// on instances that stopped checking in, such as after a crash
+func (m *Manager) Run() {
+	timeout := m.Config.Server.Policies.Presence.InstanceTimeout
+	ticker := time.NewTicker(timeout / 3)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		m.checkIn()
+		users, err := m.DB.ExpirePresenceInstances(time.Now().UTC().Add(-timeout))
+		if err != nil {
+			continue
+		}
+		for _, userID := range users {
+			u := m.acquire(userID)
+			m.update(userID)
+			m.release(userID, u)
+		}
+	}
+}
+
+// checkIn records that this instance is still running. If it was taken for
+// dead in the meantime its streams are counted again, and streams that
+// couldn't be stored before are stored now.
+func (m *Manager) checkIn() {
+	ok, err := m.DB.TouchPresenceInstance(m.instanceID)
+	if err != nil {
+		return
+	}
+	if !ok {
+		if err := m.DB.AddPresenceInstance(m.instanceID); err != nil {
+			return
+		}
+	}
+	m.Lock()
+	users := make([]uint64, 0, len(m.users))
+	for userID := range m.users {
+		users = append(users, userID)
+	}
+	m.Unlock()
+
+	for _, userID := range users {
+		u := m.acquire(userID)
+		if (!ok || u.stale) && m.store(userID, u) == nil {
+			m.update(userID)
+		}
+		m.release(userID, u)
+	}
+}
+
+// Connect records a new event stream of a user
+func (m *Manager) Connect(userID uint64) {
+	u := m.acquire(userID)
+	defer m.release(userID, u)
+
+	u.streams++
+	if u.idle != nil {
+		u.idle.Stop()
+		u.idle = nil
+		m.store(userID, u)
+		return
+	}
+	if m.store(userID, u) == nil && u.streams == 1 {
+		m.update(userID)
+	}
+}
+
+// Disconnect records that an event stream of a user closed. The user goes
+// offline once they've had no streams on any instance for the idle timeout.
+func (m *Manager) Disconnect(userID uint64) {
+	u := m.acquire(userID)
+	defer m.release(userID, u)
+
+	u.streams--
+	if u.streams == 0 {
+		var idle *time.Timer
+		idle = time.AfterFunc(m.Config.Server.Policies.Presence.IdleTimeout, func() {
+			u := m.acquire(userID)
+			defer m.release(userID, u)
+
+			// the user reconnected while this was waiting for the lock
+			if u.idle != idle {
+				return
+			}
+			u.idle = nil
+			if m.store(userID, u) == nil {
+				m.update(userID)
+			}
+		})
+		u.idle = idle
+	}
+	m.store(userID, u)
+}
+
+// SetChosen sets the status a user picked for themselves
+func (m *Manager) SetChosen(userID uint64, status harmonytypesv1.UserStatus) error {
+	u := m.acquire(userID)
+	defer m.release(userID, u)
+
+	if err := m.DB.SetChosenStatus(userID, status); err != nil {
+		return err
+	}
+	m.update(userID)
+	return nil
+}
+
+// update stores the status a user should have now and tells the guilds
+// they're in if it changed. It's called with the lock of the user held.
+func (m *Manager) update(userID uint64) {
+	online, err := m.DB.UserHasStreams(userID)
+	if err != nil {
+		return
+	}
+	status := harmonytypesv1.UserStatus_USER_STATUS_OFFLINE
+	if online {
+		chosen, err := m.DB.GetChosenStatus(userID)
+		if err != nil {
+			m.Logger.Exception(err)
+			return
+		}
+		status = chosen
+	}
+	user, err := m.DB.GetUserByID(userID)
+	if err != nil {
+		m.Logger.Exception(err)
+		return
+	}
+	if harmonytypesv1.UserStatus(user.Status) == status {
+		return
+	}
+	if err := m.DB.SetStatus(userID, status); err != nil {
+		m.Logger.Exception(err)
+		return
+	}
+	guilds, err := m.DB.GetLocalGuilds(userID)
+	if err != nil {
+		m.Logger.Exception(err)
+		return
+	}
+	for _, guildID := range guilds {
+		m.Guild.Broadcast(guildID, &chatv1.Event{
+			Event: &chatv1.Event_ProfileUpdated_{
+				ProfileUpdated: &chatv1.Event_ProfileUpdated{
+					UserId:       userID,
+					NewStatus:    status,
+					UpdateStatus: true,
+				},
+			},
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package presence
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	harmonytypesv1 "github.com/harmony-development/legato/gen/harmonytypes/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/db/queries"
+)
+
+// presenceDB keeps the streams and statuses of users in memory, every other
+// method panics
+type presenceDB struct {
+	db.IHarmonyDB
+	sync.Mutex
+	streams  map[uint64]int32
+	statuses map[uint64]harmonytypesv1.UserStatus
+	failing  bool
+}
+
+func (d *presenceDB) AddPresenceInstance(instanceID string) error { return nil }
+
+func (d *presenceDB) TouchPresenceInstance(instanceID string) (bool, error) { return true, nil }
+
+func (d *presenceDB) SetPresenceStreams(instanceID string, userID uint64, streams int32) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.failing {
+		return errors.New("connection refused")
+	}
+	d.streams[userID] = streams
+	return nil
+}
+
+func (d *presenceDB) RemovePresenceStreams(instanceID string, userID uint64) error {
+	d.Lock()
+	defer d.Unlock()
+
+	delete(d.streams, userID)
+	return nil
+}
+
+func (d *presenceDB) UserHasStreams(userID uint64) (bool, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	_, ok := d.streams[userID]
+	return ok, nil
+}
+
+func (d *presenceDB) GetChosenStatus(userID uint64) (harmonytypesv1.UserStatus, error) {
+	return harmonytypesv1.UserStatus_USER_STATUS_ONLINE_UNSPECIFIED, nil
+}
+
+func (d *presenceDB) GetUserByID(userID uint64) (queries.GetUserRow, error) {
+	return queries.GetUserRow{Status: int16(d.status(userID))}, nil
+}
+
+func (d *presenceDB) SetStatus(userID uint64, status harmonytypesv1.UserStatus) error {
+	d.Lock()
+	defer d.Unlock()
+
+	d.statuses[userID] = status
+	return nil
+}
+
+func (d *presenceDB) GetLocalGuilds(userID uint64) ([]uint64, error) {
+	return nil, nil
+}
+
+type broadcaster struct{}
+
+func (broadcaster) Broadcast(to uint64, event *chatv1.Event) {}
+
+func newTestManager(idleTimeout time.Duration) (*Manager, *presenceDB) {
+	cfg := &config.Config{}
+	cfg.Server.Policies.Presence.IdleTimeout = idleTimeout
+	database := &presenceDB{
+		streams:  map[uint64]int32{},
+		statuses: map[uint64]harmonytypesv1.UserStatus{},
+	}
+	return New(Dependencies{
+		DB:     database,
+		Config: cfg,
+		Guild:  broadcaster{},
+	}), database
+}
+
+// status is the status of a user, who's offline until they're stored
+func (d *presenceDB) status(userID uint64) harmonytypesv1.UserStatus {
+	d.Lock()
+	defer d.Unlock()
+
+	if status, ok := d.statuses[userID]; ok {
+		return status
+	}
+	return harmonytypesv1.UserStatus_USER_STATUS_OFFLINE
+}
+
+func TestConnectStoresStreamsAgainAfterFailing(t *testing.T) {
+	m, database := newTestManager(time.Minute)
+
+	database.failing = true
+	m.Connect(1)
+	if got := database.status(1); got != harmonytypesv1.UserStatus_USER_STATUS_OFFLINE {
+		t.Fatalf("expected the user to stay offline while their stream isn't stored, got %v", got)
+	}
+
+	database.failing = false
+	m.checkIn()
+	if got := database.streams[1]; got != 1 {
+		t.Errorf("expected the stream to be stored on check in, got %d streams", got)
+	}
+	if got := database.status(1); got != harmonytypesv1.UserStatus_USER_STATUS_ONLINE_UNSPECIFIED {
+		t.Errorf("expected the user to be online, got %v", got)
+	}
+}
+
+func TestUsersAreForgottenOnceOffline(t *testing.T) {
+	m, database := newTestManager(time.Millisecond)
+
+	var wg sync.WaitGroup
+	for userID := uint64(1); userID <= 8; userID++ {
+		wg.Add(1)
+		go func(userID uint64) {
+			defer wg.Done()
+			m.Connect(userID)
+			m.Connect(userID)
+			m.Disconnect(userID)
+			m.Disconnect(userID)
+		}(userID)
+	}
+	wg.Wait()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		m.Lock()
+		left := len(m.users)
+		m.Unlock()
+		if left == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected every user to be forgotten, %d are left", left)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	for userID := uint64(1); userID <= 8; userID++ {
+		if got := database.status(userID); got != harmonytypesv1.UserStatus_USER_STATUS_OFFLINE {
+			t.Errorf("expected user %d to be offline, got %v", userID, got)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 type ActionState struct {
 	actionChannels map[chatv1.ChatService_StreamEventsServer]chan struct{}
 	actionEvents   map[_userID][]chatv1.ChatService_StreamEventsServer
+	replay         userBuffers
 	sync.Mutex
 }
 
@@ -17,14 +18,24 @@ type ActionState struct {
 func (a *ActionState) Initialize() *ActionState {
 	a.actionChannels = make(map[chatv1.ChatService_StreamEventsServer]chan struct{})
 	a.actionEvents = make(map[_userID][]chatv1.ChatService_StreamEventsServer)
+	a.replay = newUserBuffers()
 	return a
 }
 
 // Subscribe subscribes
-func (a *ActionState) Subscribe(userID uint64, server chatv1.ChatService_StreamEventsServer) chan struct{} {
+func (a *ActionState) Subscribe(userID uint64, server chatv1.ChatService_StreamEventsServer, resumeAfter uint64) chan struct{} {
 	a.Lock()
 	defer a.Unlock()
 
+	if ch, ok := a.actionChannels[server]; ok {
+		return ch
+	}
+	a.replay.active(userID).replay(server, resumeAfter, &chatv1.Event_ResyncRequired{
+		Subscription: &chatv1.Event_ResyncRequired_Actions{
+			Actions: &chatv1.StreamEventsRequest_SubscribeToActions{},
+		},
+	}, nil)
+
 	go func() {
 		<-server.Context().Done()
 		a.Unsubscribe(userID, server)
@@ -37,11 +48,14 @@ func (a *ActionState) Subscribe(userID uint64, server chatv1.ChatService_StreamE
 	return a.actionChannels[server]
 }
 
-// Unsubscribe unsubscribes
-func (a *ActionState) Unsubscribe(userID uint64, server chatv1.ChatService_StreamEventsServer) {
+// Unsubscribe unsubscribes, it returns false if the stream wasn't subscribed
+func (a *ActionState) Unsubscribe(userID uint64, server chatv1.ChatService_StreamEventsServer) bool {
 	a.Lock()
 	defer a.Unlock()
 
+	if _, ok := a.actionChannels[server]; !ok {
+		return false
+	}
 	val := a.actionEvents[_userID(userID)]
 	for idx, serv := range val {
 		if serv == server {
@@ -51,20 +65,50 @@ func (a *ActionState) Unsubscribe(userID uint64, server chatv1.ChatService_Strea
 			break
 		}
 	}
-	a.actionEvents[_userID(userID)] = val
+	if len(val) == 0 {
+		delete(a.actionEvents, _userID(userID))
+		a.replay.idle(userID, a)
+	} else {
+		a.actionEvents[_userID(userID)] = val
+	}
 	close(a.actionChannels[server])
 	delete(a.actionChannels, server)
+	return true
 }
 
 // Broadcast broadcasts
 func (a *ActionState) Broadcast(userID uint64, action *chatv1.Event) {
+	a.Lock()
+	defer a.Unlock()
+
+	buffer := a.replay.get(userID)
+	if buffer == nil {
+		return
+	}
+	action = buffer.push(action)
 	val, ok := a.actionEvents[_userID(userID)]
 	if !ok {
 		return
 	}
 	for _, serv := range val {
-		if err := serv.Send(action); err != nil {
-			println(err)
+		send(serv, action)
+	}
+}
+
+// Resync resets the replay buffers and tells every subscriber to resync, for
+// when events may have been missed
+func (a *ActionState) Resync() {
+	a.Lock()
+	defer a.Unlock()
+
+	for userID, buffer := range a.replay.buffers {
+		buffer.reset()
+		for _, serv := range a.actionEvents[userID] {
+			send(serv, buffer.resync(&chatv1.Event_ResyncRequired{
+				Subscription: &chatv1.Event_ResyncRequired_Actions{
+					Actions: &chatv1.StreamEventsRequest_SubscribeToActions{},
+				},
+			}))
 		}
 	}
 }
@@ -0,0 +1,139 @@
+package integrated
+
+import (
+	"sort"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+)
+
+// guildFilter decides which events of a guild a subscription gets
+type guildFilter struct {
+	channels channelFilter
+	threads  threadSet
+}
+
+// allows tells whether an event should be sent through the filter, events
+// about thread messages are only sent for the threads in the filter
+func (f guildFilter) allows(e *chatv1.Event) bool {
+	if threadID, ok := threadOf(e); ok {
+		_, ok = f.threads[threadID]
+		return ok
+	}
+	return f.channels.allows(e)
+}
+
+// threadSet is the set of threads a guild subscription follows
+type threadSet map[uint64]struct{}
+
+func newThreadSet(threadIDs []uint64) threadSet {
+	f := make(threadSet, len(threadIDs))
+	for _, id := range threadIDs {
+		f[id] = struct{}{}
+	}
+	return f
+}
+
+// ids lists the threads in the set
+func (f threadSet) ids() []uint64 {
+	return setIDs(f)
+}
+
+// channelFilter is the set of channels a guild subscription wants
+// message events for, nil meaning all of them
+type channelFilter map[uint64]struct{}
+
+func newChannelFilter(channelIDs []uint64) channelFilter {
+	if len(channelIDs) == 0 {
+		return nil
+	}
+	f := make(channelFilter, len(channelIDs))
+	for _, id := range channelIDs {
+		f[id] = struct{}{}
+	}
+	return f
+}
+
+// ids lists the channels in the filter, none meaning all of them
+func (f channelFilter) ids() []uint64 {
+	return setIDs(f)
+}
+
+// setIDs lists the IDs in a set in ascending order
+func setIDs(set map[uint64]struct{}) []uint64 {
+	if len(set) == 0 {
+		return nil
+	}
+	ids := make([]uint64, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// allows tells whether an event should be sent through the filter
+func (f channelFilter) allows(e *chatv1.Event) bool {
+	if f == nil {
+		return true
+	}
+	channelID, ok := channelOf(e)
+	if !ok {
+		return true
+	}
+	_, ok = f[channelID]
+	return ok
+}
+
+// channelOf returns the channel of events that are about the contents
+// of a channel
+func channelOf(e *chatv1.Event) (uint64, bool) {
+	switch ev := e.Event.(type) {
+	case *chatv1.Event_SentMessage:
+		return ev.SentMessage.GetMessage().GetChannelId(), true
+	case *chatv1.Event_EditedMessage:
+		return ev.EditedMessage.ChannelId, true
+	case *chatv1.Event_DeletedMessage:
+		return ev.DeletedMessage.ChannelId, true
+	case *chatv1.Event_MessagesBulkDeleted_:
+		return ev.MessagesBulkDeleted.ChannelId, true
+	case *chatv1.Event_MessageRestored_:
+		return ev.MessageRestored.GetMessage().GetChannelId(), true
+	case *chatv1.Event_MessagePinned_:
+		return ev.MessagePinned.ChannelId, true
+	case *chatv1.Event_MessageUnpinned_:
+		return ev.MessageUnpinned.ChannelId, true
+	case *chatv1.Event_ReactionAdded_:
+		return ev.ReactionAdded.ChannelId, true
+	case *chatv1.Event_ReactionRemoved_:
+		return ev.ReactionRemoved.ChannelId, true
+	case *chatv1.Event_Typing_:
+		return ev.Typing.ChannelId, true
+	case *chatv1.Event_TypingStopped_:
+		return ev.TypingStopped.ChannelId, true
+	case *chatv1.Event_ThreadCreated_:
+		return ev.ThreadCreated.GetThread().GetChannelId(), true
+	case *chatv1.Event_ThreadArchived_:
+		return ev.ThreadArchived.ChannelId, true
+	case *chatv1.Event_ThreadUnarchived_:
+		return ev.ThreadUnarchived.ChannelId, true
+	}
+	return 0, false
+}
+
+// threadOf returns the thread of events about messages posted in a thread
+func threadOf(e *chatv1.Event) (uint64, bool) {
+	var threadID uint64
+	switch ev := e.Event.(type) {
+	case *chatv1.Event_SentMessage:
+		threadID = ev.SentMessage.GetMessage().GetThreadId()
+	case *chatv1.Event_EditedMessage:
+		threadID = ev.EditedMessage.ThreadId
+	case *chatv1.Event_DeletedMessage:
+		threadID = ev.DeletedMessage.ThreadId
+	case *chatv1.Event_MessagesBulkDeleted_:
+		threadID = ev.MessagesBulkDeleted.ThreadId
+	case *chatv1.Event_MessageRestored_:
+		threadID = ev.MessageRestored.GetMessage().GetThreadId()
+	}
+	return threadID, threadID != 0
+}
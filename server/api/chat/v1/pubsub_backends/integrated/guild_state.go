@@ -6,27 +6,78 @@ import (
 	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
 )
 
+// Visibility decides whether users can see the contents of a channel, the
+// checks it returns are computed before the guild state is locked
+type Visibility interface {
+	ChannelViewers(guildID, channelID uint64) func(userID uint64) bool
+	ViewableChannels(guildID, userID uint64) func(channelID uint64) bool
+}
+
 // GuildState is the state of a guild
 type GuildState struct {
+	// Visibility is checked for every subscriber before channel events
+	// are sent, every subscriber gets them if it's nil
+	Visibility Visibility
+
 	serverChannels map[chatv1.ChatService_StreamEventsServer]chan struct{}
 	guildEvents    map[_userID]map[_guildID][]chatv1.ChatService_StreamEventsServer
 	subs           map[_guildID]map[_userID]struct{}
+	replay         map[_guildID]*replayBuffer
+	filters        map[guildSubscription]guildFilter
 	sync.Mutex
 }
 
+type guildSubscription struct {
+	server chatv1.ChatService_StreamEventsServer
+	guild  _guildID
+}
+
 // Initialize the guild state
 func (s *GuildState) Initialize() *GuildState {
 	s.serverChannels = make(map[chatv1.ChatService_StreamEventsServer]chan struct{})
 	s.guildEvents = make(map[_userID]map[_guildID][]chatv1.ChatService_StreamEventsServer)
 	s.subs = make(map[_guildID]map[_userID]struct{})
+	s.replay = make(map[_guildID]*replayBuffer)
+	s.filters = make(map[guildSubscription]guildFilter)
 	return s
 }
 
 // Subscribe ...
-func (s *GuildState) Subscribe(guildID, userID uint64, server chatv1.ChatService_StreamEventsServer) chan struct{} {
+func (s *GuildState) Subscribe(guildID, userID uint64, server chatv1.ChatService_StreamEventsServer, resumeAfter uint64, channelIDs, threadIDs []uint64) chan struct{} {
+	canView := s.viewableChannels(guildID, userID)
+
 	s.Lock()
 	defer s.Unlock()
 
+	filter := guildFilter{newChannelFilter(channelIDs), newThreadSet(threadIDs)}
+	_, subscribed := s.filters[guildSubscription{server, _guildID(guildID)}]
+	s.filters[guildSubscription{server, _guildID(guildID)}] = filter
+
+	s.buffer(guildID).replay(server, resumeAfter, &chatv1.Event_ResyncRequired{
+		Subscription: &chatv1.Event_ResyncRequired_Guild{
+			Guild: &chatv1.StreamEventsRequest_SubscribeToGuild{
+				GuildId:    guildID,
+				ChannelIds: channelIDs,
+				ThreadIds:  threadIDs,
+			},
+		},
+	}, func(e *chatv1.Event) bool {
+		if !filter.allows(e) {
+			return false
+		}
+		channelID, ok := channelOf(e)
+		return !ok || canView(channelID)
+	})
+
+	// subscribing again only replaces the filter, so that a single
+	// unsubscribe undoes it
+	if subscribed {
+		if _, ok := s.serverChannels[server]; !ok {
+			s.serverChannels[server] = make(chan struct{})
+		}
+		return s.serverChannels[server]
+	}
+
 	s.subAdd(guildID, userID)
 
 	if _, ok := s.guildEvents[_userID(userID)]; !ok {
@@ -35,7 +86,7 @@ func (s *GuildState) Subscribe(guildID, userID uint64, server chatv1.ChatService
 
 	go func() {
 		<-server.Context().Done()
-		s.UnsubscribeUserFromGuild(userID, guildID)
+		s.Unsubscribe(guildID, userID, server)
 	}()
 	val, ok := s.guildEvents[_userID(userID)][_guildID(guildID)]
 	_ = ok
@@ -50,14 +101,17 @@ func (s *GuildState) UnsubscribeUser(userID uint64) {
 	s.Lock()
 	defer s.Unlock()
 
-	for _, guild := range s.guildEvents[_userID(userID)] {
+	for guildID, guild := range s.guildEvents[_userID(userID)] {
 		for _, serv := range guild {
-			close(s.serverChannels[serv])
-			delete(s.serverChannels, serv)
+			delete(s.filters, guildSubscription{serv, guildID})
+			if _, ok := s.serverChannels[serv]; ok {
+				close(s.serverChannels[serv])
+				delete(s.serverChannels, serv)
+			}
 		}
 	}
-	delete(s.guildEvents, _userID(userID))
 	s.subRemoveUser(userID)
+	delete(s.guildEvents, _userID(userID))
 }
 
 // UnsubscribeGuild ...
@@ -65,11 +119,13 @@ func (s *GuildState) UnsubscribeGuild(guildID uint64) {
 	s.Lock()
 	defer s.Unlock()
 	defer delete(s.subs, _guildID(guildID))
+	defer delete(s.replay, _guildID(guildID))
 
 	if val, ok := s.subs[_guildID(guildID)]; ok {
 		for user := range val {
 			for _, guild := range s.guildEvents[user] {
 				for _, serv := range guild {
+					delete(s.filters, guildSubscription{serv, _guildID(guildID)})
 					if _, ok := s.serverChannels[serv]; ok {
 						close(s.serverChannels[serv])
 						delete(s.serverChannels, serv)
@@ -89,6 +145,7 @@ func (s *GuildState) UnsubscribeUserFromGuild(userID, guildID uint64) {
 	s.subRemoveUserFromGuild(userID, guildID)
 	if _, ok := s.guildEvents[_userID(userID)]; ok {
 		for _, serv := range s.guildEvents[_userID(userID)][_guildID(guildID)] {
+			delete(s.filters, guildSubscription{serv, _guildID(guildID)})
 			if _, ok := s.serverChannels[serv]; ok {
 				close(s.serverChannels[serv])
 				delete(s.serverChannels, serv)
@@ -98,18 +155,157 @@ func (s *GuildState) UnsubscribeUserFromGuild(userID, guildID uint64) {
 	}
 }
 
+// Unsubscribe removes a single stream of a user from a guild, it returns
+// false if the stream wasn't subscribed
+func (s *GuildState) Unsubscribe(guildID, userID uint64, server chatv1.ChatService_StreamEventsServer) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	sub := guildSubscription{server, _guildID(guildID)}
+	if _, ok := s.filters[sub]; !ok {
+		return false
+	}
+	delete(s.filters, sub)
+	val := s.guildEvents[_userID(userID)][_guildID(guildID)]
+	for idx, serv := range val {
+		if serv == server {
+			val[idx] = val[len(val)-1]
+			val[len(val)-1] = nil
+			val = val[:len(val)-1]
+			break
+		}
+	}
+	if len(val) == 0 {
+		delete(s.guildEvents[_userID(userID)], _guildID(guildID))
+		s.subRemoveUserFromGuild(userID, guildID)
+	} else {
+		s.guildEvents[_userID(userID)][_guildID(guildID)] = val
+	}
+	if _, ok := s.serverChannels[server]; ok {
+		close(s.serverChannels[server])
+		delete(s.serverChannels, server)
+	}
+	return true
+}
+
 // Broadcast ...
 func (s *GuildState) Broadcast(guildID uint64, event *chatv1.Event) {
+	canView := s.channelViewers(guildID, event)
+
 	s.Lock()
 	defer s.Unlock()
 
-	go func() {
-		for sub := range s.subs[_guildID(guildID)] {
-			for _, server := range s.guildEvents[sub][_guildID(guildID)] {
-				if err := server.Send(event); err != nil {
-					println(err)
-				}
+	event = s.buffer(guildID).push(event)
+	for sub := range s.subs[_guildID(guildID)] {
+		if !canView(sub) {
+			continue
+		}
+		for _, server := range s.guildEvents[sub][_guildID(guildID)] {
+			if s.filters[guildSubscription{server, _guildID(guildID)}].allows(event) {
+				send(server, event)
 			}
 		}
-	}()
+	}
+}
+
+// Resync resets the replay buffers and tells every subscriber to resync, for
+// when events may have been missed
+func (s *GuildState) Resync() {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, buffer := range s.replay {
+		buffer.reset()
+	}
+	for sub, filter := range s.filters {
+		send(sub.server, s.buffer(uint64(sub.guild)).resync(&chatv1.Event_ResyncRequired{
+			Subscription: &chatv1.Event_ResyncRequired_Guild{
+				Guild: &chatv1.StreamEventsRequest_SubscribeToGuild{
+					GuildId:    uint64(sub.guild),
+					ChannelIds: filter.channels.ids(),
+					ThreadIds:  filter.threads.ids(),
+				},
+			},
+		}))
+	}
+}
+
+// UpdateChannels changes the channels a guild subscription gets message
+// events for, it returns false if the stream isn't subscribed to the guild
+func (s *GuildState) UpdateChannels(guildID uint64, server chatv1.ChatService_StreamEventsServer, channelIDs []uint64) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	sub := guildSubscription{server, _guildID(guildID)}
+	if _, ok := s.filters[sub]; !ok {
+		return false
+	}
+	filter := s.filters[sub]
+	filter.channels = newChannelFilter(channelIDs)
+	s.filters[sub] = filter
+	return true
+}
+
+// UpdateThreads changes the threads a guild subscription gets message
+// events for, it returns false if the stream isn't subscribed to the guild
+func (s *GuildState) UpdateThreads(guildID uint64, server chatv1.ChatService_StreamEventsServer, threadIDs []uint64) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	sub := guildSubscription{server, _guildID(guildID)}
+	filter, ok := s.filters[sub]
+	if !ok {
+		return false
+	}
+	filter.threads = newThreadSet(threadIDs)
+	s.filters[sub] = filter
+	return true
+}
+
+// channelViewers works out which subscribers of a guild can see an event
+// before the lock is taken, since it may have to query the database
+func (s *GuildState) channelViewers(guildID uint64, e *chatv1.Event) func(_userID) bool {
+	if s.Visibility == nil {
+		return func(_userID) bool { return true }
+	}
+	channelID, ok := channelOf(e)
+	if !ok {
+		return func(_userID) bool { return true }
+	}
+	canView := s.Visibility.ChannelViewers(guildID, channelID)
+
+	s.Lock()
+	users := make([]_userID, 0, len(s.subs[_guildID(guildID)]))
+	for user := range s.subs[_guildID(guildID)] {
+		users = append(users, user)
+	}
+	s.Unlock()
+
+	visible := make(map[_userID]bool, len(users))
+	for _, user := range users {
+		visible[user] = canView(uint64(user))
+	}
+	return func(user _userID) bool {
+		if ok, checked := visible[user]; checked {
+			return ok
+		}
+		// the user subscribed in the meantime
+		return canView(uint64(user))
+	}
+}
+
+// viewableChannels looks up which channels a user can see before the lock
+// is taken, for filtering the events replayed to them
+func (s *GuildState) viewableChannels(guildID, userID uint64) func(channelID uint64) bool {
+	if s.Visibility == nil {
+		return func(uint64) bool { return true }
+	}
+	return s.Visibility.ViewableChannels(guildID, userID)
+}
+
+func (s *GuildState) buffer(guildID uint64) *replayBuffer {
+	if _, ok := s.replay[_guildID(guildID)]; !ok {
+		s.replay[_guildID(guildID)] = newReplayBuffer()
+	}
+	return s.replay[_guildID(guildID)]
 }
@@ -10,6 +10,7 @@ import (
 type HomeserverEventState struct {
 	homeserverChannels map[chatv1.ChatService_StreamEventsServer]chan struct{}
 	homeserverEvents   map[_userID][]chatv1.ChatService_StreamEventsServer
+	replay             userBuffers
 	sync.Mutex
 }
 
@@ -17,14 +18,24 @@ type HomeserverEventState struct {
 func (h *HomeserverEventState) Initialize() *HomeserverEventState {
 	h.homeserverChannels = make(map[chatv1.ChatService_StreamEventsServer]chan struct{})
 	h.homeserverEvents = make(map[_userID][]chatv1.ChatService_StreamEventsServer)
+	h.replay = newUserBuffers()
 	return h
 }
 
 // Subscribe ...
-func (h *HomeserverEventState) Subscribe(userID uint64, s chatv1.ChatService_StreamEventsServer) chan struct{} {
+func (h *HomeserverEventState) Subscribe(userID uint64, s chatv1.ChatService_StreamEventsServer, resumeAfter uint64) chan struct{} {
 	h.Lock()
 	defer h.Unlock()
 
+	if ch, ok := h.homeserverChannels[s]; ok {
+		return ch
+	}
+	h.replay.active(userID).replay(s, resumeAfter, &chatv1.Event_ResyncRequired{
+		Subscription: &chatv1.Event_ResyncRequired_HomeserverEvents{
+			HomeserverEvents: &chatv1.StreamEventsRequest_SubscribeToHomeserverEvents{},
+		},
+	}, nil)
+
 	go func() {
 		<-s.Context().Done()
 		h.Unsubscribe(userID, s)
@@ -39,11 +50,15 @@ func (h *HomeserverEventState) Subscribe(userID uint64, s chatv1.ChatService_Str
 	return h.homeserverChannels[s]
 }
 
-// Unsubscribe ...
-func (h *HomeserverEventState) Unsubscribe(userID uint64, s chatv1.ChatService_StreamEventsServer) {
+// Unsubscribe ..., it returns false if the stream wasn't subscribed
+func (h *HomeserverEventState) Unsubscribe(userID uint64, s chatv1.ChatService_StreamEventsServer) bool {
 	h.Lock()
 	defer h.Unlock()
 
+	if _, ok := h.homeserverChannels[s]; !ok {
+		return false
+	}
+
 	val, ok := h.homeserverEvents[_userID(userID)]
 	_ = ok
 	for idx, serv := range val {
@@ -56,7 +71,13 @@ func (h *HomeserverEventState) Unsubscribe(userID uint64, s chatv1.ChatService_S
 	}
 	close(h.homeserverChannels[s])
 	delete(h.homeserverChannels, s)
-	h.homeserverEvents[_userID(userID)] = val
+	if len(val) == 0 {
+		delete(h.homeserverEvents, _userID(userID))
+		h.replay.idle(userID, h)
+	} else {
+		h.homeserverEvents[_userID(userID)] = val
+	}
+	return true
 }
 
 // Broadcast ...
@@ -64,11 +85,32 @@ func (h *HomeserverEventState) Broadcast(userID uint64, e *chatv1.Event) {
 	h.Lock()
 	defer h.Unlock()
 
+	buffer := h.replay.get(userID)
+	if buffer == nil {
+		return
+	}
+	e = buffer.push(e)
 	val, ok := h.homeserverEvents[_userID(userID)]
 	_ = ok
 	for _, serv := range val {
-		if err := serv.Send(e); err != nil {
-			println(err)
+		send(serv, e)
+	}
+}
+
+// Resync resets the replay buffers and tells every subscriber to resync, for
+// when events may have been missed
+func (h *HomeserverEventState) Resync() {
+	h.Lock()
+	defer h.Unlock()
+
+	for userID, buffer := range h.replay.buffers {
+		buffer.reset()
+		for _, s := range h.homeserverEvents[userID] {
+			send(s, buffer.resync(&chatv1.Event_ResyncRequired{
+				Subscription: &chatv1.Event_ResyncRequired_HomeserverEvents{
+					HomeserverEvents: &chatv1.StreamEventsRequest_SubscribeToHomeserverEvents{},
+				},
+			}))
 		}
 	}
 }
@@ -0,0 +1,124 @@
+package integrated
+
+import (
+	"sync"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// queueSize is how many events a stream can fall behind before it gets evicted
+const queueSize = 256
+
+var (
+	droppedEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "legato_pubsub_dropped_events_total",
+		Help: "Events that were never delivered because their stream fell behind",
+	})
+	evictedStreams = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "legato_pubsub_evicted_streams_total",
+		Help: "Streams that were disconnected because they fell behind",
+	})
+)
+
+// closer is implemented by streams that can be ended from the server side
+type closer interface {
+	Close()
+}
+
+// outbox is the outbound queue of a stream. A single writer goroutine
+// drains it, so events reach a stream in the order they were queued and
+// a slow stream doesn't hold up anyone else.
+type outbox struct {
+	events chan *chatv1.Event
+	closed chan struct{}
+	once   sync.Once
+}
+
+// outboxes are shared by every state, a stream subscribed to both guild
+// and action events still gets a single ordered queue
+var outboxes = struct {
+	m map[chatv1.ChatService_StreamEventsServer]*outbox
+	sync.Mutex
+}{
+	m: make(map[chatv1.ChatService_StreamEventsServer]*outbox),
+}
+
+// send queues an event for a stream
+func send(s chatv1.ChatService_StreamEventsServer, e *chatv1.Event) {
+	outboxFor(s).push(s, e)
+}
+
+func outboxFor(s chatv1.ChatService_StreamEventsServer) *outbox {
+	outboxes.Lock()
+	defer outboxes.Unlock()
+
+	if o, ok := outboxes.m[s]; ok {
+		return o
+	}
+	o := &outbox{
+		events: make(chan *chatv1.Event, queueSize),
+		closed: make(chan struct{}),
+	}
+	outboxes.m[s] = o
+	go o.write(s)
+	return o
+}
+
+func (o *outbox) push(s chatv1.ChatService_StreamEventsServer, e *chatv1.Event) {
+	select {
+	case <-o.closed:
+		droppedEvents.Inc()
+		return
+	default:
+	}
+	select {
+	case o.events <- e:
+	default:
+		droppedEvents.Inc()
+		o.evict(s)
+	}
+}
+
+func (o *outbox) evict(s chatv1.ChatService_StreamEventsServer) {
+	o.once.Do(func() {
+		close(o.closed)
+		evictedStreams.Inc()
+		droppedEvents.Add(float64(len(o.events)))
+		if c, ok := s.(closer); ok {
+			c.Close()
+		}
+	})
+}
+
+// stop stops delivery to a stream that can't be written to anymore
+func (o *outbox) stop() {
+	o.once.Do(func() {
+		close(o.closed)
+	})
+}
+
+func (o *outbox) write(s chatv1.ChatService_StreamEventsServer) {
+	defer func() {
+		// keep the closed outbox around until the stream is gone, so
+		// that late broadcasts don't bring it back
+		<-s.Context().Done()
+		outboxes.Lock()
+		delete(outboxes.m, s)
+		outboxes.Unlock()
+	}()
+	for {
+		select {
+		case e := <-o.events:
+			if err := s.Send(e); err != nil {
+				o.stop()
+				return
+			}
+		case <-o.closed:
+			return
+		case <-s.Context().Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package integrated
+
+import (
+	"sync"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// replayWindow is how many events of a subscription are kept for resuming clients
+const replayWindow = 512
+
+// resumeWindow is how long the events of a user are kept after their last
+// stream closes, resuming later than that needs a resync
+const resumeWindow = 5 * time.Minute
+
+// replayBuffer numbers the events of a subscription scope and keeps the
+// latest ones around so that reconnecting clients can catch up
+type replayBuffer struct {
+	sequence uint64
+	events   [replayWindow]*chatv1.Event
+}
+
+func newReplayBuffer() *replayBuffer {
+	// sequences start at the current time so that they keep increasing
+	// across restarts and stale resume requests are detected. Buffers are
+	// kept per instance, so with the Postgres backend a stream can only be
+	// resumed on the instance it was on. Sequences from another instance
+	// started at a different time, which puts them outside of the window and
+	// gets the client a resync rather than the wrong events.
+	return &replayBuffer{
+		sequence: uint64(time.Now().UnixNano()),
+	}
+}
+
+// push assigns the next sequence to an event and remembers it
+func (r *replayBuffer) push(e *chatv1.Event) *chatv1.Event {
+	e = proto.Clone(e).(*chatv1.Event)
+	r.sequence++
+	e.Sequence = r.sequence
+	r.events[r.sequence%replayWindow] = e
+	return e
+}
+
+// reset forgets the events of a buffer once some of them went missing. The
+// sequence skips past the window, so streams resuming from before the gap
+// get a resync instead of a replay with a hole in it.
+func (r *replayBuffer) reset() {
+	r.sequence += replayWindow + 1
+	r.events = [replayWindow]*chatv1.Event{}
+}
+
+// since returns the events after a sequence, or false if some of them
+// are no longer available
+func (r *replayBuffer) since(after uint64) ([]*chatv1.Event, bool) {
+	if after > r.sequence || r.sequence-after > replayWindow {
+		return nil, false
+	}
+	events := make([]*chatv1.Event, 0, r.sequence-after)
+	for seq := after + 1; seq <= r.sequence; seq++ {
+		events = append(events, r.events[seq%replayWindow])
+	}
+	return events, true
+}
+
+// replay sends a resuming stream the events it missed
+func (r *replayBuffer) replay(s chatv1.ChatService_StreamEventsServer, after uint64, resync *chatv1.Event_ResyncRequired, allows func(*chatv1.Event) bool) {
+	if after == 0 {
+		return
+	}
+	events, ok := r.since(after)
+	if !ok {
+		send(s, r.resync(resync))
+		return
+	}
+	for _, e := range events {
+		if allows == nil || allows(e) {
+			send(s, e)
+		}
+	}
+}
+
+// resync is the event telling a stream to resync a subscription, from which
+// it can resume later on
+func (r *replayBuffer) resync(subscription *chatv1.Event_ResyncRequired) *chatv1.Event {
+	return &chatv1.Event{
+		Event: &chatv1.Event_ResyncRequired_{
+			ResyncRequired: subscription,
+		},
+		Sequence: r.sequence,
+	}
+}
+
+// userBuffers keeps the replay buffers of users who have streams, and of
+// those whose last stream closed within the resume window. Events for
+// anyone else aren't kept, since nobody could resume from them.
+type userBuffers struct {
+	buffers map[_userID]*userBuffer
+}
+
+type userBuffer struct {
+	*replayBuffer
+	expiry *time.Timer
+}
+
+func newUserBuffers() userBuffers {
+	return userBuffers{
+		buffers: make(map[_userID]*userBuffer),
+	}
+}
+
+// active gets the buffer of a user who's subscribing, creating it if needed
+func (u userBuffers) active(userID uint64) *replayBuffer {
+	b, ok := u.buffers[_userID(userID)]
+	if !ok {
+		b = &userBuffer{replayBuffer: newReplayBuffer()}
+		u.buffers[_userID(userID)] = b
+	}
+	if b.expiry != nil {
+		b.expiry.Stop()
+		b.expiry = nil
+	}
+	return b.replayBuffer
+}
+
+// get gets the buffer of a user, or nil if nobody can resume their events
+func (u userBuffers) get(userID uint64) *replayBuffer {
+	if b, ok := u.buffers[_userID(userID)]; ok {
+		return b.replayBuffer
+	}
+	return nil
+}
+
+// idle drops the buffer of a user after the resume window, unless they
+// subscribe again in the meantime. lock is the lock of the state owning the
+// buffers, which the caller holds.
+func (u userBuffers) idle(userID uint64, lock sync.Locker) {
+	b, ok := u.buffers[_userID(userID)]
+	if !ok || b.expiry != nil {
+		return
+	}
+	var expiry *time.Timer
+	expiry = time.AfterFunc(resumeWindow, func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if b.expiry == expiry {
+			delete(u.buffers, _userID(userID))
+		}
+	})
+	b.expiry = expiry
+}
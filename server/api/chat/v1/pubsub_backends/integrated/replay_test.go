@@ -0,0 +1,59 @@
+package integrated
+
+import (
+	"testing"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+)
+
+func TestReplay(t *testing.T) {
+	r := newReplayBuffer()
+	start := r.sequence
+
+	for i := 0; i < replayWindow+10; i++ {
+		e := r.push(&chatv1.Event{})
+		if e.Sequence != start+uint64(i)+1 {
+			t.Fatalf("expected sequence %d, got %d", start+uint64(i)+1, e.Sequence)
+		}
+	}
+
+	events, ok := r.since(r.sequence - 5)
+	if !ok || len(events) != 5 {
+		t.Fatalf("expected 5 events, got %d", len(events))
+	}
+	for i, e := range events {
+		if e.Sequence != r.sequence-4+uint64(i) {
+			t.Fatalf("events out of order")
+		}
+	}
+
+	if _, ok := r.since(start); ok {
+		t.Fatal("expected a resync past the replay window")
+	}
+	if _, ok := r.since(r.sequence + 1); ok {
+		t.Fatal("expected a resync for an unknown sequence")
+	}
+	if events, ok := r.since(r.sequence); !ok || len(events) != 0 {
+		t.Fatal("expected nothing to replay")
+	}
+}
+
+func TestReplayReset(t *testing.T) {
+	r := newReplayBuffer()
+	for i := 0; i < 10; i++ {
+		r.push(&chatv1.Event{})
+	}
+	before := r.sequence
+
+	r.reset()
+	if r.sequence <= before {
+		t.Fatalf("expected the sequence to keep increasing, got %d after %d", r.sequence, before)
+	}
+	if _, ok := r.since(before); ok {
+		t.Fatal("expected a resync when resuming from before the reset")
+	}
+	e := r.push(&chatv1.Event{})
+	if events, ok := r.since(r.sequence - 1); !ok || len(events) != 1 || events[0] != e {
+		t.Fatal("expected events after the reset to be replayed")
+	}
+}
@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/api/chat/v1/pubsub_backends/integrated"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/logger"
+	"github.com/lib/pq"
+	"github.com/ztrue/tracerr"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	channelName = "legato_events"
+	// Postgres refuses NOTIFY payloads of 8000 bytes or more, so anything
+	// larger is put in the event queue and sent as a reference instead
+	maxPayloadSize = 7900
+)
+
+// ErrMalformedPayload : a notification doesn't look like one we sent
+var ErrMalformedPayload = errors.New("Malformed event payload")
+
+type scope byte
+
+const (
+	actionScope     scope = 'a'
+	guildScope      scope = 'g'
+	homeserverScope scope = 'h'
+)
+
+// Dependencies are the backend services this package needs
+type Dependencies struct {
+	DB         db.IHarmonyDB
+	Logger     logger.ILogger
+	Config     *config.Config
+	Visibility integrated.Visibility
+}
+
+// Listener relays events between legato instances through Postgres LISTEN/NOTIFY
+// and fans them out to the streams connected to this instance
+type Listener struct {
+	Dependencies
+	listener   *pq.Listener
+	actions    *integrated.ActionState
+	guild      *integrated.GuildState
+	homeserver *integrated.HomeserverEventState
+}
+
+// New connects a listener to the database
+func New(deps Dependencies) (*Listener, error) {
+	l := &Listener{
+		Dependencies: deps,
+		actions:      (&integrated.ActionState{}).Initialize(),
+		guild:        (&integrated.GuildState{Visibility: deps.Visibility}).Initialize(),
+		homeserver:   (&integrated.HomeserverEventState{}).Initialize(),
+	}
+	l.listener = pq.NewListener(db.ConnectionString(deps.Config), 10*time.Second, time.Minute, l.reportProblem)
+	if err := l.listener.Listen(channelName); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	go l.listen()
+	go l.ExpireRoutine()
+	return l, nil
+}
+
+// Actions returns the action pub/sub of the listener
+func (l *Listener) Actions() *ActionState {
+	return &ActionState{ActionState: l.actions, listener: l}
+}
+
+// Guild returns the guild pub/sub of the listener
+func (l *Listener) Guild() *GuildState {
+	return &GuildState{GuildState: l.guild, listener: l}
+}
+
+// Homeserver returns the homeserver event pub/sub of the listener
+func (l *Listener) Homeserver() *HomeserverEventState {
+	return &HomeserverEventState{HomeserverEventState: l.homeserver, listener: l}
+}
+
+// ExpireRoutine periodically removes old events from the event queue
+func (l *Listener) ExpireRoutine() {
+	for {
+		time.Sleep(5 * time.Minute)
+		if err := l.DB.ExpireQueuedEvents(); err != nil {
+			l.Logger.Exception(err)
+		}
+	}
+}
+
+func (l *Listener) reportProblem(ev pq.ListenerEventType, err error) {
+	if err != nil {
+		l.Logger.Exception(tracerr.Wrap(err))
+	}
+}
+
+func (l *Listener) publish(s scope, target uint64, event *chatv1.Event) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		l.Logger.Exception(tracerr.Wrap(err))
+		return
+	}
+	encoded := base64.StdEncoding.EncodeToString(data)
+	if len(encoded) > maxPayloadSize {
+		eventID, err := l.DB.AddQueuedEvent(data)
+		if err != nil {
+			l.Logger.Exception(err)
+			return
+		}
+		encoded = "#" + strconv.FormatUint(eventID, 10)
+	}
+	if err := l.DB.Notify(channelName, fmt.Sprintf("%c%d:%s", s, target, encoded)); err != nil {
+		l.Logger.Exception(err)
+	}
+}
+
+func (l *Listener) listen() {
+	for notification := range l.listener.Notify {
+		// a nil notification means the connection was re-established.
+		// Events sent while it was down are lost, so every stream on this
+		// instance is told to resync and nobody resumes across the gap.
+		if notification == nil {
+			l.actions.Resync()
+			l.guild.Resync()
+			l.homeserver.Resync()
+			continue
+		}
+		if err := l.dispatch(notification.Extra); err != nil {
+			l.Logger.Exception(err)
+		}
+	}
+}
+
+func (l *Listener) dispatch(payload string) error {
+	sep := strings.IndexByte(payload, ':')
+	if sep < 2 {
+		return tracerr.Wrap(ErrMalformedPayload)
+	}
+	target, err := strconv.ParseUint(payload[1:sep], 10, 64)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	data, err := l.decode(payload[sep+1:])
+	if err != nil {
+		return err
+	}
+	event := new(chatv1.Event)
+	if err := proto.Unmarshal(data, event); err != nil {
+		return tracerr.Wrap(err)
+	}
+	switch scope(payload[0]) {
+	case actionScope:
+		l.actions.Broadcast(target, event)
+	case guildScope:
+		l.guild.Broadcast(target, event)
+	case homeserverScope:
+		l.homeserver.Broadcast(target, event)
+	default:
+		return tracerr.Wrap(ErrMalformedPayload)
+	}
+	return nil
+}
+
+func (l *Listener) decode(data string) ([]byte, error) {
+	if strings.HasPrefix(data, "#") {
+		eventID, err := strconv.ParseUint(data[1:], 10, 64)
+		if err != nil {
+			return nil, tracerr.Wrap(err)
+		}
+		return l.DB.GetQueuedEvent(eventID)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	return decoded, tracerr.Wrap(err)
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/api/chat/v1/pubsub_backends/integrated"
+)
+
+// ActionState is the manager of action pub/sub. Subscriptions are kept
+// in memory, broadcasts go through the database.
+type ActionState struct {
+	*integrated.ActionState
+	listener *Listener
+}
+
+// Broadcast broadcasts
+func (a *ActionState) Broadcast(userID uint64, action *chatv1.Event) {
+	a.listener.publish(actionScope, userID, action)
+}
+
+// GuildState is the state of a guild. Subscriptions are kept in memory,
+// broadcasts go through the database.
+type GuildState struct {
+	*integrated.GuildState
+	listener *Listener
+}
+
+// Broadcast ...
+func (s *GuildState) Broadcast(guildID uint64, event *chatv1.Event) {
+	s.listener.publish(guildScope, guildID, event)
+}
+
+// HomeserverEventState ... Subscriptions are kept in memory, broadcasts go
+// through the database.
+type HomeserverEventState struct {
+	*integrated.HomeserverEventState
+	listener *Listener
+}
+
+// Broadcast ...
+func (h *HomeserverEventState) Broadcast(userID uint64, e *chatv1.Event) {
+	h.listener.publish(homeserverScope, userID, e)
+}
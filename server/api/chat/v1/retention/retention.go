@@ -0,0 +1,139 @@
+package retention
+
+import (
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/harmony-development/legato/server/http/attachments/backend"
+	"github.com/harmony-development/legato/server/logger"
+)
+
+// Broadcaster delivers events to the subscribers of a guild
+type Broadcaster interface {
+	Broadcast(to uint64, event *chatv1.Event)
+}
+
+// Dependencies are the backend services this package needs
+type Dependencies struct {
+	DB             db.IHarmonyDB
+	Logger         logger.ILogger
+	Config         *config.Config
+	Guild          Broadcaster
+	StorageBackend backend.AttachmentBackend
+}
+
+// Pruner deletes messages past the retention of their channel, and deleted
+// messages past their grace period
+type Pruner struct {
+	Dependencies
+}
+
+// New creates a new retention pruner
+func New(deps Dependencies) *Pruner {
+	return &Pruner{
+		Dependencies: deps,
+	}
+}
+
+// Run prunes expired messages and purges deleted ones every check interval,
+// a batch at a time so large backlogs don't hold locks for long. Every
+// instance can run it, a message is only deleted and announced once.
+func (p *Pruner) Run() {
+	policy := p.Config.Server.Policies.Retention
+	for {
+		time.Sleep(policy.CheckInterval)
+		p.prune(policy.BatchSize)
+		p.purge(policy.BatchSize)
+	}
+}
+
+func (p *Pruner) prune(batchSize int32) {
+	for {
+		pruned, err := p.DB.PruneExpiredMessages(batchSize)
+		if err != nil {
+			return
+		}
+		p.announce(pruned)
+		var files []string
+		for _, message := range pruned {
+			files = append(files, message.Attachments...)
+		}
+		p.deleteAttachments(files)
+		if len(pruned) < int(batchSize) {
+			return
+		}
+	}
+}
+
+// purge removes deleted messages for good, their deletion was already
+// announced so nothing is sent
+func (p *Pruner) purge(batchSize int32) {
+	for {
+		purged, err := p.DB.PurgeDeletedMessages(batchSize)
+		if err != nil {
+			return
+		}
+		var files []string
+		for _, message := range purged {
+			files = append(files, message.Attachments...)
+		}
+		p.deleteAttachments(files)
+		if len(purged) < int(batchSize) {
+			return
+		}
+	}
+}
+
+type location struct {
+	guildID   uint64
+	channelID uint64
+	threadID  uint64
+}
+
+// announce sends one bulk deletion event per channel and thread a batch
+// touched, so that thread subscriptions get the deletions of their threads
+func (p *Pruner) announce(pruned []queries.PruneExpiredMessagesRow) {
+	byLocation := map[location][]uint64{}
+	var order []location
+	for _, message := range pruned {
+		loc := location{message.GuildID, message.ChannelID, uint64(message.ThreadID.Int64)}
+		if _, ok := byLocation[loc]; !ok {
+			order = append(order, loc)
+		}
+		byLocation[loc] = append(byLocation[loc], message.MessageID)
+	}
+	for _, loc := range order {
+		p.Guild.Broadcast(loc.guildID, &chatv1.Event{
+			Event: &chatv1.Event_MessagesBulkDeleted_{
+				MessagesBulkDeleted: &chatv1.Event_MessagesBulkDeleted{
+					GuildId:    loc.guildID,
+					ChannelId:  loc.channelID,
+					MessageIds: byLocation[loc],
+					ThreadId:   loc.threadID,
+				},
+			},
+		})
+	}
+}
+
+// deleteAttachments removes the attachments of removed messages from
+// storage unless something else still references them
+func (p *Pruner) deleteAttachments(files []string) {
+	seen := map[string]bool{}
+	for _, fileID := range files {
+		if seen[fileID] {
+			continue
+		}
+		seen[fileID] = true
+		inUse, err := p.DB.FileInUse(fileID)
+		if err != nil || inUse {
+			continue
+		}
+		if err := p.StorageBackend.DeleteFile(fileID); err != nil {
+			p.Logger.CheckException(err)
+		}
+	}
+}
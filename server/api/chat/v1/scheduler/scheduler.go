@@ -0,0 +1,189 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/api/chat/v1/permissions"
+	"github.com/harmony-development/legato/server/api/middleware"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/harmony-development/legato/server/logger"
+	"github.com/sony/sonyflake"
+	"github.com/ztrue/tracerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Sender sends a message the way the SendMessage RPC does, under an ID
+// picked by the scheduler
+type Sender interface {
+	SendMessageWithID(c context.Context, r *chatv1.SendMessageRequest, messageID uint64) (*chatv1.SendMessageResponse, error)
+}
+
+// Dependencies are the backend services this package needs
+type Dependencies struct {
+	DB        db.IHarmonyDB
+	Logger    logger.ILogger
+	Config    *config.Config
+	Sonyflake *sonyflake.Sonyflake
+	Perms     *permissions.Manager
+	Sender    Sender
+}
+
+// Scheduler sends scheduled messages once they are due
+type Scheduler struct {
+	Dependencies
+}
+
+// New creates a new message scheduler
+func New(deps Dependencies) *Scheduler {
+	return &Scheduler{
+		Dependencies: deps,
+	}
+}
+
+// ErrNotPermitted is returned when the author of a scheduled message can no
+// longer send messages in its channel
+var ErrNotPermitted = errors.New("author can no longer send messages in the channel")
+
+var errTooManyAttempts = errors.New("scheduled message failed too many times")
+
+// Run sends the due messages every check interval. Pending messages are
+// kept in the database so they survive restarts, and every instance can
+// run it since a claimed message is leased to one instance. Messages are
+// only removed once they're sent or given up on.
+func (s *Scheduler) Run() {
+	policy := s.Config.Server.Policies.ScheduledMessages
+	for {
+		time.Sleep(policy.CheckInterval)
+		for {
+			now := time.Now().UTC()
+			due, err := s.DB.ClaimDueScheduledMessages(now, now.Add(policy.LeaseDuration), policy.BatchSize)
+			if err != nil {
+				break
+			}
+			for _, scheduled := range due {
+				s.settle(scheduled, s.send(scheduled))
+			}
+			if len(due) < int(policy.BatchSize) {
+				break
+			}
+		}
+	}
+}
+
+// send performs the permission check of the SendMessage RPC as of now, and
+// sends the message if the author still passes it. The ID of the message is
+// stored before it's sent, so a message whose instance sent it but didn't get
+// to settle it is found by the next instance instead of being sent again.
+func (s *Scheduler) send(scheduled queries.ScheduledMessage) error {
+	if scheduled.MessageID.Valid {
+		sent, err := s.DB.ScheduledMessageSent(uint64(scheduled.MessageID.Int64))
+		if err != nil || sent {
+			return err
+		}
+	}
+	if int(scheduled.Attempts) > s.Config.Server.Policies.ScheduledMessages.MaximumAttempts {
+		// the instances that claimed it before didn't get to settle it
+		return errTooManyAttempts
+	}
+	r := new(chatv1.SendMessageRequest)
+	if err := proto.Unmarshal(scheduled.Request, r); err != nil {
+		return err
+	}
+	r.GuildId = scheduled.GuildID
+	r.ChannelId = scheduled.ChannelID
+
+	ctx := middleware.HarmonyContext{
+		Context: context.Background(),
+		UserID:  scheduled.UserID,
+	}
+	owner, err := s.DB.GetOwner(scheduled.GuildID)
+	if err != nil {
+		return err
+	}
+	ctx.IsOwner = owner == scheduled.UserID
+	if !ctx.IsOwner {
+		member, err := s.DB.UserInGuild(scheduled.UserID, scheduled.GuildID)
+		if err != nil {
+			return err
+		}
+		if !member {
+			return ErrNotPermitted
+		}
+		if ctx.UserRoles, err = s.DB.RolesForUser(scheduled.GuildID, scheduled.UserID); err != nil {
+			return err
+		}
+		if !s.Perms.Check("messages.send", ctx.UserRoles, scheduled.GuildID, scheduled.ChannelID) {
+			return ErrNotPermitted
+		}
+	}
+	messageID, err := s.Sonyflake.NextID()
+	if err != nil {
+		return err
+	}
+	if err := s.DB.SetScheduledMessageID(scheduled.ScheduledID, messageID); err != nil {
+		return err
+	}
+	_, err = s.Sender.SendMessageWithID(ctx, r, messageID)
+	return err
+}
+
+// settle removes a claimed message once it's sent. Messages which failed for
+// a reason that may go away, such as slow mode or a database error, are
+// released to be retried later, the others are dropped and logged.
+//
+// Messages that can't be settled stay claimed until their lease runs out, and
+// are then sent again unless send finds they were sent already.
+func (s *Scheduler) settle(scheduled queries.ScheduledMessage, err error) {
+	if err == nil {
+		s.finish(scheduled)
+		return
+	}
+	policy := s.Config.Server.Policies.ScheduledMessages
+	if delay, ok := retryDelay(err, policy.RetryDelay); ok && int(scheduled.Attempts) < policy.MaximumAttempts {
+		if err := s.DB.RetryScheduledMessage(scheduled.ScheduledID, time.Now().UTC().Add(delay)); err != nil {
+			s.Logger.Exception(tracerr.Errorf("releasing scheduled message %d for a retry, it's retried once its lease runs out: %w", scheduled.ScheduledID, err))
+		}
+		return
+	}
+	s.Logger.Exception(tracerr.Errorf("dropping scheduled message %d of user %d after %d attempts: %w", scheduled.ScheduledID, scheduled.UserID, scheduled.Attempts, err))
+	s.finish(scheduled)
+}
+
+// finish removes a settled message
+func (s *Scheduler) finish(scheduled queries.ScheduledMessage) {
+	if err := s.DB.FinishScheduledMessage(scheduled.ScheduledID); err != nil {
+		s.Logger.Exception(tracerr.Errorf("removing scheduled message %d, it's claimed again once its lease runs out: %w", scheduled.ScheduledID, err))
+	}
+}
+
+// retryDelay works out whether a failed send is worth retrying and when.
+// Errors telling clients when to retry are honoured, other RPC errors are
+// the request's fault and errors from anywhere else are assumed to be
+// temporary.
+func retryDelay(err error, fallback time.Duration) (time.Duration, bool) {
+	if errors.Is(err, ErrNotPermitted) || errors.Is(err, errTooManyAttempts) {
+		return 0, false
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return fallback, true
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.RetryDelay.AsDuration(), true
+		}
+	}
+	switch st.Code() {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return fallback, true
+	}
+	return 0, false
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/db/queries"
+)
+
+// scheduleDB knows which messages were sent and records the scheduled
+// messages finished, every other method panics
+type scheduleDB struct {
+	db.IHarmonyDB
+	sent     map[uint64]bool
+	finished []uint64
+}
+
+func (d *scheduleDB) ScheduledMessageSent(messageID uint64) (bool, error) {
+	return d.sent[messageID], nil
+}
+
+func (d *scheduleDB) FinishScheduledMessage(scheduledID uint64) error {
+	d.finished = append(d.finished, scheduledID)
+	return nil
+}
+
+// sender records the IDs of the messages sent through it
+type sender struct {
+	sent []uint64
+}
+
+func (s *sender) SendMessageWithID(c context.Context, r *chatv1.SendMessageRequest, messageID uint64) (*chatv1.SendMessageResponse, error) {
+	s.sent = append(s.sent, messageID)
+	return &chatv1.SendMessageResponse{MessageId: messageID}, nil
+}
+
+func TestSendSkipsMessagesSentBefore(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Server.Policies.ScheduledMessages.MaximumAttempts = 1
+	database := &scheduleDB{sent: map[uint64]bool{7: true}}
+	messages := &sender{}
+	s := New(Dependencies{
+		DB:     database,
+		Config: cfg,
+		Sender: messages,
+	})
+
+	// an instance sent it, but its lease ran out before it could finish it
+	scheduled := queries.ScheduledMessage{
+		ScheduledID: 3,
+		Attempts:    2,
+		MessageID:   sql.NullInt64{Int64: 7, Valid: true},
+	}
+	s.settle(scheduled, s.send(scheduled))
+
+	if len(messages.sent) != 0 {
+		t.Errorf("expected the message not to be sent again, sent %v", messages.sent)
+	}
+	if len(database.finished) != 1 || database.finished[0] != 3 {
+		t.Errorf("expected the scheduled message to be finished, finished %v", database.finished)
+	}
+}
@@ -3,22 +3,25 @@ package v1
 import chatv1 "github.com/harmony-development/legato/gen/chat/v1"
 
 type ActionSubscriptionManager interface {
-	Subscribe(userID uint64, stream chatv1.ChatService_StreamEventsServer) chan struct{}
-	Unsubscribe(userID uint64, stream chatv1.ChatService_StreamEventsServer)
+	Subscribe(userID uint64, stream chatv1.ChatService_StreamEventsServer, resumeAfter uint64) chan struct{}
+	Unsubscribe(userID uint64, stream chatv1.ChatService_StreamEventsServer) bool
 	Broadcast(to uint64, action *chatv1.Event)
 }
 
 type GuildSubscriptionManager interface {
-	Subscribe(guildID, userID uint64, server chatv1.ChatService_StreamEventsServer) chan struct{}
+	Subscribe(guildID, userID uint64, server chatv1.ChatService_StreamEventsServer, resumeAfter uint64, channelIDs, threadIDs []uint64) chan struct{}
+	UpdateChannels(guildID uint64, server chatv1.ChatService_StreamEventsServer, channelIDs []uint64) bool
+	UpdateThreads(guildID uint64, server chatv1.ChatService_StreamEventsServer, threadIDs []uint64) bool
 	UnsubscribeUser(userID uint64)
 	UnsubscribeGuild(guildID uint64)
 	UnsubscribeUserFromGuild(userID, guildID uint64)
+	Unsubscribe(guildID, userID uint64, server chatv1.ChatService_StreamEventsServer) bool
 	Broadcast(to uint64, event *chatv1.Event)
 }
 
 type HomeserverSubscriptionManager interface {
-	Subscribe(userID uint64, s chatv1.ChatService_StreamEventsServer) chan struct{}
-	Unsubscribe(userID uint64, s chatv1.ChatService_StreamEventsServer)
+	Subscribe(userID uint64, s chatv1.ChatService_StreamEventsServer, resumeAfter uint64) chan struct{}
+	Unsubscribe(userID uint64, s chatv1.ChatService_StreamEventsServer) bool
 	Broadcast(userID uint64, e *chatv1.Event)
 }
 
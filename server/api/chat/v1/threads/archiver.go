@@ -0,0 +1,59 @@
+package threads
+
+import (
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/logger"
+)
+
+// Broadcaster delivers events to the subscribers of a guild
+type Broadcaster interface {
+	Broadcast(to uint64, event *chatv1.Event)
+}
+
+// Dependencies are the backend services this package needs
+type Dependencies struct {
+	DB     db.IHarmonyDB
+	Logger logger.ILogger
+	Config *config.Config
+	Guild  Broadcaster
+}
+
+// Archiver archives threads nobody posted in for a while
+type Archiver struct {
+	Dependencies
+}
+
+// New creates a new thread archiver
+func New(deps Dependencies) *Archiver {
+	return &Archiver{
+		Dependencies: deps,
+	}
+}
+
+// Run archives inactive threads every check interval. Every instance can
+// run it, a thread is only archived and announced once.
+func (a *Archiver) Run() {
+	policy := a.Config.Server.Policies.Threads
+	for {
+		time.Sleep(policy.CheckInterval)
+		archived, err := a.DB.ArchiveInactiveThreads(time.Now().UTC().Add(-policy.ArchiveAfter))
+		if err != nil {
+			continue
+		}
+		for _, thread := range archived {
+			a.Guild.Broadcast(thread.GuildID, &chatv1.Event{
+				Event: &chatv1.Event_ThreadArchived_{
+					ThreadArchived: &chatv1.Event_ThreadArchived{
+						GuildId:   thread.GuildID,
+						ChannelId: thread.ChannelID,
+						ThreadId:  thread.ThreadID,
+					},
+				},
+			})
+		}
+	}
+}
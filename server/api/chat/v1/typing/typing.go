@@ -0,0 +1,102 @@
+package typing
+
+import (
+	"sync"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+)
+
+// Timeout is how long a typing indicator lasts without being renewed
+const Timeout = 5 * time.Second
+
+// Broadcaster delivers events to the subscribers of a guild
+type Broadcaster interface {
+	Broadcast(to uint64, event *chatv1.Event)
+}
+
+type indicator struct {
+	guildID   uint64
+	channelID uint64
+	userID    uint64
+}
+
+// Manager keeps track of who is typing where. Indicators only live in
+// memory, they aren't worth a trip to the database.
+type Manager struct {
+	Guild  Broadcaster
+	timers map[indicator]*time.Timer
+	sync.Mutex
+}
+
+// New creates a new typing manager
+func New(guild Broadcaster) *Manager {
+	return &Manager{
+		Guild:  guild,
+		timers: make(map[indicator]*time.Timer),
+	}
+}
+
+// Start marks a user as typing in a channel until the timeout passes
+func (m *Manager) Start(guildID, channelID, userID uint64) {
+	m.Lock()
+	defer m.Unlock()
+
+	i := indicator{guildID, channelID, userID}
+	timer, ok := m.timers[i]
+	// a timer that already fired is on its way to stop the indicator,
+	// replace it instead
+	if ok && timer.Reset(Timeout) {
+		return
+	}
+	timer = time.AfterFunc(Timeout, func() {
+		m.expire(i, timer)
+	})
+	m.timers[i] = timer
+	if ok {
+		return
+	}
+	m.Guild.Broadcast(guildID, &chatv1.Event{
+		Event: &chatv1.Event_Typing_{
+			Typing: &chatv1.Event_Typing{
+				UserId:    userID,
+				GuildId:   guildID,
+				ChannelId: channelID,
+			},
+		},
+	})
+}
+
+// Stop clears the typing indicator of a user, if there is one
+func (m *Manager) Stop(guildID, channelID, userID uint64) {
+	m.Lock()
+	defer m.Unlock()
+
+	i := indicator{guildID, channelID, userID}
+	if timer, ok := m.timers[i]; ok {
+		timer.Stop()
+		m.remove(i)
+	}
+}
+
+func (m *Manager) expire(i indicator, timer *time.Timer) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.timers[i] == timer {
+		m.remove(i)
+	}
+}
+
+func (m *Manager) remove(i indicator) {
+	delete(m.timers, i)
+	m.Guild.Broadcast(i.guildID, &chatv1.Event{
+		Event: &chatv1.Event_TypingStopped_{
+			TypingStopped: &chatv1.Event_TypingStopped{
+				UserId:    i.userID,
+				GuildId:   i.guildID,
+				ChannelId: i.channelID,
+			},
+		},
+	})
+}
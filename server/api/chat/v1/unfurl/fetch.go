@@ -0,0 +1,371 @@
+package unfurl
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	harmonytypesv1 "github.com/harmony-development/legato/gen/harmonytypes/v1"
+	"golang.org/x/net/html"
+)
+
+const maximumRedirects = 5
+
+// mediaPath is where clients download files stored on the server
+const mediaPath = "/_harmony/media/download/"
+
+// ErrBlockedAddress is returned when a link resolves to an address the
+// server must not connect to on behalf of users
+var ErrBlockedAddress = errors.New("link resolves to a blocked address")
+
+// ErrUnsupportedImage is returned for preview images which are too large or
+// not in a format that's safe to serve from the server
+var ErrUnsupportedImage = errors.New("unsupported preview image")
+
+// imageTypes are the image formats previews may include. SVG is left out
+// since it can carry scripts, and stored files are served inline.
+var imageTypes = map[string]bool{
+	"image/gif":  true,
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+var blockedNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.0.2.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"198.51.100.0/24",
+	"203.0.113.0/24",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func parseNetworks(cidrs ...string) (ret []*net.IPNet) {
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		ret = append(ret, network)
+	}
+	return
+}
+
+// isBlocked checks whether an address is loopback, private, link-local or
+// otherwise not on the public internet
+func isBlocked(ip net.IP) bool {
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// newClient creates an HTTP client which checks every address it connects
+// to, after DNS resolution and on every redirect, so a link can't reach
+// internal services by pointing a hostname or a redirect at them
+func newClient(timeout time.Duration, blocked func(net.IP) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || blocked(ip) {
+				return ErrBlockedAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// going through a proxy would bypass the address checks
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          16,
+			IdleConnTimeout:       time.Minute,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maximumRedirects {
+				return http.ErrUseLastResponse
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+}
+
+// get fetches a link, reading at most maxSize bytes of the body
+func (u *Unfurler) get(link string, maxSize int64) (*http.Response, []byte, error) {
+	req, err := http.NewRequest(http.MethodGet, link, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("User-Agent", "legato link preview")
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize))
+	return resp, data, err
+}
+
+type metadata struct {
+	title       string
+	description string
+	siteName    string
+	image       string
+	url         string
+	oembed      string
+}
+
+// parseMetadata reads the OpenGraph and plain HTML metadata of a page along
+// with the oEmbed endpoint it advertises
+func parseMetadata(page []byte, base *url.URL) (ret metadata) {
+	var docTitle, docDescription string
+	inTitle := false
+	tokens := html.NewTokenizer(strings.NewReader(string(page)))
+	for {
+		switch tokens.Next() {
+		case html.ErrorToken:
+			goto done
+		case html.TextToken:
+			if inTitle && docTitle == "" {
+				docTitle = strings.TrimSpace(string(tokens.Text()))
+			}
+		case html.EndTagToken:
+			name, _ := tokens.TagName()
+			switch string(name) {
+			case "title":
+				inTitle = false
+			case "head":
+				goto done
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokens.TagName()
+			attrs := map[string]string{}
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = tokens.TagAttr()
+				attrs[string(key)] = string(val)
+			}
+			switch string(name) {
+			case "title":
+				inTitle = true
+			case "body":
+				goto done
+			case "meta":
+				content := strings.TrimSpace(attrs["content"])
+				property := attrs["property"]
+				if property == "" {
+					property = attrs["name"]
+				}
+				switch strings.ToLower(property) {
+				case "og:title":
+					ret.title = content
+				case "og:description":
+					ret.description = content
+				case "description":
+					docDescription = content
+				case "og:site_name":
+					ret.siteName = content
+				case "og:image", "og:image:url":
+					if ret.image == "" {
+						ret.image = resolve(base, content)
+					}
+				case "og:url":
+					ret.url = resolve(base, content)
+				}
+			case "link":
+				if strings.EqualFold(attrs["rel"], "alternate") && attrs["type"] == "application/json+oembed" {
+					ret.oembed = resolve(base, attrs["href"])
+				}
+			}
+		}
+	}
+done:
+	if ret.title == "" {
+		ret.title = docTitle
+	}
+	if ret.description == "" {
+		ret.description = docDescription
+	}
+	if ret.url == "" {
+		ret.url = base.String()
+	}
+	return
+}
+
+// resolve makes a link found in a page absolute, dropping anything that
+// isn't http(s)
+func resolve(base *url.URL, ref string) string {
+	parsed, err := base.Parse(strings.TrimSpace(ref))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ""
+	}
+	return parsed.String()
+}
+
+type oembed struct {
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	ProviderName string `json:"provider_name"`
+	ProviderURL  string `json:"provider_url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	URL          string `json:"url"`
+}
+
+// fetch builds the preview of a link. OpenGraph metadata is preferred, the
+// oEmbed endpoint of the page fills in whatever is missing.
+func (u *Unfurler) fetch(link string, maxSize int64) (*harmonytypesv1.Embed, error) {
+	resp, page, err := u.get(link, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, nil
+	}
+	meta := parseMetadata(page, resp.Request.URL)
+
+	var author string
+	if meta.oembed != "" {
+		if _, data, err := u.get(meta.oembed, maxSize); err == nil {
+			var info oembed
+			if json.Unmarshal(data, &info) == nil {
+				author = info.AuthorName
+				if meta.title == "" {
+					meta.title = info.Title
+				}
+				if meta.siteName == "" {
+					meta.siteName = info.ProviderName
+				}
+				if meta.image == "" && info.Type == "photo" {
+					meta.image = resolve(resp.Request.URL, info.URL)
+				}
+				if meta.image == "" {
+					meta.image = resolve(resp.Request.URL, info.ThumbnailURL)
+				}
+			}
+		}
+	}
+	if meta.title == "" && meta.description == "" && meta.image == "" {
+		return nil, nil
+	}
+
+	embed := &harmonytypesv1.Embed{
+		Title: meta.title,
+		Body:  meta.description,
+		Header: &harmonytypesv1.EmbedHeading{
+			Text:    meta.siteName,
+			Subtext: author,
+			Url:     meta.url,
+		},
+	}
+	if meta.image != "" {
+		meta.image, err = u.rehost(meta.image)
+		if err != nil {
+			meta.image = ""
+		}
+	}
+	if meta.image != "" {
+		embed.Fields = append(embed.Fields, &harmonytypesv1.EmbedField{
+			ImageUrl:     meta.image,
+			Presentation: harmonytypesv1.FieldPresentation_CaptionedImage,
+		})
+	}
+	return embed, nil
+}
+
+// rehost stores a copy of a preview image on the server, so that clients
+// load it from their homeserver instead of from the linked site. An image
+// that's already stored is reused instead of being fetched again.
+func (u *Unfurler) rehost(link string) (string, error) {
+	stored, err := u.DB.UsePreviewImage(link)
+	if err == nil {
+		return mediaPath + stored, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+	maxSize := u.Config.Server.Policies.LinkPreviews.MaximumImageSize
+	resp, data, err := u.get(link, maxSize+1)
+	if err != nil {
+		return "", err
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if !imageTypes[mediaType] || int64(len(data)) > maxSize {
+		return "", ErrUnsupportedImage
+	}
+	name := path.Base(resp.Request.URL.Path)
+	if name == "/" || name == "." {
+		name = "preview"
+	}
+	id, err := u.StorageBackend.SaveFile(name, mediaType, bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	// another worker may have stored the same image meanwhile
+	stored, err = u.DB.AddPreviewImage(link, id)
+	if err != nil || stored != id {
+		if err := u.StorageBackend.DeleteFile(id); err != nil {
+			u.Logger.CheckException(err)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return mediaPath + stored, nil
+}
+
+// previewImages gets the stored images embeds show
+func previewImages(embeds []*harmonytypesv1.Embed) []string {
+	var fileIDs []string
+	for _, embed := range embeds {
+		for _, field := range embed.Fields {
+			if strings.HasPrefix(field.ImageUrl, mediaPath) {
+				fileIDs = append(fileIDs, strings.TrimPrefix(field.ImageUrl, mediaPath))
+			}
+		}
+	}
+	return fileIDs
+}
@@ -0,0 +1,244 @@
+package unfurl
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	harmonytypesv1 "github.com/harmony-development/legato/gen/harmonytypes/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/http/attachments/backend"
+	"github.com/harmony-development/legato/server/logger"
+	lru "github.com/hashicorp/golang-lru"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var linkRegex = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// imageBatchSize is how many unused preview images are deleted at once
+const imageBatchSize = 100
+
+// Broadcaster delivers events to the subscribers of a guild
+type Broadcaster interface {
+	Broadcast(to uint64, event *chatv1.Event)
+}
+
+// Dependencies are the backend services this package needs
+type Dependencies struct {
+	DB             db.IHarmonyDB
+	Logger         logger.ILogger
+	Config         *config.Config
+	Guild          Broadcaster
+	StorageBackend backend.AttachmentBackend
+}
+
+// Unfurler fetches link previews on behalf of clients, so that posting a
+// link doesn't make every client reveal its address to the linked site
+type Unfurler struct {
+	Dependencies
+	client *http.Client
+	cache  *lru.Cache
+	queue  chan job
+}
+
+type cachedPreview struct {
+	embed   *harmonytypesv1.Embed
+	err     error
+	expires time.Time
+}
+
+// job is a sent message waiting to have its links previewed
+type job struct {
+	guildID   uint64
+	channelID uint64
+	messageID uint64
+	content   string
+}
+
+// New creates a new link unfurler
+func New(deps Dependencies) *Unfurler {
+	policy := deps.Config.Server.Policies.LinkPreviews
+	cache, err := lru.New(policy.CacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &Unfurler{
+		Dependencies: deps,
+		client:       newClient(policy.Timeout, isBlocked),
+		cache:        cache,
+		queue:        make(chan job, policy.QueueLength),
+	}
+}
+
+// Run previews queued messages, with at most the configured number of
+// workers fetching links at once
+func (u *Unfurler) Run() {
+	go u.collectImages()
+	var wg sync.WaitGroup
+	for i := 0; i < u.Config.Server.Policies.LinkPreviews.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range u.queue {
+				u.Unfurl(j.guildID, j.channelID, j.messageID, j.content)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// collectImages deletes the stored preview images no message shows. An
+// image is only deleted once it's unused for longer than previews are
+// cached, so that cached previews never show deleted images.
+func (u *Unfurler) collectImages() {
+	policy := u.Config.Server.Policies.LinkPreviews
+	for {
+		time.Sleep(policy.CacheDuration)
+		for {
+			fileIDs, err := u.DB.ExpirePreviewImages(time.Now().UTC().Add(-policy.CacheDuration), imageBatchSize)
+			if err != nil {
+				break
+			}
+			for _, fileID := range fileIDs {
+				// a message may have it as an attachment too
+				if inUse, err := u.DB.FileInUse(fileID); err != nil || inUse {
+					continue
+				}
+				if err := u.StorageBackend.DeleteFile(fileID); err != nil {
+					u.Logger.CheckException(err)
+				}
+			}
+			if len(fileIDs) < imageBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// Enqueue queues a freshly sent message to have its links previewed. When
+// the queue is full the message goes without previews, so that sending is
+// never held up by slow sites.
+func (u *Unfurler) Enqueue(guildID, channelID, messageID uint64, content string) {
+	policy := u.Config.Server.Policies.LinkPreviews
+	if !policy.Enabled || len(Links(content, 1)) == 0 {
+		return
+	}
+	select {
+	case u.queue <- job{guildID, channelID, messageID, content}:
+	default:
+	}
+}
+
+// Links finds up to max distinct http(s) links in message content
+func Links(content string, max int) []string {
+	var links []string
+	seen := map[string]bool{}
+	for _, link := range linkRegex.FindAllString(content, -1) {
+		link = strings.TrimRight(link, ".,:;!?)]}'")
+		if seen[link] {
+			continue
+		}
+		if len(links) >= max {
+			break
+		}
+		seen[link] = true
+		links = append(links, link)
+	}
+	return links
+}
+
+// Preview gets the preview of a link, from the cache if it was fetched
+// recently. Links without a preview give a nil embed, and are cached too so
+// they aren't fetched over and over. Failed fetches are cached for a shorter
+// while, so a dead link isn't retried for every message it's posted in.
+func (u *Unfurler) Preview(link string) (*harmonytypesv1.Embed, error) {
+	if val, ok := u.cache.Get(link); ok {
+		cached := val.(cachedPreview)
+		if time.Now().Before(cached.expires) {
+			return cached.embed, cached.err
+		}
+		u.cache.Remove(link)
+	}
+	policy := u.Config.Server.Policies.LinkPreviews
+	embed, err := u.fetch(link, policy.MaximumSize)
+	duration := policy.CacheDuration
+	if err != nil {
+		embed = nil
+		duration = policy.FailureCacheDuration
+	}
+	u.cache.Add(link, cachedPreview{
+		embed:   embed,
+		err:     err,
+		expires: time.Now().Add(duration),
+	})
+	return embed, err
+}
+
+// Unfurl previews the links of a freshly sent message and adds them to its
+// embeds. It's meant to run in the background after the message was sent.
+func (u *Unfurler) Unfurl(guildID, channelID, messageID uint64, content string) {
+	policy := u.Config.Server.Policies.LinkPreviews
+	if !policy.Enabled {
+		return
+	}
+	var previews []*harmonytypesv1.Embed
+	for _, link := range Links(content, policy.MaximumLinks) {
+		embed, err := u.Preview(link)
+		if err != nil || embed == nil {
+			continue
+		}
+		previews = append(previews, proto.Clone(embed).(*harmonytypesv1.Embed))
+	}
+	if len(previews) == 0 {
+		return
+	}
+
+	message, err := u.DB.GetMessage(messageID)
+	if err != nil {
+		return
+	}
+	// the links may be gone by now
+	if message.DeletedAt.Valid || message.Content != content {
+		return
+	}
+	var embeds []*harmonytypesv1.Embed
+	if err := json.Unmarshal(message.Embeds, &embeds); err != nil {
+		u.Logger.CheckException(err)
+		return
+	}
+	embeds = append(embeds, previews...)
+	data, err := json.Marshal(embeds)
+	if err != nil {
+		u.Logger.CheckException(err)
+		return
+	}
+	// previews aren't edits, so they go in without a revision or edit time
+	ok, err := u.DB.SetMessageEmbeds(messageID, content, data, previewImages(previews))
+	if err != nil || !ok {
+		return
+	}
+	var editedAt *timestamppb.Timestamp
+	if message.EditedAt.Valid {
+		editedAt, _ = ptypes.TimestampProto(message.EditedAt.Time.UTC())
+	}
+	u.Guild.Broadcast(guildID, &chatv1.Event{
+		Event: &chatv1.Event_EditedMessage{
+			EditedMessage: &chatv1.Event_MessageUpdated{
+				GuildId:      guildID,
+				ChannelId:    channelID,
+				MessageId:    messageID,
+				EditedAt:     editedAt,
+				Embeds:       embeds,
+				UpdateEmbeds: true,
+				ThreadId:     uint64(message.ThreadID.Int64),
+			},
+		},
+	})
+}
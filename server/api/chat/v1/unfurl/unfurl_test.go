@@ -0,0 +1,346 @@
+package unfurl
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	harmonytypesv1 "github.com/harmony-development/legato/gen/harmonytypes/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const openGraphPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>Fallback title</title>
+  <meta property="og:title" content="Harmony">
+  <meta property="og:description" content="A free and open chat protocol">
+  <meta property="og:site_name" content="Example">
+  <meta property="og:image" content="/banner.png">
+</head>
+<body><meta property="og:title" content="not in the head"></body>
+</html>`
+
+const oembedPage = `<html><head>
+  <title>Cat video</title>
+  <link rel="alternate" type="application/json+oembed" href="/oembed.json">
+</head></html>`
+
+const oembedData = `{
+  "type": "video",
+  "title": "Cat video",
+  "author_name": "someone",
+  "provider_name": "Tube",
+  "thumbnail_url": "/thumb.jpg"
+}`
+
+// fixture serves test pages and counts the requests it gets
+func fixture(t *testing.T) (*httptest.Server, *int32) {
+	var hits int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/og", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, openGraphPage)
+	})
+	mux.HandleFunc("/oembed", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, oembedPage)
+	})
+	mux.HandleFunc("/oembed.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, oembedData)
+	})
+	for _, image := range []string{"/image.png", "/banner.png", "/thumb.jpg"} {
+		mux.HandleFunc(image, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "image/png")
+			fmt.Fprint(w, "\x89PNG")
+		})
+	}
+	mux.HandleFunc("/huge", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, "<html><head><!-- %s -->", strings.Repeat("a", 4096))
+		fmt.Fprint(w, `<meta property="og:title" content="too far"></head></html>`)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/og", http.StatusFound)
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits
+}
+
+// storage keeps the files saved by the unfurler in memory
+type storage struct {
+	files map[string]string
+}
+
+func (s *storage) SaveFile(name, contentType string, r io.Reader) (string, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	id := fmt.Sprintf("file%d", len(s.files))
+	s.files[id] = contentType + ":" + string(data)
+	return id, nil
+}
+
+func (s *storage) GetMetadata(id string) (string, string, int32, error) {
+	return "", "", 0, errors.New("not implemented")
+}
+
+func (s *storage) ReadFile(id string) (string, string, int32, io.ReadCloser, error) {
+	return "", "", 0, nil, errors.New("not implemented")
+}
+
+func (s *storage) DeleteFile(id string) error {
+	delete(s.files, id)
+	return nil
+}
+
+// previewDB keeps the preview images recorded by the unfurler in memory,
+// every other method panics
+type previewDB struct {
+	db.IHarmonyDB
+	images map[string]string
+}
+
+func (d *previewDB) UsePreviewImage(url string) (string, error) {
+	if fileID, ok := d.images[url]; ok {
+		return fileID, nil
+	}
+	return "", sql.ErrNoRows
+}
+
+func (d *previewDB) AddPreviewImage(url, fileID string) (string, error) {
+	if stored, ok := d.images[url]; ok {
+		return stored, nil
+	}
+	d.images[url] = fileID
+	return fileID, nil
+}
+
+// newTestUnfurler creates an unfurler allowed to reach the loopback
+// fixture server
+func newTestUnfurler(t *testing.T, blocked func(net.IP) bool) *Unfurler {
+	cfg := &config.Config{}
+	policy := &cfg.Server.Policies.LinkPreviews
+	policy.Enabled = true
+	policy.MaximumLinks = 5
+	policy.MaximumSize = 1024
+	policy.Timeout = 2 * time.Second
+	policy.MaximumImageSize = 1024
+	policy.CacheDuration = time.Hour
+	policy.FailureCacheDuration = time.Minute
+	cache, err := lru.New(16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Unfurler{
+		Dependencies: Dependencies{
+			DB:             &previewDB{images: map[string]string{}},
+			Config:         cfg,
+			StorageBackend: &storage{files: map[string]string{}},
+		},
+		client: newClient(policy.Timeout, blocked),
+		cache:  cache,
+	}
+}
+
+func allowAll(net.IP) bool { return false }
+
+func TestLinks(t *testing.T) {
+	cases := []struct {
+		content string
+		max     int
+		want    []string
+	}{
+		{"no links here", 5, nil},
+		{"see https://example.com/a.", 5, []string{"https://example.com/a"}},
+		{"(http://example.com) http://example.com", 5, []string{"http://example.com"}},
+		{"<https://a.example> ftp://b.example https://c.example/?q=1", 5, []string{"https://a.example", "https://c.example/?q=1"}},
+		{"https://a.example https://b.example https://c.example", 2, []string{"https://a.example", "https://b.example"}},
+	}
+	for _, c := range cases {
+		got := Links(c.content, c.max)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Links(%q, %d) = %q, want %q", c.content, c.max, got, c.want)
+		}
+	}
+}
+
+func TestPreviewOpenGraph(t *testing.T) {
+	server, hits := fixture(t)
+	u := newTestUnfurler(t, allowAll)
+
+	embed, err := u.Preview(server.URL + "/og")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if embed == nil {
+		t.Fatal("expected a preview")
+	}
+	if embed.Title != "Harmony" || embed.Body != "A free and open chat protocol" {
+		t.Errorf("unexpected title and body %q, %q", embed.Title, embed.Body)
+	}
+	if embed.Header.GetText() != "Example" || embed.Header.GetUrl() != server.URL+"/og" {
+		t.Errorf("unexpected header %+v", embed.Header)
+	}
+	if len(embed.Fields) != 1 || embed.Fields[0].ImageUrl != "/_harmony/media/download/file0" {
+		t.Errorf("unexpected fields %+v", embed.Fields)
+	}
+	if got := u.StorageBackend.(*storage).files["file0"]; got != "image/png:\x89PNG" {
+		t.Errorf("expected the image to be stored on the server, got %q", got)
+	}
+	if embed.Fields[0].Presentation != harmonytypesv1.FieldPresentation_CaptionedImage {
+		t.Errorf("unexpected presentation %v", embed.Fields[0].Presentation)
+	}
+
+	fetched := atomic.LoadInt32(hits)
+	if _, err := u.Preview(server.URL + "/og"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(hits) - fetched; got != 0 {
+		t.Errorf("expected the second preview to be cached, got %d requests", got)
+	}
+}
+
+func TestPreviewReusesStoredImages(t *testing.T) {
+	server, hits := fixture(t)
+	u := newTestUnfurler(t, allowAll)
+
+	if _, err := u.Preview(server.URL + "/og"); err != nil {
+		t.Fatal(err)
+	}
+	fetched := atomic.LoadInt32(hits)
+	u.cache.Purge()
+	embed, err := u.Preview(server.URL + "/og")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(hits) - fetched; got != 1 {
+		t.Errorf("expected only the page to be fetched again, got %d requests", got)
+	}
+	if len(embed.Fields) != 1 || embed.Fields[0].ImageUrl != "/_harmony/media/download/file0" {
+		t.Errorf("unexpected fields %+v", embed.Fields)
+	}
+	if got := len(u.StorageBackend.(*storage).files); got != 1 {
+		t.Errorf("expected a single stored image, got %d", got)
+	}
+	if got := previewImages([]*harmonytypesv1.Embed{embed}); !reflect.DeepEqual(got, []string{"file0"}) {
+		t.Errorf("unexpected preview images %q", got)
+	}
+}
+
+func TestPreviewOEmbed(t *testing.T) {
+	server, _ := fixture(t)
+	u := newTestUnfurler(t, allowAll)
+
+	embed, err := u.Preview(server.URL + "/oembed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if embed == nil {
+		t.Fatal("expected a preview")
+	}
+	if embed.Title != "Cat video" {
+		t.Errorf("unexpected title %q", embed.Title)
+	}
+	if embed.Header.GetText() != "Tube" || embed.Header.GetSubtext() != "someone" {
+		t.Errorf("unexpected header %+v", embed.Header)
+	}
+	if len(embed.Fields) != 1 || embed.Fields[0].ImageUrl != "/_harmony/media/download/file0" {
+		t.Errorf("unexpected fields %+v", embed.Fields)
+	}
+}
+
+func TestPreviewWithoutMetadata(t *testing.T) {
+	server, _ := fixture(t)
+	u := newTestUnfurler(t, allowAll)
+
+	for _, path := range []string{"/image.png", "/huge"} {
+		embed, err := u.Preview(server.URL + path)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if embed != nil {
+			t.Errorf("%s: expected no preview, got %+v", path, embed)
+		}
+	}
+}
+
+func TestPreviewCachesFailures(t *testing.T) {
+	server, hits := fixture(t)
+	u := newTestUnfurler(t, allowAll)
+
+	for i := 0; i < 2; i++ {
+		if _, err := u.Preview(server.URL + "/missing"); err == nil {
+			t.Error("expected an error for a missing page")
+		}
+	}
+	if got := atomic.LoadInt32(hits); got != 1 {
+		t.Errorf("expected the failure to be cached, got %d requests", got)
+	}
+}
+
+func TestPreviewFollowsRedirects(t *testing.T) {
+	server, _ := fixture(t)
+	u := newTestUnfurler(t, allowAll)
+
+	embed, err := u.Preview(server.URL + "/redirect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if embed.GetTitle() != "Harmony" || embed.Header.GetUrl() != server.URL+"/og" {
+		t.Errorf("unexpected preview %+v", embed)
+	}
+}
+
+func TestPreviewBlocksPrivateAddresses(t *testing.T) {
+	server, hits := fixture(t)
+	u := newTestUnfurler(t, isBlocked)
+
+	if _, err := u.Preview(server.URL + "/og"); !errors.Is(err, ErrBlockedAddress) {
+		t.Errorf("expected the loopback fixture to be blocked, got %v", err)
+	}
+	if got := atomic.LoadInt32(hits); got != 0 {
+		t.Errorf("expected no requests to reach the fixture, got %d", got)
+	}
+}
+
+func TestIsBlocked(t *testing.T) {
+	cases := map[string]bool{
+		"127.0.0.1":        true,
+		"10.1.2.3":         true,
+		"172.20.0.1":       true,
+		"192.168.1.1":      true,
+		"169.254.169.254":  true,
+		"100.64.0.1":       true,
+		"0.0.0.0":          true,
+		"::1":              true,
+		"fd00::1":          true,
+		"fe80::1":          true,
+		"::ffff:127.0.0.1": true,
+		"93.184.216.34":    false,
+		"2606:4700::1111":  false,
+	}
+	for addr, want := range cases {
+		if got := isBlocked(net.ParseIP(addr)); got != want {
+			t.Errorf("isBlocked(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
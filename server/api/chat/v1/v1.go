@@ -7,13 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"strconv"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
 	harmonytypesv1 "github.com/harmony-development/legato/gen/harmonytypes/v1"
+	"github.com/harmony-development/legato/server/api/chat/v1/automod"
+	"github.com/harmony-development/legato/server/api/chat/v1/mentions"
 	"github.com/harmony-development/legato/server/api/chat/v1/permissions"
+	"github.com/harmony-development/legato/server/api/chat/v1/presence"
+	"github.com/harmony-development/legato/server/api/chat/v1/typing"
+	"github.com/harmony-development/legato/server/api/chat/v1/unfurl"
 	"github.com/harmony-development/legato/server/api/middleware"
 	"github.com/harmony-development/legato/server/config"
 	"github.com/harmony-development/legato/server/db"
@@ -22,9 +31,11 @@ import (
 	"github.com/harmony-development/legato/server/logger"
 	"github.com/harmony-development/legato/server/responses"
 	"github.com/sony/sonyflake"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -43,6 +54,9 @@ type Dependencies struct {
 	Sonyflake      *sonyflake.Sonyflake
 	PubSub         SubscriptionManager
 	Perms          *permissions.Manager
+	Presence       *presence.Manager
+	TypingManager  *typing.Manager
+	Unfurler       *unfurl.Unfurler
 	Config         *config.Config
 	StorageBackend backend.AttachmentBackend
 }
@@ -99,6 +113,132 @@ func (v1 *V1) ProtoToOverrides(overrides *harmonytypesv1.Override) (ret []byte)
 	return
 }
 
+// MessageToProto converts a stored message to its protocol representation
+func (v1 *V1) MessageToProto(message queries.Message) (*harmonytypesv1.Message, error) {
+	createdAt, _ := ptypes.TimestampProto(message.CreatedAt.UTC())
+	var editedAt *timestamppb.Timestamp
+	if message.EditedAt.Valid {
+		editedAt, _ = ptypes.TimestampProto(message.EditedAt.Time.UTC())
+	}
+	var embeds []*harmonytypesv1.Embed
+	var actions []*harmonytypesv1.Action
+	var override *harmonytypesv1.Override
+	attachments := []*harmonytypesv1.Attachment{}
+	if err := json.Unmarshal(message.Embeds, &embeds); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(message.Actions, &actions); err != nil {
+		return nil, err
+	}
+	if len(message.Overrides) > 0 {
+		override = new(harmonytypesv1.Override)
+		if err := proto.Unmarshal(message.Overrides, override); err != nil {
+			return nil, err
+		}
+	}
+	for _, a := range message.Attachments {
+		contentType, fileName, size, err := v1.StorageBackend.GetMetadata(a)
+		if err == nil {
+			attachments = append(attachments, &harmonytypesv1.Attachment{
+				Id:   a,
+				Name: fileName,
+				Type: contentType,
+				Size: size,
+			})
+		}
+	}
+	return &harmonytypesv1.Message{
+		GuildId:     message.GuildID,
+		ChannelId:   message.ChannelID,
+		MessageId:   message.MessageID,
+		AuthorId:    message.UserID,
+		CreatedAt:   createdAt,
+		EditedAt:    editedAt,
+		Content:     message.Content,
+		Embeds:      embeds,
+		Attachments: attachments,
+		Actions:     actions,
+		Overrides:   override,
+		InReplyTo:   uint64(message.ReplyToID.Int64),
+		ThreadId:    uint64(message.ThreadID.Int64),
+	}, nil
+}
+
+// RevisionToProto converts a stored revision of a message to its protocol
+// representation
+func (v1 *V1) RevisionToProto(revision queries.MessageRevision) (*chatv1.MessageRevision, error) {
+	message, err := v1.MessageToProto(queries.Message{
+		Content:     revision.Content,
+		Embeds:      revision.Embeds,
+		Actions:     revision.Actions,
+		Overrides:   revision.Overrides,
+		Attachments: revision.Attachments,
+	})
+	if err != nil {
+		return nil, err
+	}
+	writtenAt, _ := ptypes.TimestampProto(revision.WrittenAt.UTC())
+	replacedAt, _ := ptypes.TimestampProto(revision.ReplacedAt.UTC())
+	return &chatv1.MessageRevision{
+		Content:     message.Content,
+		Embeds:      message.Embeds,
+		Actions:     message.Actions,
+		Attachments: message.Attachments,
+		Overrides:   message.Overrides,
+		WrittenAt:   writtenAt,
+		ReplacedAt:  replacedAt,
+	}, nil
+}
+
+// ThreadToProto converts a stored thread to its protocol representation
+func (v1 *V1) ThreadToProto(thread queries.Thread) *chatv1.Thread {
+	createdAt, _ := ptypes.TimestampProto(thread.CreatedAt.UTC())
+	lastMessageAt, _ := ptypes.TimestampProto(thread.LastMessageAt.UTC())
+	return &chatv1.Thread{
+		GuildId:       thread.GuildID,
+		ChannelId:     thread.ChannelID,
+		ThreadId:      thread.ThreadID,
+		Name:          thread.Name,
+		CreatedBy:     thread.CreatedBy,
+		CreatedAt:     createdAt,
+		LastMessageAt: lastMessageAt,
+		Archived:      thread.Archived,
+	}
+}
+
+// MessagesToProto converts stored messages along with their reactions,
+// leaving out the ones that can't be decoded
+func (v1 *V1) MessagesToProto(messages []queries.Message) ([]*harmonytypesv1.Message, error) {
+	ret := []*harmonytypesv1.Message{}
+	byID := map[uint64]*harmonytypesv1.Message{}
+	ids := []uint64{}
+	for _, message := range messages {
+		msg, err := v1.MessageToProto(message)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, msg)
+		byID[msg.MessageId] = msg
+		ids = append(ids, msg.MessageId)
+	}
+	if len(ids) == 0 {
+		return ret, nil
+	}
+	reactions, err := v1.DB.GetReactions(ids)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	for _, reaction := range reactions {
+		msg := byID[reaction.MessageID]
+		msg.Reactions = append(msg.Reactions, &harmonytypesv1.Reaction{
+			Emoji: reaction.Emoji,
+			Emote: reaction.Emote,
+			Count: uint32(reaction.Count),
+		})
+	}
+	return ret, nil
+}
+
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
@@ -307,6 +447,7 @@ func (v1 *V1) GetGuildChannels(c context.Context, r *chatv1.GetGuildChannelsRequ
 				ChannelName: channel.ChannelName,
 				IsCategory:  channel.Category,
 				Kind:        channel.Kind.String,
+				SlowMode:    uint32(channel.SlowMode),
 			})
 		}
 	}
@@ -333,53 +474,18 @@ func (v1 *V1) GetMessage(c context.Context, r *chatv1.GetMessageRequest) (*chatv
 	if err != nil {
 		return nil, err
 	}
-	createdAt, _ := ptypes.TimestampProto(message.CreatedAt.UTC())
-	var editedAt *timestamppb.Timestamp
-	if message.EditedAt.Valid {
-		editedAt, _ = ptypes.TimestampProto(editedAt.AsTime().UTC())
-	}
-	var embeds []*harmonytypesv1.Embed
-	var actions []*harmonytypesv1.Action
-	attachments := []*harmonytypesv1.Attachment{}
-	var override *harmonytypesv1.Override
-	if err := json.Unmarshal(message.Embeds, &embeds); err != nil {
-		return nil, err
+	if message.DeletedAt.Valid {
+		return nil, status.Error(codes.NotFound, responses.BadLocationMessage)
 	}
-	if err := json.Unmarshal(message.Actions, &actions); err != nil {
+	msgs, err := v1.MessagesToProto([]queries.Message{message})
+	if err != nil {
 		return nil, err
 	}
-	if len(message.Overrides) > 0 {
-		override = new(harmonytypesv1.Override)
-		if err := proto.Unmarshal(message.Overrides, override); err != nil {
-			return nil, err
-		}
-	}
-	for _, a := range message.Attachments {
-		contentType, fileName, size, err := v1.StorageBackend.GetMetadata(a)
-		if err == nil {
-			attachments = append(attachments, &harmonytypesv1.Attachment{
-				Id:   a,
-				Name: fileName,
-				Type: contentType,
-				Size: size,
-			})
-		}
+	if len(msgs) == 0 {
+		return nil, status.Error(codes.Internal, responses.InternalServerError)
 	}
 	return &chatv1.GetMessageResponse{
-		Message: &harmonytypesv1.Message{
-			GuildId:     message.GuildID,
-			ChannelId:   message.ChannelID,
-			MessageId:   message.MessageID,
-			AuthorId:    message.UserID,
-			CreatedAt:   createdAt,
-			EditedAt:    editedAt,
-			Content:     message.Content,
-			Embeds:      embeds,
-			Attachments: attachments,
-			Actions:     actions,
-			Overrides:   override,
-			InReplyTo:   uint64(message.ReplyToID.Int64),
-		},
+		Message: msgs[0],
 	}, nil
 }
 
@@ -397,202 +503,589 @@ func init() {
 
 // GetChannelMessages implements the GetChannelMessages RPC
 func (v1 *V1) GetChannelMessages(c context.Context, r *chatv1.GetChannelMessagesRequest) (*chatv1.GetChannelMessagesResponse, error) {
-	var err error
+	var cursor queries.Message
+	cursors := 0
+	for _, messageID := range []uint64{r.BeforeMessage, r.AfterMessage, r.AroundMessage} {
+		if messageID != 0 {
+			cursors++
+			var err error
+			if cursor, err = v1.cursorMessage(r.GuildId, r.ChannelId, messageID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if cursors > 1 {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+
+	maximum := v1.Config.Server.Policies.APIs.Messages.MaximumGetAmount
+	limit := int(r.Limit)
+	if limit == 0 || limit > maximum {
+		limit = maximum
+	}
+
 	var messages []queries.Message
-	if r.BeforeMessage != 0 {
-		time, err := v1.DB.GetMessageDate(r.BeforeMessage)
+	var reachedTop, reachedBottom bool
+	switch {
+	case r.BeforeMessage != 0:
+		before, err := v1.DB.GetMessagesBefore(r.GuildId, r.ChannelId, r.BeforeMessage, int32(limit))
 		if err != nil {
 			return nil, err
 		}
-		messages, err = v1.DB.GetMessagesBefore(r.GuildId, r.ChannelId, time)
-		if err != nil && err != sql.ErrNoRows {
+		messages = before
+		reachedTop = len(before) < limit
+	case r.AfterMessage != 0:
+		after, err := v1.DB.GetMessagesAfter(r.GuildId, r.ChannelId, r.AfterMessage, int32(limit))
+		if err != nil {
 			return nil, err
 		}
-	} else {
-		messages, err = v1.DB.GetMessages(r.GuildId, r.ChannelId)
-		if err != nil && err != sql.ErrNoRows {
+		messages = reverseMessages(after)
+		reachedBottom = len(after) < limit
+	case r.AroundMessage != 0:
+		beforeLimit := (limit - 1) / 2
+		afterLimit := limit - 1 - beforeLimit
+		after, err := v1.DB.GetMessagesAfter(r.GuildId, r.ChannelId, r.AroundMessage, int32(afterLimit))
+		if err != nil {
+			return nil, err
+		}
+		before, err := v1.DB.GetMessagesBefore(r.GuildId, r.ChannelId, r.AroundMessage, int32(beforeLimit))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(append(reverseMessages(after), cursor), before...)
+		reachedTop = len(before) < beforeLimit
+		reachedBottom = len(after) < afterLimit
+	default:
+		latest, err := v1.DB.GetMessages(r.GuildId, r.ChannelId, int32(limit))
+		if err != nil {
 			return nil, err
 		}
+		messages = latest
+		reachedTop = len(latest) < limit
+		reachedBottom = true
+	}
+
+	msgs, err := v1.MessagesToProto(messages)
+	if err != nil {
+		return nil, err
 	}
 	return &chatv1.GetChannelMessagesResponse{
-		ReachedTop: len(messages) < v1.Config.Server.Policies.APIs.Messages.MaximumGetAmount,
-		Messages: func() (ret []*harmonytypesv1.Message) {
-			for _, message := range messages {
-				createdAt, _ := ptypes.TimestampProto(message.CreatedAt.UTC())
-				var editedAt *timestamppb.Timestamp
-				if message.EditedAt.Valid {
-					editedAt, _ = ptypes.TimestampProto(editedAt.AsTime().UTC())
-				}
-				var embeds []*harmonytypesv1.Embed
-				var actions []*harmonytypesv1.Action
-				var overrides *harmonytypesv1.Override
-				attachments := []*harmonytypesv1.Attachment{}
-				if err := json.Unmarshal(message.Embeds, &embeds); err != nil {
-					continue
-				}
-				if err := json.Unmarshal(message.Actions, &actions); err != nil {
-					continue
-				}
-				if len(message.Overrides) > 0 {
-					overrides = new(harmonytypesv1.Override)
-					if err := proto.Unmarshal(message.Overrides, overrides); err != nil {
-						continue
-					}
-				}
-				for _, a := range message.Attachments {
-					contentType, fileName, size, err := v1.StorageBackend.GetMetadata(a)
-					if err == nil {
-						attachments = append(attachments, &harmonytypesv1.Attachment{
-							Id:   a,
-							Name: fileName,
-							Type: contentType,
-							Size: size,
-						})
-					}
-				}
-				ret = append(ret, &harmonytypesv1.Message{
-					GuildId:     message.GuildID,
-					ChannelId:   message.ChannelID,
-					MessageId:   message.MessageID,
-					AuthorId:    message.UserID,
-					CreatedAt:   createdAt,
-					EditedAt:    editedAt,
-					Content:     message.Content,
-					Attachments: attachments,
-					Embeds:      embeds,
-					Actions:     actions,
-					Overrides:   overrides,
-					InReplyTo:   uint64(message.ReplyToID.Int64),
-				})
-			}
-			return
-		}(),
+		ReachedTop:    reachedTop,
+		ReachedBottom: reachedBottom,
+		Messages:      msgs,
 	}, nil
 }
 
+// cursorMessage gets a message used for paginating, making sure it's in
+// the channel itself and not in one of its threads, deleted messages are
+// treated as missing since the cursor is returned along with the page
+func (v1 *V1) cursorMessage(guildID, channelID, messageID uint64) (queries.Message, error) {
+	message, err := v1.DB.GetMessage(messageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return message, status.Error(codes.NotFound, responses.BadLocationMessage)
+	}
+	if err != nil {
+		return message, err
+	}
+	if message.GuildID != guildID || message.ChannelID != channelID || message.ThreadID.Valid || message.DeletedAt.Valid {
+		return message, status.Error(codes.NotFound, responses.BadLocationMessage)
+	}
+	return message, nil
+}
+
+func reverseMessages(messages []queries.Message) []queries.Message {
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	return messages
+}
+
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
 			Duration: 5 * time.Second,
-			Burst:    2,
+			Burst:    5,
 		},
-		Auth:       true,
-		Location:   middleware.GuildLocation | middleware.JoinedLocation,
-		Permission: "guild.manage.change-name",
-	}, "/protocol.chat.v1.ChatService/UpdateGuildName")
+		Auth:     true,
+		Location: middleware.GuildLocation | middleware.JoinedLocation,
+	}, "/protocol.chat.v1.ChatService/SearchMessages")
 }
 
-// UpdateGuildName implements the UpdateGuildName RPC
-func (v1 *V1) UpdateGuildName(c context.Context, r *chatv1.UpdateGuildNameRequest) (*empty.Empty, error) {
-	if err := v1.DB.UpdateGuildName(r.GuildId, r.NewGuildName); err != nil {
+// SearchMessages implements the SearchMessages RPC
+func (v1 *V1) SearchMessages(c context.Context, r *chatv1.SearchMessagesRequest) (*chatv1.SearchMessagesResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	channels, err := v1.DB.ChannelsForGuild(r.GuildId)
+	if err != nil {
 		return nil, err
 	}
-	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
-		Event: &chatv1.Event_EditedGuild{
-			EditedGuild: &chatv1.Event_GuildUpdated{
-				GuildId:    r.GuildId,
-				Name:       r.NewGuildName,
-				UpdateName: true,
-			},
-		},
+	visible := []int64{}
+	found := r.ChannelId == 0
+	for _, channel := range channels {
+		if channel.Category || (r.ChannelId != 0 && channel.ChannelID != r.ChannelId) {
+			continue
+		}
+		found = true
+		if v1.Perms.CanView(r.GuildId, channel.ChannelID, ctx.UserID) {
+			visible = append(visible, int64(channel.ChannelID))
+		}
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, responses.BadLocationChannel)
+	}
+	if r.ChannelId != 0 && len(visible) == 0 {
+		return nil, status.Error(codes.PermissionDenied, responses.InsufficientPrivileges)
+	}
+
+	maximum := v1.Config.Server.Policies.APIs.Messages.MaximumGetAmount
+	limit := int(r.Limit)
+	if limit == 0 || limit > maximum {
+		limit = maximum
+	}
+	after := time.Unix(0, 0).UTC()
+	if r.After != nil {
+		after = r.After.AsTime()
+	}
+	before := time.Now().UTC()
+	if r.Before != nil {
+		before = r.Before.AsTime()
+	}
+	if len(visible) == 0 {
+		return &chatv1.SearchMessagesResponse{ReachedEnd: true}, nil
+	}
+
+	messages, err := v1.DB.SearchMessages(queries.SearchMessagesParams{
+		Guildid:       r.GuildId,
+		Channels:      visible,
+		Query:         r.Query,
+		Authorid:      int64(r.AuthorId),
+		After:         after,
+		Before:        before,
+		Hasattachment: r.HasAttachment,
+		Replyto:       int64(r.InReplyTo),
+		Beforemessage: int64(r.BeforeMessage),
+		Max:           int32(limit),
 	})
-	return &empty.Empty{}, nil
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	msgs, err := v1.MessagesToProto(messages)
+	if err != nil {
+		return nil, err
+	}
+	return &chatv1.SearchMessagesResponse{
+		Messages:   msgs,
+		ReachedEnd: len(messages) < limit,
+	}, nil
 }
 
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
 			Duration: 5 * time.Second,
-			Burst:    2,
+			Burst:    5,
+		},
+		Auth: true,
+	}, "/protocol.chat.v1.ChatService/GetMentions")
+}
+
+// GetMentions implements the GetMentions RPC
+func (v1 *V1) GetMentions(c context.Context, r *chatv1.GetMentionsRequest) (*chatv1.GetMentionsResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	maximum := v1.Config.Server.Policies.APIs.Messages.MaximumGetAmount
+	limit := int(r.Limit)
+	if limit == 0 || limit > maximum {
+		limit = maximum
+	}
+	messages, err := v1.DB.GetMentions(ctx.UserID, r.BeforeMessage, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+	visible := []queries.Message{}
+	for _, message := range messages {
+		if v1.Perms.CanView(message.GuildID, message.ChannelID, ctx.UserID) {
+			visible = append(visible, message)
+		}
+	}
+	msgs, err := v1.MessagesToProto(visible)
+	if err != nil {
+		return nil, err
+	}
+	return &chatv1.GetMentionsResponse{
+		Messages:   msgs,
+		ReachedEnd: len(messages) < limit,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    10,
 		},
 		Auth:       true,
 		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
-		Permission: "channels.manage.change-name",
-	}, "/protocol.chat.v1.ChatService/UpdateChannelName")
+		Permission: "messages.view",
+	}, "/protocol.chat.v1.ChatService/GetThreadMessages")
 }
 
-// UpdateChannelName implements the UpdateChannelName RPC
-func (v1 *V1) UpdateChannelName(c context.Context, r *chatv1.UpdateChannelNameRequest) (*empty.Empty, error) {
-	if err := v1.DB.SetChannelName(r.GuildId, r.ChannelId, r.NewChannelName); err != nil {
+// GetThreadMessages implements the GetThreadMessages RPC
+func (v1 *V1) GetThreadMessages(c context.Context, r *chatv1.GetThreadMessagesRequest) (*chatv1.GetThreadMessagesResponse, error) {
+	thread, err := v1.DB.GetThread(r.ThreadId)
+	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
-	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
-		Event: &chatv1.Event_EditedChannel{
-			EditedChannel: &chatv1.Event_ChannelUpdated{
-				GuildId:    r.GuildId,
-				ChannelId:  r.ChannelId,
-				Name:       r.NewChannelName,
-				UpdateName: true,
-			},
-		},
-	})
-	return &emptypb.Empty{}, nil
+	if err == sql.ErrNoRows || thread.GuildID != r.GuildId || thread.ChannelID != r.ChannelId {
+		return nil, status.Error(codes.NotFound, responses.ThreadNotFound)
+	}
+	maximum := v1.Config.Server.Policies.APIs.Messages.MaximumGetAmount
+	limit := int(r.Limit)
+	if limit == 0 || limit > maximum {
+		limit = maximum
+	}
+	messages, err := v1.DB.GetThreadMessages(r.ThreadId, r.BeforeMessage, int32(limit))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	msgs, err := v1.MessagesToProto(messages)
+	if err != nil {
+		return nil, err
+	}
+	return &chatv1.GetThreadMessagesResponse{
+		ReachedTop: len(messages) < limit,
+		Messages:   msgs,
+	}, nil
 }
 
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
 			Duration: 5 * time.Second,
-			Burst:    2,
+			Burst:    10,
 		},
 		Auth:       true,
 		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
-		Permission: "channels.manage.move",
-	}, "/protocol.chat.v1.ChatService/UpdateChannelOrder")
+		Permission: "messages.view",
+	}, "/protocol.chat.v1.ChatService/GetActiveThreads")
 }
 
-// UpdateChannelOrder implements the UpdateChannelOrder RPC
-func (v1 *V1) UpdateChannelOrder(c context.Context, r *chatv1.UpdateChannelOrderRequest) (*empty.Empty, error) {
-	if err := v1.DB.MoveChannel(r.GuildId, r.ChannelId, r.PreviousId, r.NextId); err != nil {
+// GetActiveThreads implements the GetActiveThreads RPC
+func (v1 *V1) GetActiveThreads(c context.Context, r *chatv1.GetActiveThreadsRequest) (*chatv1.GetActiveThreadsResponse, error) {
+	threads, err := v1.DB.GetActiveThreads(r.ChannelId)
+	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
-	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
-		Event: &chatv1.Event_EditedChannel{
-			EditedChannel: &chatv1.Event_ChannelUpdated{
-				GuildId:     r.GuildId,
-				ChannelId:   r.ChannelId,
-				PreviousId:  r.PreviousId,
-				NextId:      r.NextId,
-				UpdateOrder: true,
-			},
-		},
-	})
-	return &emptypb.Empty{}, nil
+	ret := &chatv1.GetActiveThreadsResponse{}
+	for _, thread := range threads {
+		ret.Threads = append(ret.Threads, v1.ThreadToProto(thread))
+	}
+	return ret, nil
 }
 
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
 			Duration: 5 * time.Second,
-			Burst:    2,
+			Burst:    10,
 		},
 		Auth:       true,
-		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.AuthorLocation,
-		Permission: "messages.send",
-	}, "/protocol.chat.v1.ChatService/UpdateMessage")
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "messages.view",
+	}, "/protocol.chat.v1.ChatService/GetPinnedMessages")
 }
 
-// UpdateMessage implements the UpdateMessage RPC
-func (v1 *V1) UpdateMessage(c context.Context, r *chatv1.UpdateMessageRequest) (*empty.Empty, error) {
-	ctx := c.(middleware.HarmonyContext)
-	if !r.UpdateActions && !r.UpdateEmbeds && !r.UpdateContent && !r.UpdateOverrides {
-		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+// GetPinnedMessages implements the GetPinnedMessages RPC
+func (v1 *V1) GetPinnedMessages(c context.Context, r *chatv1.GetPinnedMessagesRequest) (*chatv1.GetPinnedMessagesResponse, error) {
+	messages, err := v1.DB.GetPinnedMessages(r.ChannelId)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
 	}
-
-	owner, err := v1.DB.GetMessageOwner(r.MessageId)
+	msgs, err := v1.MessagesToProto(messages)
 	if err != nil {
 		return nil, err
 	}
-	if owner != ctx.UserID {
-		return nil, ErrNoPermissions
-	}
+	return &chatv1.GetPinnedMessagesResponse{
+		Messages: msgs,
+	}, nil
+}
 
-	var actions *[]byte
-	var embeds *[]byte
-	var overrides *[]byte
-	var attachments *[]string
-	attachmentsData := []*harmonytypesv1.Attachment{}
-	if r.UpdateActions {
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.JoinedLocation,
+		Permission: "messages.history.view",
+	}, "/protocol.chat.v1.ChatService/GetMessageHistory")
+}
+
+// GetMessageHistory implements the GetMessageHistory RPC
+func (v1 *V1) GetMessageHistory(c context.Context, r *chatv1.GetMessageHistoryRequest) (*chatv1.GetMessageHistoryResponse, error) {
+	revisions, err := v1.DB.GetMessageRevisions(r.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	ret := []*chatv1.MessageRevision{}
+	for _, revision := range revisions {
+		rev, err := v1.RevisionToProto(revision)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, rev)
+	}
+	return &chatv1.GetMessageHistoryResponse{
+		Revisions: ret,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    2,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.JoinedLocation,
+		Permission: "guild.manage.change-name",
+	}, "/protocol.chat.v1.ChatService/UpdateGuildName")
+}
+
+// UpdateGuildName implements the UpdateGuildName RPC
+func (v1 *V1) UpdateGuildName(c context.Context, r *chatv1.UpdateGuildNameRequest) (*empty.Empty, error) {
+	if err := v1.DB.UpdateGuildName(r.GuildId, r.NewGuildName); err != nil {
+		return nil, err
+	}
+	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_EditedGuild{
+			EditedGuild: &chatv1.Event_GuildUpdated{
+				GuildId:    r.GuildId,
+				Name:       r.NewGuildName,
+				UpdateName: true,
+			},
+		},
+	})
+	return &empty.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    2,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.JoinedLocation,
+		Permission: "guild.manage.retention",
+	}, "/protocol.chat.v1.ChatService/SetGuildRetention")
+}
+
+// SetGuildRetention implements the SetGuildRetention RPC
+func (v1 *V1) SetGuildRetention(c context.Context, r *chatv1.SetGuildRetentionRequest) (*empty.Empty, error) {
+	if r.MaxAge > math.MaxInt64 {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	if err := v1.DB.SetGuildRetention(r.GuildId, int64(r.MaxAge)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "channels.manage.retention",
+	}, "/protocol.chat.v1.ChatService/SetChannelRetention")
+}
+
+// SetChannelRetention implements the SetChannelRetention RPC
+func (v1 *V1) SetChannelRetention(c context.Context, r *chatv1.SetChannelRetentionRequest) (*empty.Empty, error) {
+	if r.Inherit {
+		if err := v1.DB.ClearChannelRetention(r.ChannelId); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+	if r.MaxAge > math.MaxInt64 {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	if err := v1.DB.SetChannelRetention(r.GuildId, r.ChannelId, int64(r.MaxAge)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    10,
+		},
+		Auth:     true,
+		Location: middleware.GuildLocation | middleware.JoinedLocation,
+	}, "/protocol.chat.v1.ChatService/GetRetention")
+}
+
+// GetRetention implements the GetRetention RPC
+func (v1 *V1) GetRetention(c context.Context, r *chatv1.GetRetentionRequest) (*chatv1.GetRetentionResponse, error) {
+	maxAge, channels, err := v1.DB.GetRetention(r.GuildId)
+	if err != nil {
+		return nil, err
+	}
+	res := &chatv1.GetRetentionResponse{
+		MaxAge: uint64(maxAge),
+	}
+	for _, channel := range channels {
+		res.Channels = append(res.Channels, &chatv1.GetRetentionResponse_ChannelRetention{
+			ChannelId: channel.ChannelID,
+			MaxAge:    uint64(channel.MaxAge),
+		})
+	}
+	return res, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    2,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "channels.manage.change-name",
+	}, "/protocol.chat.v1.ChatService/UpdateChannelName")
+}
+
+// UpdateChannelName implements the UpdateChannelName RPC
+func (v1 *V1) UpdateChannelName(c context.Context, r *chatv1.UpdateChannelNameRequest) (*empty.Empty, error) {
+	if err := v1.DB.SetChannelName(r.GuildId, r.ChannelId, r.NewChannelName); err != nil {
+		return nil, err
+	}
+	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_EditedChannel{
+			EditedChannel: &chatv1.Event_ChannelUpdated{
+				GuildId:    r.GuildId,
+				ChannelId:  r.ChannelId,
+				Name:       r.NewChannelName,
+				UpdateName: true,
+			},
+		},
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    2,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "channels.manage.slow-mode",
+	}, "/protocol.chat.v1.ChatService/SetChannelSlowMode")
+}
+
+// SetChannelSlowMode implements the SetChannelSlowMode RPC
+func (v1 *V1) SetChannelSlowMode(c context.Context, r *chatv1.SetChannelSlowModeRequest) (*empty.Empty, error) {
+	if time.Duration(r.Interval)*time.Second > v1.Config.Server.Policies.SlowMode.MaximumInterval {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	if ok, err := v1.DB.SetChannelSlowMode(r.GuildId, r.ChannelId, int32(r.Interval)); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, status.Error(codes.NotFound, responses.BadLocationChannel)
+	}
+	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_EditedChannel{
+			EditedChannel: &chatv1.Event_ChannelUpdated{
+				GuildId:        r.GuildId,
+				ChannelId:      r.ChannelId,
+				SlowMode:       r.Interval,
+				UpdateSlowMode: true,
+			},
+		},
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    2,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "channels.manage.move",
+	}, "/protocol.chat.v1.ChatService/UpdateChannelOrder")
+}
+
+// UpdateChannelOrder implements the UpdateChannelOrder RPC
+func (v1 *V1) UpdateChannelOrder(c context.Context, r *chatv1.UpdateChannelOrderRequest) (*empty.Empty, error) {
+	if err := v1.DB.MoveChannel(r.GuildId, r.ChannelId, r.PreviousId, r.NextId); err != nil {
+		return nil, err
+	}
+	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_EditedChannel{
+			EditedChannel: &chatv1.Event_ChannelUpdated{
+				GuildId:     r.GuildId,
+				ChannelId:   r.ChannelId,
+				PreviousId:  r.PreviousId,
+				NextId:      r.NextId,
+				UpdateOrder: true,
+			},
+		},
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    2,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.AuthorLocation,
+		Permission: "messages.send",
+	}, "/protocol.chat.v1.ChatService/UpdateMessage")
+}
+
+// UpdateMessage implements the UpdateMessage RPC
+func (v1 *V1) UpdateMessage(c context.Context, r *chatv1.UpdateMessageRequest) (*empty.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	if !r.UpdateActions && !r.UpdateEmbeds && !r.UpdateContent && !r.UpdateOverrides {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+
+	message, err := v1.DB.GetMessage(r.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	if message.UserID != ctx.UserID {
+		return nil, ErrNoPermissions
+	}
+	if err := v1.checkTimeout(ctx, r.GuildId); err != nil {
+		return nil, err
+	}
+
+	var content *string
+	var actions *[]byte
+	var embeds *[]byte
+	var overrides *[]byte
+	var attachments *[]string
+	attachmentsData := []*harmonytypesv1.Attachment{}
+	attachmentTypes := []string{}
+	if r.UpdateContent {
+		content = &r.Content
+	}
+	if r.UpdateActions {
 		val := v1.ProtoToActions(r.Actions)
 		actions = &val
 	}
@@ -616,10 +1109,45 @@ func (v1 *V1) UpdateMessage(c context.Context, r *chatv1.UpdateMessageRequest) (
 					Type: contentType,
 					Size: size,
 				})
+				attachmentTypes = append(attachmentTypes, contentType)
+			}
+		}
+	}
+	moderated := &harmonytypesv1.Message{
+		GuildId:   r.GuildId,
+		ChannelId: r.ChannelId,
+		MessageId: r.MessageId,
+		AuthorId:  ctx.UserID,
+		Content:   message.Content,
+	}
+	// automod only looks at content and attachments, an edit touching
+	// neither isn't moderated again. The edited message is moderated as a
+	// whole, with the parts the edit leaves alone as they're stored.
+	var verdict automod.Verdict
+	if r.UpdateContent || r.UpdateAttachments {
+		if r.UpdateContent {
+			moderated.Content = r.Content
+		}
+		if !r.UpdateAttachments {
+			for _, a := range message.Attachments {
+				if contentType, _, _, err := v1.StorageBackend.GetMetadata(a); err == nil {
+					attachmentTypes = append(attachmentTypes, contentType)
+				}
 			}
 		}
+		verdict, err = v1.moderate(ctx, r.GuildId, automod.Message{
+			Content:         moderated.Content,
+			AttachmentTypes: attachmentTypes,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
-	tiempo, err := v1.DB.UpdateMessage(r.MessageId, &r.Content, embeds, actions, overrides, attachments)
+	if verdict.Block {
+		v1.enforce(verdict, moderated, uint64(message.ThreadID.Int64))
+		return nil, status.Error(codes.PermissionDenied, responses.AutomodBlocked)
+	}
+	tiempo, err := v1.DB.UpdateMessage(r.MessageId, content, embeds, actions, overrides, attachments)
 	if err != nil {
 		return nil, err
 	}
@@ -639,9 +1167,29 @@ func (v1 *V1) UpdateMessage(c context.Context, r *chatv1.UpdateMessageRequest) (
 				Overrides:     r.Overrides,
 				EditedAt:      editedAt,
 				Attachments:   attachmentsData,
+				ThreadId:      uint64(message.ThreadID.Int64),
 			},
 		},
 	})
+	if verdict.Broken() {
+		v1.enforce(verdict, moderated, uint64(message.ThreadID.Int64))
+		if verdict.Delete {
+			return &emptypb.Empty{}, nil
+		}
+	}
+	if r.UpdateContent {
+		edited, err := v1.DB.GetMessage(r.MessageId)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := v1.MessageToProto(edited)
+		if err != nil {
+			return nil, err
+		}
+		if err := v1.handleMentions(ctx, msg, true); err != nil {
+			v1.Logger.CheckException(err)
+		}
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -736,19 +1284,23 @@ func init() {
 // DeleteMessage implements the DeleteMessage RPC
 func (v1 *V1) DeleteMessage(c context.Context, r *chatv1.DeleteMessageRequest) (*empty.Empty, error) {
 	ctx := c.(middleware.HarmonyContext)
-	owner, err := v1.DB.GetMessageOwner(r.MessageId)
+	message, err := v1.DB.GetMessage(r.MessageId)
 	if err != nil {
 		return nil, err
 	}
-	if ctx.UserID != owner && !(ctx.IsOwner || v1.Perms.Check("messages.manage.delete", ctx.UserRoles, r.GuildId, r.ChannelId)) {
+	if ctx.UserID != message.UserID && !(ctx.IsOwner || v1.Perms.Check("messages.manage.delete", ctx.UserRoles, r.GuildId, r.ChannelId)) {
 		return nil, ErrNoPermissions
 	}
+	if err := v1.DB.DeleteMessage(r.MessageId, r.ChannelId, r.GuildId, ctx.UserID); err != nil {
+		return nil, err
+	}
 	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
 		Event: &chatv1.Event_DeletedMessage{
 			DeletedMessage: &chatv1.Event_MessageDeleted{
 				GuildId:   r.GuildId,
 				ChannelId: r.ChannelId,
 				MessageId: r.MessageId,
+				ThreadId:  uint64(message.ThreadID.Int64),
 			},
 		},
 	})
@@ -761,18 +1313,418 @@ func init() {
 			Duration: 5 * time.Second,
 			Burst:    5,
 		},
-		Auth:     true,
-		Location: middleware.NoLocation,
-	}, "/protocol.chat.v1.ChatService/JoinGuild")
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "messages.manage.restore",
+	}, "/protocol.chat.v1.ChatService/RestoreMessage")
 }
 
-// JoinGuild implements the JoinGuild RPC
-func (v1 *V1) JoinGuild(c context.Context, r *chatv1.JoinGuildRequest) (*chatv1.JoinGuildResponse, error) {
-	ctx := c.(middleware.HarmonyContext)
-	guildID, err := v1.DB.ResolveGuildID(r.InviteId)
+// RestoreMessage implements the RestoreMessage RPC
+func (v1 *V1) RestoreMessage(c context.Context, r *chatv1.RestoreMessageRequest) (*empty.Empty, error) {
+	restored, err := v1.DB.RestoreMessage(r.MessageId, r.ChannelId, r.GuildId)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, v1.Logger.ErrorResponse(codes.NotFound, err, responses.GuildNotFound)
+		return nil, err
+	}
+	if !restored {
+		return nil, status.Error(codes.NotFound, responses.BadLocationMessage)
+	}
+	message, err := v1.DB.GetMessage(r.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := v1.MessagesToProto([]queries.Message{message})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) > 0 {
+		v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+			Event: &chatv1.Event_MessageRestored_{
+				MessageRestored: &chatv1.Event_MessageRestored{
+					Message: msgs[0],
+				},
+			},
+		})
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "messages.manage.restore",
+	}, "/protocol.chat.v1.ChatService/GetDeletedMessages")
+}
+
+// GetDeletedMessages implements the GetDeletedMessages RPC
+func (v1 *V1) GetDeletedMessages(c context.Context, r *chatv1.GetDeletedMessagesRequest) (*chatv1.GetDeletedMessagesResponse, error) {
+	maximum := v1.Config.Server.Policies.APIs.Messages.MaximumGetAmount
+	limit := int(r.Limit)
+	if limit == 0 || limit > maximum {
+		limit = maximum
+	}
+	messages, err := v1.DB.GetDeletedMessages(r.GuildId, r.ChannelId, r.BeforeMessage, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := v1.MessagesToProto(messages)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[uint64]queries.Message{}
+	for _, message := range messages {
+		byID[message.MessageID] = message
+	}
+	ret := []*chatv1.DeletedMessage{}
+	for _, msg := range msgs {
+		message := byID[msg.MessageId]
+		deletedAt, _ := ptypes.TimestampProto(message.DeletedAt.Time.UTC())
+		ret = append(ret, &chatv1.DeletedMessage{
+			Message:   msg,
+			DeletedBy: uint64(message.DeletedBy.Int64),
+			DeletedAt: deletedAt,
+		})
+	}
+	return &chatv1.GetDeletedMessagesResponse{
+		Messages:   ret,
+		ReachedEnd: len(messages) < limit,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 10 * time.Second,
+			Burst:    2,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "messages.manage.bulk-delete",
+	}, "/protocol.chat.v1.ChatService/BulkDeleteMessages")
+}
+
+// BulkDeleteMessages implements the BulkDeleteMessages RPC
+func (v1 *V1) BulkDeleteMessages(c context.Context, r *chatv1.BulkDeleteMessagesRequest) (*chatv1.BulkDeleteMessagesResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	maximum := v1.Config.Server.Policies.APIs.Messages.MaximumBulkDeleteAmount
+	filtered := r.AuthorId != 0 || r.After != nil || r.Before != nil || r.Last != 0
+	if (len(r.MessageIds) == 0) == !filtered || len(r.MessageIds) > maximum {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	limit := int(r.Last)
+	if limit == 0 || limit > maximum {
+		limit = maximum
+	}
+	after := time.Unix(0, 0).UTC()
+	if r.After != nil {
+		after = r.After.AsTime()
+	}
+	before := time.Now().UTC()
+	if r.Before != nil {
+		before = r.Before.AsTime()
+	}
+	deleted, err := v1.DB.BulkDeleteMessages(r.GuildId, r.ChannelId, ctx.UserID, r.MessageIds, r.AuthorId, after, before, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+	if len(deleted) > 0 {
+		v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+			Event: &chatv1.Event_MessagesBulkDeleted_{
+				MessagesBulkDeleted: &chatv1.Event_MessagesBulkDeleted{
+					GuildId:    r.GuildId,
+					ChannelId:  r.ChannelId,
+					MessageIds: deleted,
+				},
+			},
+		})
+	}
+	return &chatv1.BulkDeleteMessagesResponse{
+		MessageIds: deleted,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    20,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.JoinedLocation,
+		Permission: "messages.view",
+	}, "/protocol.chat.v1.ChatService/AckChannel")
+}
+
+// AckChannel implements the AckChannel RPC
+func (v1 *V1) AckChannel(c context.Context, r *chatv1.AckChannelRequest) (*empty.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	if err := v1.DB.AckChannel(ctx.UserID, r.GuildId, r.ChannelId, r.MessageId); err != nil {
+		return nil, err
+	}
+	v1.PubSub.Homeserver.Broadcast(ctx.UserID, &chatv1.Event{
+		Event: &chatv1.Event_ChannelAcked_{
+			ChannelAcked: &chatv1.Event_ChannelAcked{
+				GuildId:   r.GuildId,
+				ChannelId: r.ChannelId,
+				MessageId: r.MessageId,
+			},
+		},
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:     true,
+		Location: middleware.GuildLocation | middleware.JoinedLocation,
+	}, "/protocol.chat.v1.ChatService/GetReadStates")
+}
+
+// GetReadStates implements the GetReadStates RPC
+func (v1 *V1) GetReadStates(c context.Context, r *chatv1.GetReadStatesRequest) (*chatv1.GetReadStatesResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	states, err := v1.DB.GetReadStates(r.GuildId, ctx.UserID)
+	if err != nil {
+		return nil, err
+	}
+	ret := []*chatv1.ChannelReadState{}
+	for _, state := range states {
+		if !v1.Perms.CanView(r.GuildId, state.ChannelID, ctx.UserID) {
+			continue
+		}
+		ret = append(ret, &chatv1.ChannelReadState{
+			ChannelId:    state.ChannelID,
+			LastReadId:   uint64(state.LastReadID),
+			UnreadCount:  uint64(state.UnreadCount),
+			MentionCount: uint64(state.MentionCount),
+		})
+	}
+	return &chatv1.GetReadStatesResponse{
+		ReadStates: ret,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.JoinedLocation,
+		Permission: "messages.pins.manage",
+	}, "/protocol.chat.v1.ChatService/PinMessage")
+}
+
+// PinMessage implements the PinMessage RPC
+func (v1 *V1) PinMessage(c context.Context, r *chatv1.PinMessageRequest) (*empty.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	pinned, err := v1.DB.PinMessage(r.ChannelId, r.MessageId, ctx.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if pinned {
+		v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+			Event: &chatv1.Event_MessagePinned_{
+				MessagePinned: &chatv1.Event_MessagePinned{
+					GuildId:   r.GuildId,
+					ChannelId: r.ChannelId,
+					MessageId: r.MessageId,
+					PinnedBy:  ctx.UserID,
+				},
+			},
+		})
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.JoinedLocation,
+		Permission: "messages.pins.manage",
+	}, "/protocol.chat.v1.ChatService/UnpinMessage")
+}
+
+// UnpinMessage implements the UnpinMessage RPC
+func (v1 *V1) UnpinMessage(c context.Context, r *chatv1.UnpinMessageRequest) (*empty.Empty, error) {
+	unpinned, err := v1.DB.UnpinMessage(r.ChannelId, r.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	if unpinned {
+		v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+			Event: &chatv1.Event_MessageUnpinned_{
+				MessageUnpinned: &chatv1.Event_MessageUnpinned{
+					GuildId:   r.GuildId,
+					ChannelId: r.ChannelId,
+					MessageId: r.MessageId,
+				},
+			},
+		})
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// maxEmojiLength is long enough for emoji ZWJ sequences like family emoji
+const maxEmojiLength = 32
+
+// validReaction checks that exactly one of an emoji and an emote is given,
+// and that the emoji doesn't contain any text
+func validReaction(emoji, emote string) bool {
+	if (emoji == "") == (emote == "") {
+		return false
+	}
+	if emote != "" {
+		return true
+	}
+	if len(emoji) > maxEmojiLength || !utf8.ValidString(emoji) {
+		return false
+	}
+	for _, r := range emoji {
+		if unicode.IsLetter(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v1 *V1) reactionEvent(guildID, channelID, messageID, userID uint64, emoji, emote string, added bool) error {
+	count, err := v1.DB.CountReaction(messageID, emoji, emote)
+	if err != nil {
+		return err
+	}
+	reaction := &harmonytypesv1.Reaction{
+		Emoji: emoji,
+		Emote: emote,
+		Count: uint32(count),
+	}
+	if added {
+		v1.PubSub.Guild.Broadcast(guildID, &chatv1.Event{
+			Event: &chatv1.Event_ReactionAdded_{
+				ReactionAdded: &chatv1.Event_ReactionAdded{
+					GuildId:   guildID,
+					ChannelId: channelID,
+					MessageId: messageID,
+					UserId:    userID,
+					Reaction:  reaction,
+				},
+			},
+		})
+	} else {
+		v1.PubSub.Guild.Broadcast(guildID, &chatv1.Event{
+			Event: &chatv1.Event_ReactionRemoved_{
+				ReactionRemoved: &chatv1.Event_ReactionRemoved{
+					GuildId:   guildID,
+					ChannelId: channelID,
+					MessageId: messageID,
+					UserId:    userID,
+					Reaction:  reaction,
+				},
+			},
+		})
+	}
+	return nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 3 * time.Second,
+			Burst:    10,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.JoinedLocation,
+		Permission: "messages.reactions.add",
+	}, "/protocol.chat.v1.ChatService/AddReaction")
+}
+
+// AddReaction implements the AddReaction RPC
+func (v1 *V1) AddReaction(c context.Context, r *chatv1.AddReactionRequest) (*empty.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	if !validReaction(r.Emoji, r.Emote) {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidReaction)
+	}
+	if r.Emote != "" {
+		ok, err := v1.DB.HasEquippedEmote(ctx.UserID, r.Emote)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, responses.InvalidReaction)
+		}
+	}
+	added, err := v1.DB.AddReaction(r.MessageId, ctx.UserID, r.Emoji, r.Emote)
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		if err := v1.reactionEvent(r.GuildId, r.ChannelId, r.MessageId, ctx.UserID, r.Emoji, r.Emote, true); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 3 * time.Second,
+			Burst:    10,
+		},
+		Auth:     true,
+		Location: middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.JoinedLocation,
+	}, "/protocol.chat.v1.ChatService/RemoveReaction")
+}
+
+// RemoveReaction implements the RemoveReaction RPC
+func (v1 *V1) RemoveReaction(c context.Context, r *chatv1.RemoveReactionRequest) (*empty.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	if !validReaction(r.Emoji, r.Emote) {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidReaction)
+	}
+	removed, err := v1.DB.RemoveReaction(r.MessageId, ctx.UserID, r.Emoji, r.Emote)
+	if err != nil {
+		return nil, err
+	}
+	if removed {
+		if err := v1.reactionEvent(r.GuildId, r.ChannelId, r.MessageId, ctx.UserID, r.Emoji, r.Emote, false); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:     true,
+		Location: middleware.NoLocation,
+	}, "/protocol.chat.v1.ChatService/JoinGuild")
+}
+
+// JoinGuild implements the JoinGuild RPC
+func (v1 *V1) JoinGuild(c context.Context, r *chatv1.JoinGuildRequest) (*chatv1.JoinGuildResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	guildID, err := v1.DB.ResolveGuildID(r.InviteId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, v1.Logger.ErrorResponse(codes.NotFound, err, responses.GuildNotFound)
 		}
 		return nil, err
 	}
@@ -802,27 +1754,747 @@ func init() {
 			Burst:    5,
 		},
 		Auth:     true,
-		Location: middleware.GuildLocation,
-	}, "/protocol.chat.v1.ChatService/LeaveGuild")
+		Location: middleware.GuildLocation,
+	}, "/protocol.chat.v1.ChatService/LeaveGuild")
+}
+
+// LeaveGuild implements the LeaveGuild RPC
+func (v1 *V1) LeaveGuild(c context.Context, r *chatv1.LeaveGuildRequest) (*empty.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	if isOwner, err := v1.DB.IsOwner(r.GuildId, ctx.UserID); err != nil {
+		return nil, err
+	} else if isOwner {
+		return nil, status.Error(codes.FailedPrecondition, responses.InvalidRequest)
+	}
+	v1.PubSub.Guild.UnsubscribeUserFromGuild(ctx.UserID, r.GuildId)
+	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_LeftMember{
+			LeftMember: &chatv1.Event_MemberLeft{
+				MemberId: ctx.UserID,
+				GuildId:  r.GuildId,
+			},
+		},
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:     true,
+		Location: middleware.GuildLocation,
+	}, "/protocol.chat.v1.ChatService/StreamEvents")
+}
+
+// StreamEvents implements the StreamEvents RPC
+func (v1 *V1) StreamEvents(s chatv1.ChatService_StreamEventsServer) error {
+	userID, err := middleware.AuthHandler(v1.DB, s.Context())
+	if err != nil {
+		return err
+	}
+	v1.Presence.Connect(userID)
+	defer v1.Presence.Disconnect(userID)
+	stream := newEventStream(s)
+	defer stream.finish()
+	stream.spawn(func() {
+		v1.heartbeat(stream)
+	})
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- v1.handleStreamRequests(userID, stream)
+	}()
+	select {
+	case err := <-errChan:
+		return err
+	case <-stream.ctx.Done():
+		if s.Context().Err() != nil {
+			return s.Context().Err()
+		}
+		return stream.closeErr()
+	}
+}
+
+// heartbeat periodically checks that the client on the other end of a
+// stream is still there
+func (v1 *V1) heartbeat(s *eventStream) {
+	ticker := time.NewTicker(v1.Config.Server.Policies.Heartbeat.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if s.unacked() >= v1.Config.Server.Policies.Heartbeat.MaxMissed {
+				s.closeWith(status.Error(codes.DeadlineExceeded, responses.HeartbeatMissed))
+				return
+			}
+			s.sentHeartbeat()
+			if err := s.Send(&chatv1.Event{
+				Event: &chatv1.Event_Heartbeat_{
+					Heartbeat: &chatv1.Event_Heartbeat{},
+				},
+			}); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (v1 *V1) handleStreamRequests(userID uint64, s *eventStream) error {
+	for {
+		in, err := s.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := v1.handleStreamRequest(userID, s, in); err != nil {
+			if err := s.Send(&chatv1.Event{
+				Event: &chatv1.Event_RequestFailed_{
+					RequestFailed: &chatv1.Event_RequestFailed{
+						Request: in,
+						Error:   status.Convert(err).Message(),
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (v1 *V1) handleStreamRequest(userID uint64, s *eventStream, in *chatv1.StreamEventsRequest) error {
+	switch x := in.Request.(type) {
+	case *chatv1.StreamEventsRequest_SubscribeToGuild_:
+		if err := middleware.LocationHandler(v1.DB, x.SubscribeToGuild, "/protocol.chat.v1.ChatService/StreamGuildEvents", userID); err != nil {
+			return err
+		}
+		ok, err := v1.DB.UserInGuild(userID, x.SubscribeToGuild.GuildId)
+		if err != nil {
+			return v1.Logger.ErrorResponse(codes.Internal, err, responses.InternalServerError)
+		}
+		if !ok {
+			return status.Error(codes.PermissionDenied, responses.NotInGuild)
+		}
+		v1.PubSub.Guild.Subscribe(x.SubscribeToGuild.GuildId, userID, s, x.SubscribeToGuild.ResumeAfter, x.SubscribeToGuild.ChannelIds, x.SubscribeToGuild.ThreadIds)
+	case *chatv1.StreamEventsRequest_UpdateGuildChannels_:
+		if !v1.PubSub.Guild.UpdateChannels(x.UpdateGuildChannels.GuildId, s, x.UpdateGuildChannels.ChannelIds) {
+			return status.Error(codes.FailedPrecondition, responses.NotSubscribed)
+		}
+	case *chatv1.StreamEventsRequest_UpdateGuildThreads_:
+		if !v1.PubSub.Guild.UpdateThreads(x.UpdateGuildThreads.GuildId, s, x.UpdateGuildThreads.ThreadIds) {
+			return status.Error(codes.FailedPrecondition, responses.NotSubscribed)
+		}
+	case *chatv1.StreamEventsRequest_UnsubscribeFromGuild_:
+		if !v1.PubSub.Guild.Unsubscribe(x.UnsubscribeFromGuild.GuildId, userID, s) {
+			return status.Error(codes.FailedPrecondition, responses.NotSubscribed)
+		}
+	case *chatv1.StreamEventsRequest_AckHeartbeat_:
+		s.ackHeartbeat()
+	case *chatv1.StreamEventsRequest_SubscribeToActions_:
+		v1.PubSub.Actions.Subscribe(userID, s, x.SubscribeToActions.ResumeAfter)
+	case *chatv1.StreamEventsRequest_UnsubscribeFromActions_:
+		if !v1.PubSub.Actions.Unsubscribe(userID, s) {
+			return status.Error(codes.FailedPrecondition, responses.NotSubscribed)
+		}
+	case *chatv1.StreamEventsRequest_SubscribeToHomeserverEvents_:
+		if err := v1.DB.UserIsLocal(userID); err != nil {
+			if err == db.ErrNotLocal {
+				return status.Error(codes.PermissionDenied, responses.NotLocal)
+			}
+			return v1.Logger.ErrorResponse(codes.Internal, err, responses.InternalServerError)
+		}
+		v1.PubSub.Homeserver.Subscribe(userID, s, x.SubscribeToHomeserverEvents.ResumeAfter)
+	case *chatv1.StreamEventsRequest_UnsubscribeFromHomeserverEvents_:
+		if !v1.PubSub.Homeserver.Unsubscribe(userID, s) {
+			return status.Error(codes.FailedPrecondition, responses.NotSubscribed)
+		}
+	default:
+		return status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	return nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    20,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation,
+		Permission: "actions.trigger",
+	}, "/protocol.chat.v1.ChatService/TriggerAction")
+}
+
+func (v1 *V1) TriggerAction(c context.Context, r *chatv1.TriggerActionRequest) (*emptypb.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	msg, err := v1.DB.GetMessage(r.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ChannelID != r.ChannelId || msg.GuildID != r.GuildId {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	for _, action := range v1.ActionsToProto(msg.Actions) {
+		if action.Id == r.ActionId {
+			v1.PubSub.Actions.Broadcast(ctx.UserID, &chatv1.Event{
+				Event: &chatv1.Event_ActionPerformed_{
+					ActionPerformed: &chatv1.Event_ActionPerformed{
+						GuildId:    r.GuildId,
+						ChannelId:  r.ChannelId,
+						MessageId:  r.MessageId,
+						ActionData: r.ActionData,
+						ActionId:   r.ActionId,
+					},
+				},
+			})
+			return &emptypb.Empty{}, nil
+		}
+	}
+	return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+}
+
+// hasPermission checks a permission node on top of the one the middleware
+// checked for the RPC. The middleware skips loading roles for owners, so
+// they are loaded here when missing.
+func (v1 *V1) hasPermission(ctx middleware.HarmonyContext, node string, guildID, channelID uint64) (bool, error) {
+	if ctx.IsOwner {
+		return true, nil
+	}
+	owner, err := v1.DB.GetOwner(guildID)
+	if err != nil {
+		return false, err
+	}
+	if owner == ctx.UserID {
+		return true, nil
+	}
+	roles := ctx.UserRoles
+	if roles == nil {
+		if roles, err = v1.DB.RolesForUser(guildID, ctx.UserID); err != nil {
+			return false, err
+		}
+	}
+	return v1.Perms.Check(node, roles, guildID, channelID), nil
+}
+
+// checkSlowMode starts the slow mode cooldown of the user sending a message,
+// and returns when it started so it can be released if the message isn't
+// sent after all; the time is zero if no cooldown was started. Sending during
+// the cooldown fails with the time left as a RetryInfo.
+func (v1 *V1) checkSlowMode(ctx middleware.HarmonyContext, guildID, channelID uint64) (time.Time, error) {
+	interval, err := v1.DB.GetChannelSlowMode(channelID)
+	if err != nil || interval == 0 {
+		return time.Time{}, err
+	}
+	bypass, err := v1.hasPermission(ctx, "messages.slowmode.bypass", guildID, channelID)
+	if err != nil || bypass {
+		return time.Time{}, err
+	}
+	now := time.Now().UTC()
+	remaining, err := v1.DB.ClaimSlowMode(channelID, ctx.UserID, interval, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if remaining > 0 {
+		return time.Time{}, retryError(codes.ResourceExhausted, responses.SlowModeCooldown, remaining)
+	}
+	return now, nil
+}
+
+// checkTimeout refuses messages from members who are timed out, with the
+// time left as a RetryInfo
+func (v1 *V1) checkTimeout(ctx middleware.HarmonyContext, guildID uint64) error {
+	remaining, err := v1.DB.GetMemberTimeout(guildID, ctx.UserID)
+	if err != nil || remaining == 0 {
+		return err
+	}
+	return retryError(codes.PermissionDenied, responses.TimedOut, remaining)
+}
+
+// retryError is an error telling clients when to try again
+func retryError(code codes.Code, message string, after time.Duration) error {
+	st, err := status.New(code, message).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(after),
+	})
+	if err != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// automodRules gets the automod rules of a guild
+func (v1 *V1) automodRules(guildID uint64) ([]*chatv1.AutomodRule, error) {
+	rows, err := v1.DB.GetAutomodRules(guildID)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]*chatv1.AutomodRule, 0, len(rows))
+	for _, row := range rows {
+		rule := new(chatv1.AutomodRule)
+		if err := proto.Unmarshal(row.Data, rule); err != nil {
+			return nil, err
+		}
+		rule.RuleId = row.RuleID
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// moderate runs the automod rules of a guild against a message. The guild
+// owner is never moderated.
+func (v1 *V1) moderate(ctx middleware.HarmonyContext, guildID uint64, message automod.Message) (automod.Verdict, error) {
+	rules, err := v1.automodRules(guildID)
+	if err != nil || len(rules) == 0 {
+		return automod.Verdict{}, err
+	}
+	owner, err := v1.DB.GetOwner(guildID)
+	if err != nil || owner == ctx.UserID {
+		return automod.Verdict{}, err
+	}
+	roles := ctx.UserRoles
+	if roles == nil {
+		if roles, err = v1.DB.RolesForUser(guildID, ctx.UserID); err != nil {
+			return automod.Verdict{}, err
+		}
+	}
+	return automod.Evaluate(rules, roles, message), nil
+}
+
+// enforce carries out the verdict on a message. Blocked messages were never
+// sent, so they are only reported and punished.
+func (v1 *V1) enforce(verdict automod.Verdict, message *harmonytypesv1.Message, threadID uint64) {
+	if verdict.Delete {
+		if err := v1.DB.DeleteMessage(message.MessageId, message.ChannelId, message.GuildId, systemAuthorID); err != nil {
+			v1.Logger.CheckException(err)
+		} else {
+			v1.PubSub.Guild.Broadcast(message.GuildId, &chatv1.Event{
+				Event: &chatv1.Event_DeletedMessage{
+					DeletedMessage: &chatv1.Event_MessageDeleted{
+						GuildId:   message.GuildId,
+						ChannelId: message.ChannelId,
+						MessageId: message.MessageId,
+						ThreadId:  threadID,
+					},
+				},
+			})
+		}
+	}
+	for _, flag := range verdict.Flags {
+		if err := v1.flagMessage(flag, message, verdict.Block); err != nil {
+			v1.Logger.CheckException(err)
+		}
+	}
+	if verdict.Timeout > 0 {
+		until := time.Now().UTC().Add(verdict.Timeout)
+		if err := v1.DB.TimeoutMember(message.GuildId, message.AuthorId, until); err != nil {
+			v1.Logger.CheckException(err)
+		}
+	}
+}
+
+// systemAuthorID is the author of messages posted by the server itself,
+// like automod reports. No user has it, snowflakes are never 0.
+const systemAuthorID = 0
+
+// flagMessage posts a report of a message in a moderator channel as a
+// system message
+func (v1 *V1) flagMessage(flag automod.Flag, message *harmonytypesv1.Message, blocked bool) error {
+	fields := []*harmonytypesv1.EmbedField{
+		{Title: "Author", Body: fmt.Sprintf("<@%d>", message.AuthorId)},
+		{Title: "Channel", Body: strconv.FormatUint(message.ChannelId, 10)},
+	}
+	if blocked {
+		fields = append(fields, &harmonytypesv1.EmbedField{Title: "Message", Body: "blocked"})
+	} else {
+		fields = append(fields, &harmonytypesv1.EmbedField{Title: "Message", Body: strconv.FormatUint(message.MessageId, 10)})
+	}
+	embeds := []*harmonytypesv1.Embed{{
+		Title:  flag.Rule.Name,
+		Body:   message.Content,
+		Header: &harmonytypesv1.EmbedHeading{Text: "Automod"},
+		Fields: fields,
+	}}
+	overrides := &harmonytypesv1.Override{
+		Name:   "Automod",
+		Reason: &harmonytypesv1.Override_SystemMessage{SystemMessage: &emptypb.Empty{}},
+	}
+	content := fmt.Sprintf("Automod rule %q flagged a message", flag.Rule.Name)
+
+	messageID, err := v1.Sonyflake.NextID()
+	if err != nil {
+		return err
+	}
+	msg, err := v1.DB.AddMessage(
+		flag.ChannelID,
+		message.GuildId,
+		systemAuthorID,
+		messageID,
+		content,
+		nil,
+		v1.ProtoToEmbeds(embeds),
+		v1.ProtoToActions(nil),
+		v1.ProtoToOverrides(overrides),
+		sql.NullInt64{},
+		sql.NullInt64{},
+	)
+	if err != nil {
+		return err
+	}
+	createdAt, _ := ptypes.TimestampProto(msg.CreatedAt.UTC())
+	v1.PubSub.Guild.Broadcast(message.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_SentMessage{
+			SentMessage: &chatv1.Event_MessageSent{
+				Message: &harmonytypesv1.Message{
+					GuildId:   message.GuildId,
+					ChannelId: flag.ChannelID,
+					MessageId: messageID,
+					AuthorId:  systemAuthorID,
+					CreatedAt: createdAt,
+					Content:   content,
+					Embeds:    embeds,
+					Overrides: overrides,
+				},
+			},
+		},
+	})
+	return nil
+}
+
+// handleMentions stores the users a message mentions and notifies the ones
+// it didn't mention before. Users who can't see the channel are left out.
+func (v1 *V1) handleMentions(ctx middleware.HarmonyContext, message *harmonytypesv1.Message, edited bool) error {
+	found := mentions.Parse(message.Content)
+	if found.Empty() && !edited {
+		return nil
+	}
+	var mentioned []uint64
+	if !found.Empty() {
+		anyRole := false
+		if found.Everyone || len(found.Roles) > 0 {
+			var err error
+			if anyRole, err = v1.hasPermission(ctx, "messages.mentions.everyone", message.GuildId, message.ChannelId); err != nil {
+				return err
+			}
+		}
+		resolved, err := v1.DB.ResolveMentions(message.GuildId, found.Users, found.Roles, found.Everyone && anyRole, anyRole)
+		if err != nil {
+			return err
+		}
+		for _, userID := range resolved {
+			if userID != message.AuthorId && v1.Perms.CanView(message.GuildId, message.ChannelId, userID) {
+				mentioned = append(mentioned, userID)
+			}
+		}
+	}
+	added, err := v1.DB.SetMentions(message.MessageId, message.GuildId, message.ChannelId, mentioned)
+	if err != nil {
+		return err
+	}
+	for _, userID := range added {
+		v1.PubSub.Homeserver.Broadcast(userID, &chatv1.Event{
+			Event: &chatv1.Event_MentionReceived_{
+				MentionReceived: &chatv1.Event_MentionReceived{
+					Message: message,
+				},
+			},
+		})
+	}
+	return nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 1 * time.Second,
+			Burst:    1500,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation,
+		Permission: "messages.send",
+	}, "/protocol.chat.v1.ChatService/SendMessage")
+}
+
+// SendMessage implements the SendMessage RPC
+func (v1 *V1) SendMessage(c context.Context, r *chatv1.SendMessageRequest) (*chatv1.SendMessageResponse, error) {
+	messageID, err := v1.Sonyflake.NextID()
+	if err != nil {
+		return nil, v1.Logger.ErrorResponse(codes.Unknown, err, responses.UnknownError)
+	}
+	return v1.SendMessageWithID(c, r, messageID)
+}
+
+// SendMessageWithID sends a message the way the SendMessage RPC does, under
+// an ID picked by the caller. Sending a second message with the same ID fails.
+func (v1 *V1) SendMessageWithID(c context.Context, r *chatv1.SendMessageRequest, messageID uint64) (*chatv1.SendMessageResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	var thread queries.Thread
+	if r.ThreadId != 0 {
+		var err error
+		thread, err = v1.DB.GetThread(r.ThreadId)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if err == sql.ErrNoRows || thread.GuildID != r.GuildId || thread.ChannelID != r.ChannelId {
+			return nil, status.Error(codes.NotFound, responses.ThreadNotFound)
+		}
+	}
+	if err := v1.checkTimeout(ctx, r.GuildId); err != nil {
+		return nil, err
+	}
+
+	attachments := []*harmonytypesv1.Attachment{}
+	attachmentTypes := []string{}
+
+	for _, a := range r.Attachments {
+		contentType, fileName, size, err := v1.StorageBackend.GetMetadata(a)
+		if err == nil {
+			attachments = append(attachments, &harmonytypesv1.Attachment{
+				Id:   a,
+				Name: fileName,
+				Type: contentType,
+				Size: size,
+			})
+			attachmentTypes = append(attachmentTypes, contentType)
+		}
+	}
+
+	verdict, err := v1.moderate(ctx, r.GuildId, automod.Message{
+		Content:         r.Content,
+		AttachmentTypes: attachmentTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if verdict.Block {
+		v1.enforce(verdict, &harmonytypesv1.Message{
+			GuildId:   r.GuildId,
+			ChannelId: r.ChannelId,
+			AuthorId:  ctx.UserID,
+			Content:   r.Content,
+		}, r.ThreadId)
+		return nil, status.Error(codes.PermissionDenied, responses.AutomodBlocked)
+	}
+	cooldown, err := v1.checkSlowMode(ctx, r.GuildId, r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := v1.DB.AddMessage(
+		r.ChannelId,
+		r.GuildId,
+		ctx.UserID,
+		messageID,
+		r.Content,
+		r.Attachments,
+		v1.ProtoToEmbeds(r.Embeds),
+		v1.ProtoToActions(r.Actions),
+		v1.ProtoToOverrides(r.Overrides),
+		sql.NullInt64{
+			Int64: int64(r.InReplyTo),
+			Valid: r.InReplyTo != 0,
+		},
+		sql.NullInt64{
+			Int64: int64(r.ThreadId),
+			Valid: r.ThreadId != 0,
+		},
+	)
+	if err != nil {
+		if !cooldown.IsZero() {
+			// failing to release it is logged, and only makes the user wait
+			v1.DB.ReleaseSlowMode(r.ChannelId, ctx.UserID, cooldown)
+		}
+		return nil, err
+	}
+	if r.ThreadId != 0 {
+		if err := v1.DB.TouchThread(r.ThreadId); err != nil {
+			return nil, err
+		}
+		if thread.Archived {
+			v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+				Event: &chatv1.Event_ThreadUnarchived_{
+					ThreadUnarchived: &chatv1.Event_ThreadUnarchived{
+						GuildId:   r.GuildId,
+						ChannelId: r.ChannelId,
+						ThreadId:  r.ThreadId,
+					},
+				},
+			})
+		}
+	}
+
+	message := harmonytypesv1.Message{
+		GuildId:     r.GuildId,
+		ChannelId:   r.ChannelId,
+		MessageId:   messageID,
+		AuthorId:    ctx.UserID,
+		Content:     r.Content,
+		Attachments: attachments,
+		Embeds:      r.Embeds,
+		Actions:     r.Actions,
+		Overrides:   r.Overrides,
+		InReplyTo:   r.InReplyTo,
+		ThreadId:    r.ThreadId,
+	}
+	createdAt, _ := ptypes.TimestampProto(msg.CreatedAt.UTC())
+	message.CreatedAt = createdAt
+	message.AuthorId = ctx.UserID
+	v1.TypingManager.Stop(r.GuildId, r.ChannelId, ctx.UserID)
+	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_SentMessage{
+			SentMessage: &chatv1.Event_MessageSent{
+				EchoId:  r.EchoId,
+				Message: &message,
+			},
+		},
+	})
+	if verdict.Broken() {
+		v1.enforce(verdict, &message, r.ThreadId)
+	}
+	// there's no point notifying or previewing a message automod deleted
+	if verdict.Delete {
+		return &chatv1.SendMessageResponse{
+			MessageId: messageID,
+		}, nil
+	}
+	if err := v1.handleMentions(ctx, &message, false); err != nil {
+		v1.Logger.CheckException(err)
+	}
+	v1.Unfurler.Enqueue(r.GuildId, r.ChannelId, messageID, r.Content)
+	return &chatv1.SendMessageResponse{
+		MessageId: messageID,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.JoinedLocation,
+		Permission: "messages.send",
+	}, "/protocol.chat.v1.ChatService/ScheduleMessage")
+}
+
+// ScheduleMessage implements the ScheduleMessage RPC
+func (v1 *V1) ScheduleMessage(c context.Context, r *chatv1.ScheduleMessageRequest) (*chatv1.ScheduleMessageResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	if r.Message == nil || r.SendAt == nil {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	if !r.SendAt.AsTime().After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, responses.SendAtInPast)
+	}
+	pending, err := v1.DB.CountScheduledMessages(r.GuildId, ctx.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= int64(v1.Config.Server.Policies.ScheduledMessages.MaximumPending) {
+		return nil, status.Error(codes.ResourceExhausted, responses.TooManyScheduled)
+	}
+	r.Message.GuildId = r.GuildId
+	r.Message.ChannelId = r.ChannelId
+	request, err := proto.Marshal(r.Message)
+	if err != nil {
+		return nil, v1.Logger.ErrorResponse(codes.Internal, err, responses.InternalServerError)
+	}
+	scheduledID, err := v1.Sonyflake.NextID()
+	if err != nil {
+		return nil, v1.Logger.ErrorResponse(codes.Unknown, err, responses.UnknownError)
+	}
+	if err := v1.DB.AddScheduledMessage(scheduledID, r.GuildId, r.ChannelId, ctx.UserID, r.SendAt.AsTime().UTC(), request); err != nil {
+		return nil, err
+	}
+	return &chatv1.ScheduleMessageResponse{
+		ScheduledId: scheduledID,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:     true,
+		Location: middleware.GuildLocation | middleware.JoinedLocation,
+	}, "/protocol.chat.v1.ChatService/GetScheduledMessages")
+}
+
+// GetScheduledMessages implements the GetScheduledMessages RPC
+func (v1 *V1) GetScheduledMessages(c context.Context, r *chatv1.GetScheduledMessagesRequest) (*chatv1.GetScheduledMessagesResponse, error) {
+	ctx := c.(middleware.HarmonyContext)
+	pending, err := v1.DB.GetScheduledMessages(r.GuildId, ctx.UserID)
+	if err != nil {
+		return nil, err
+	}
+	ret := []*chatv1.ScheduledMessage{}
+	for _, scheduled := range pending {
+		message := new(chatv1.SendMessageRequest)
+		if err := proto.Unmarshal(scheduled.Request, message); err != nil {
+			continue
+		}
+		sendAt, _ := ptypes.TimestampProto(scheduled.SendAt.UTC())
+		ret = append(ret, &chatv1.ScheduledMessage{
+			ScheduledId: scheduled.ScheduledID,
+			SendAt:      sendAt,
+			Message:     message,
+		})
+	}
+	return &chatv1.GetScheduledMessagesResponse{
+		ScheduledMessages: ret,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:     true,
+		Location: middleware.GuildLocation | middleware.JoinedLocation,
+	}, "/protocol.chat.v1.ChatService/UpdateScheduledMessage")
 }
 
-// LeaveGuild implements the LeaveGuild RPC
-func (v1 *V1) LeaveGuild(c context.Context, r *chatv1.LeaveGuildRequest) (*empty.Empty, error) {
+// UpdateScheduledMessage implements the UpdateScheduledMessage RPC
+func (v1 *V1) UpdateScheduledMessage(c context.Context, r *chatv1.UpdateScheduledMessageRequest) (*empty.Empty, error) {
 	ctx := c.(middleware.HarmonyContext)
-	if isOwner, err := v1.DB.IsOwner(r.GuildId, ctx.UserID); err != nil {
+	scheduled, err := v1.DB.GetScheduledMessage(r.ScheduledId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
-	} else if isOwner {
-		return nil, status.Error(codes.FailedPrecondition, responses.InvalidRequest)
 	}
-	v1.PubSub.Guild.UnsubscribeUserFromGuild(r.GuildId, ctx.UserID)
-	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
-		Event: &chatv1.Event_LeftMember{
-			LeftMember: &chatv1.Event_MemberLeft{
-				MemberId: ctx.UserID,
-				GuildId:  r.GuildId,
-			},
-		},
-	})
+	if errors.Is(err, sql.ErrNoRows) || scheduled.GuildID != r.GuildId || scheduled.UserID != ctx.UserID {
+		return nil, status.Error(codes.NotFound, responses.ScheduledNotFound)
+	}
+	sendAt := scheduled.SendAt
+	if r.SendAt != nil {
+		if !r.SendAt.AsTime().After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, responses.SendAtInPast)
+		}
+		sendAt = r.SendAt.AsTime().UTC()
+	}
+	request := scheduled.Request
+	if r.Message != nil {
+		r.Message.GuildId = scheduled.GuildID
+		r.Message.ChannelId = scheduled.ChannelID
+		if request, err = proto.Marshal(r.Message); err != nil {
+			return nil, v1.Logger.ErrorResponse(codes.Internal, err, responses.InternalServerError)
+		}
+	}
+	updated, err := v1.DB.UpdateScheduledMessage(r.ScheduledId, ctx.UserID, sendAt, request)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, status.Error(codes.NotFound, responses.ScheduledNotFound)
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -833,170 +2505,95 @@ func init() {
 			Burst:    5,
 		},
 		Auth:     true,
-		Location: middleware.GuildLocation,
-	}, "/protocol.chat.v1.ChatService/StreamEvents")
+		Location: middleware.GuildLocation | middleware.JoinedLocation,
+	}, "/protocol.chat.v1.ChatService/CancelScheduledMessage")
 }
 
-// StreamEvents implements the StreamEvents RPC
-func (v1 *V1) StreamEvents(s chatv1.ChatService_StreamEventsServer) error {
-	userID, err := middleware.AuthHandler(v1.DB, s.Context())
+// CancelScheduledMessage implements the CancelScheduledMessage RPC
+func (v1 *V1) CancelScheduledMessage(c context.Context, r *chatv1.CancelScheduledMessageRequest) (*empty.Empty, error) {
+	ctx := c.(middleware.HarmonyContext)
+	scheduled, err := v1.DB.GetScheduledMessage(r.ScheduledId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if errors.Is(err, sql.ErrNoRows) || scheduled.GuildID != r.GuildId || scheduled.UserID != ctx.UserID {
+		return nil, status.Error(codes.NotFound, responses.ScheduledNotFound)
+	}
+	cancelled, err := v1.DB.CancelScheduledMessage(r.ScheduledId, ctx.UserID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for {
-		in, err := s.Recv()
-		if err == io.EOF {
-			return nil
-		}
-		if err != nil {
-			return err
-		}
-		switch x := in.Request.(type) {
-		case *chatv1.StreamEventsRequest_SubscribeToGuild_:
-			if err := middleware.LocationHandler(v1.DB, x.SubscribeToGuild, "/protocol.chat.v1.ChatService/StreamGuildEvents", userID); err != nil {
-				fmt.Println(err)
-				break
-			}
-			ok, err := v1.DB.UserInGuild(userID, x.SubscribeToGuild.GuildId)
-			if err != nil {
-				fmt.Println(err)
-				break
-			}
-			if !ok {
-				fmt.Println("user not in guild")
-				break
-			}
-			v1.PubSub.Guild.Subscribe(x.SubscribeToGuild.GuildId, userID, s)
-		case *chatv1.StreamEventsRequest_SubscribeToActions_:
-			v1.PubSub.Actions.Subscribe(userID, s)
-		case *chatv1.StreamEventsRequest_SubscribeToHomeserverEvents_:
-			err = v1.DB.UserIsLocal(userID)
-			if err != nil {
-				break
-			}
-			v1.PubSub.Homeserver.Subscribe(userID, s)
-		}
+	if !cancelled {
+		return nil, status.Error(codes.NotFound, responses.ScheduledNotFound)
 	}
+	return &emptypb.Empty{}, nil
 }
 
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
 			Duration: 5 * time.Second,
-			Burst:    20,
+			Burst:    5,
 		},
 		Auth:       true,
-		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation,
-		Permission: "actions.trigger",
-	}, "/protocol.chat.v1.ChatService/TriggerAction")
+		Location:   middleware.GuildLocation | middleware.ChannelLocation | middleware.MessageLocation | middleware.JoinedLocation,
+		Permission: "messages.threads.create",
+	}, "/protocol.chat.v1.ChatService/CreateThread")
 }
 
-func (v1 *V1) TriggerAction(c context.Context, r *chatv1.TriggerActionRequest) (*emptypb.Empty, error) {
+// CreateThread implements the CreateThread RPC
+func (v1 *V1) CreateThread(c context.Context, r *chatv1.CreateThreadRequest) (*chatv1.CreateThreadResponse, error) {
 	ctx := c.(middleware.HarmonyContext)
-	msg, err := v1.DB.GetMessage(r.MessageId)
+	if r.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	message, err := v1.DB.GetMessage(r.MessageId)
 	if err != nil {
 		return nil, err
 	}
-	if msg.ChannelID != r.ChannelId || msg.GuildID != r.GuildId {
+	// threads don't nest
+	if message.ThreadID.Valid {
 		return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
 	}
-	for _, action := range v1.ActionsToProto(msg.Actions) {
-		if action.Id == r.ActionId {
-			v1.PubSub.Actions.Broadcast(ctx.UserID, &chatv1.Event{
-				Event: &chatv1.Event_ActionPerformed_{
-					ActionPerformed: &chatv1.Event_ActionPerformed{
-						GuildId:    r.GuildId,
-						ChannelId:  r.ChannelId,
-						MessageId:  r.MessageId,
-						ActionData: r.ActionData,
-						ActionId:   r.ActionId,
-					},
-				},
-			})
-			return &emptypb.Empty{}, nil
-		}
+	if _, err := v1.DB.GetThread(r.MessageId); err == nil {
+		return nil, status.Error(codes.AlreadyExists, responses.ThreadExists)
+	} else if err != sql.ErrNoRows {
+		return nil, err
 	}
-	return nil, status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	thread, err := v1.DB.CreateThread(r.MessageId, r.GuildId, r.ChannelId, ctx.UserID, r.Name)
+	if err != nil {
+		return nil, err
+	}
+	ret := v1.ThreadToProto(thread)
+	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
+		Event: &chatv1.Event_ThreadCreated_{
+			ThreadCreated: &chatv1.Event_ThreadCreated{
+				Thread: ret,
+			},
+		},
+	})
+	return &chatv1.CreateThreadResponse{
+		Thread: ret,
+	}, nil
 }
 
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
-			Duration: 1 * time.Second,
-			Burst:    1500,
+			Duration: 2 * time.Second,
+			Burst:    2,
 		},
 		Auth:       true,
 		Location:   middleware.GuildLocation | middleware.ChannelLocation,
 		Permission: "messages.send",
-	}, "/protocol.chat.v1.ChatService/SendMessage")
+	}, "/protocol.chat.v1.ChatService/Typing")
 }
 
-// SendMessage implements the SendMessage RPC
-func (v1 *V1) SendMessage(c context.Context, r *chatv1.SendMessageRequest) (*chatv1.SendMessageResponse, error) {
+// Typing implements the Typing RPC
+func (v1 *V1) Typing(c context.Context, r *chatv1.TypingRequest) (*emptypb.Empty, error) {
 	ctx := c.(middleware.HarmonyContext)
-	messageID, err := v1.Sonyflake.NextID()
-	if err != nil {
-		return nil, v1.Logger.ErrorResponse(codes.Unknown, err, responses.UnknownError)
-	}
-	msg, err := v1.DB.AddMessage(
-		r.ChannelId,
-		r.GuildId,
-		ctx.UserID,
-		messageID,
-		r.Content,
-		r.Attachments,
-		v1.ProtoToEmbeds(r.Embeds),
-		v1.ProtoToActions(r.Actions),
-		v1.ProtoToOverrides(r.Overrides),
-		sql.NullInt64{
-			Int64: int64(r.InReplyTo),
-			Valid: r.InReplyTo != 0,
-		},
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	attachments := []*harmonytypesv1.Attachment{}
-
-	for _, a := range r.Attachments {
-		contentType, fileName, size, err := v1.StorageBackend.GetMetadata(a)
-		if err == nil {
-			attachments = append(attachments, &harmonytypesv1.Attachment{
-				Id:   a,
-				Name: fileName,
-				Type: contentType,
-				Size: size,
-			})
-		}
-	}
-
-	message := harmonytypesv1.Message{
-		GuildId:     r.GuildId,
-		ChannelId:   r.ChannelId,
-		MessageId:   messageID,
-		AuthorId:    ctx.UserID,
-		Content:     r.Content,
-		Attachments: attachments,
-		Embeds:      r.Embeds,
-		Actions:     r.Actions,
-		Overrides:   r.Overrides,
-		InReplyTo:   r.InReplyTo,
-	}
-	createdAt, _ := ptypes.TimestampProto(msg.CreatedAt.UTC())
-	message.CreatedAt = createdAt
-	message.AuthorId = ctx.UserID
-	v1.PubSub.Guild.Broadcast(r.GuildId, &chatv1.Event{
-		Event: &chatv1.Event_SentMessage{
-			SentMessage: &chatv1.Event_MessageSent{
-				EchoId:  r.EchoId,
-				Message: &message,
-			},
-		},
-	})
-	return &chatv1.SendMessageResponse{
-		MessageId: messageID,
-	}, nil
+	v1.TypingManager.Start(r.GuildId, r.ChannelId, ctx.UserID)
+	return &emptypb.Empty{}, nil
 }
 
 func init() {
@@ -1244,9 +2841,157 @@ func init() {
 
 // DeleteGuildRole implements the DeleteGuildRole RPC
 func (v1 *V1) DeleteGuildRole(c context.Context, r *chatv1.DeleteGuildRoleRequest) (*empty.Empty, error) {
+	defer v1.Perms.ForgetGuildRoles(r.GuildId)
 	return &empty.Empty{}, v1.DB.RemoveRoleFromGuild(r.GuildId, r.RoleId)
 }
 
+// validateAutomodRule checks a rule before it's stored, including that the
+// channels it flags messages to are in the guild
+func (v1 *V1) validateAutomodRule(guildID uint64, rule *chatv1.AutomodRule) error {
+	if rule == nil || automod.Validate(rule) != nil {
+		return status.Error(codes.InvalidArgument, responses.InvalidRequest)
+	}
+	maxTimeout := uint64(v1.Config.Server.Policies.Automod.MaximumTimeout / time.Second)
+	for _, action := range rule.Actions {
+		switch kind := action.Kind.(type) {
+		case *chatv1.AutomodAction_Flag_:
+			ok, err := v1.DB.HasChannelWithID(guildID, kind.Flag.ChannelId)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return status.Error(codes.NotFound, responses.BadLocationChannel)
+			}
+		case *chatv1.AutomodAction_Timeout_:
+			if kind.Timeout.Duration > maxTimeout {
+				return status.Error(codes.InvalidArgument, responses.InvalidRequest)
+			}
+		}
+	}
+	return nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.JoinedLocation,
+		Permission: "automod.manage",
+	}, "/protocol.chat.v1.ChatService/CreateAutomodRule")
+}
+
+// CreateAutomodRule implements the CreateAutomodRule RPC
+func (v1 *V1) CreateAutomodRule(c context.Context, r *chatv1.CreateAutomodRuleRequest) (*chatv1.CreateAutomodRuleResponse, error) {
+	if err := v1.validateAutomodRule(r.GuildId, r.Rule); err != nil {
+		return nil, err
+	}
+	rules, err := v1.DB.GetAutomodRules(r.GuildId)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) >= v1.Config.Server.Policies.Automod.MaximumRules {
+		return nil, status.Error(codes.ResourceExhausted, responses.TooManyAutomodRules)
+	}
+	ruleID, err := v1.Sonyflake.NextID()
+	if err != nil {
+		return nil, v1.Logger.ErrorResponse(codes.Unknown, err, responses.UnknownError)
+	}
+	r.Rule.RuleId = 0
+	data, err := proto.Marshal(r.Rule)
+	if err != nil {
+		return nil, v1.Logger.ErrorResponse(codes.Internal, err, responses.InternalServerError)
+	}
+	if err := v1.DB.AddAutomodRule(r.GuildId, ruleID, data); err != nil {
+		return nil, err
+	}
+	return &chatv1.CreateAutomodRuleResponse{
+		RuleId: ruleID,
+	}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.JoinedLocation,
+		Permission: "automod.manage",
+	}, "/protocol.chat.v1.ChatService/UpdateAutomodRule")
+}
+
+// UpdateAutomodRule implements the UpdateAutomodRule RPC
+func (v1 *V1) UpdateAutomodRule(c context.Context, r *chatv1.UpdateAutomodRuleRequest) (*empty.Empty, error) {
+	if err := v1.validateAutomodRule(r.GuildId, r.Rule); err != nil {
+		return nil, err
+	}
+	ruleID := r.Rule.RuleId
+	r.Rule.RuleId = 0
+	data, err := proto.Marshal(r.Rule)
+	if err != nil {
+		return nil, v1.Logger.ErrorResponse(codes.Internal, err, responses.InternalServerError)
+	}
+	ok, err := v1.DB.UpdateAutomodRule(r.GuildId, ruleID, data)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, responses.AutomodRuleNotFound)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    5,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.JoinedLocation,
+		Permission: "automod.manage",
+	}, "/protocol.chat.v1.ChatService/DeleteAutomodRule")
+}
+
+// DeleteAutomodRule implements the DeleteAutomodRule RPC
+func (v1 *V1) DeleteAutomodRule(c context.Context, r *chatv1.DeleteAutomodRuleRequest) (*empty.Empty, error) {
+	ok, err := v1.DB.DeleteAutomodRule(r.GuildId, r.RuleId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, responses.AutomodRuleNotFound)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func init() {
+	middleware.RegisterRPCConfig(middleware.RPCConfig{
+		RateLimit: middleware.RateLimit{
+			Duration: 5 * time.Second,
+			Burst:    10,
+		},
+		Auth:       true,
+		Location:   middleware.GuildLocation | middleware.JoinedLocation,
+		Permission: "automod.manage",
+	}, "/protocol.chat.v1.ChatService/GetAutomodRules")
+}
+
+// GetAutomodRules implements the GetAutomodRules RPC
+func (v1 *V1) GetAutomodRules(c context.Context, r *chatv1.GetAutomodRulesRequest) (*chatv1.GetAutomodRulesResponse, error) {
+	rules, err := v1.automodRules(r.GuildId)
+	if err != nil {
+		return nil, err
+	}
+	return &chatv1.GetAutomodRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
 func init() {
 	middleware.RegisterRPCConfig(middleware.RPCConfig{
 		RateLimit: middleware.RateLimit{
@@ -1368,6 +3113,7 @@ func init() {
 }
 
 func (v1 *V1) ManageUserRoles(c context.Context, r *chatv1.ManageUserRolesRequest) (*empty.Empty, error) {
+	defer v1.Perms.ForgetRoles(r.GuildId, r.UserId)
 	return &empty.Empty{}, v1.DB.ManageRoles(r.GuildId, r.UserId, r.GiveRoleIds, r.TakeRoleIds)
 }
 
@@ -1496,7 +3242,7 @@ func (v1 *V1) ProfileUpdate(c context.Context, r *chatv1.ProfileUpdateRequest) (
 	if err := r.Validate(); err != nil {
 		return nil, err
 	}
-	if err := v1.DB.SetStatus(ctx.UserID, r.NewStatus); err != nil {
+	if err := v1.Presence.SetChosen(ctx.UserID, r.NewStatus); err != nil {
 		v1.Logger.Exception(err)
 		return nil, errors.New(responses.UnknownError)
 	}
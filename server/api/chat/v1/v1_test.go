@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	harmonytypesv1 "github.com/harmony-development/legato/gen/harmonytypes/v1"
+	"github.com/harmony-development/legato/server/api/middleware"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/db/queries"
+)
+
+// messageDB holds a single message and records how it's updated, every
+// other method panics
+type messageDB struct {
+	db.IHarmonyDB
+	message queries.Message
+	updated bool
+	content *string
+	embeds  *[]byte
+}
+
+func (d *messageDB) GetMessage(messageID uint64) (queries.Message, error) {
+	return d.message, nil
+}
+
+func (d *messageDB) GetMemberTimeout(guildID, userID uint64) (time.Duration, error) {
+	return 0, nil
+}
+
+func (d *messageDB) GetAutomodRules(guildID uint64) ([]queries.AutomodRule, error) {
+	return nil, nil
+}
+
+func (d *messageDB) UpdateMessage(messageID uint64, content *string, embeds, actions, overrides *[]byte, attachments *[]string) (time.Time, error) {
+	d.updated = true
+	d.content = content
+	d.embeds = embeds
+	return time.Now(), nil
+}
+
+// broadcasts collects the events sent to guilds
+type broadcasts struct {
+	GuildSubscriptionManager
+	events []*chatv1.Event
+}
+
+func (b *broadcasts) Broadcast(to uint64, event *chatv1.Event) {
+	b.events = append(b.events, event)
+}
+
+func TestUpdateMessageEmbedsOnly(t *testing.T) {
+	database := &messageDB{message: queries.Message{
+		MessageID: 3,
+		GuildID:   1,
+		ChannelID: 2,
+		UserID:    4,
+		Content:   "hello",
+	}}
+	guild := &broadcasts{}
+	v1 := &V1{Dependencies: Dependencies{
+		DB:     database,
+		Config: &config.Config{},
+		PubSub: SubscriptionManager{Guild: guild},
+	}}
+
+	_, err := v1.UpdateMessage(middleware.HarmonyContext{
+		Context: context.Background(),
+		UserID:  4,
+	}, &chatv1.UpdateMessageRequest{
+		GuildId:      1,
+		ChannelId:    2,
+		MessageId:    3,
+		Embeds:       []*harmonytypesv1.Embed{{Title: "preview"}},
+		UpdateEmbeds: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !database.updated || database.embeds == nil {
+		t.Fatal("expected the embeds to be updated")
+	}
+	// without new content nothing changes what UpdateMessage compares
+	// against the stored message, so no revision is recorded
+	if database.content != nil {
+		t.Fatalf("expected the content to be left alone, got %q", *database.content)
+	}
+	if len(guild.events) != 1 || guild.events[0].GetEditedMessage().UpdateContent {
+		t.Fatal("expected an edit event that leaves the content alone")
+	}
+}
@@ -22,6 +22,7 @@ type Config struct {
 		PrivateKeyPath string `hcl:"PrivateKeyPath,optional" default:"harmony-key.pem"`
 		PublicKeyPath  string `hcl:"PublicKeyPath,optional" default:"harmony-key.pub"`
 		StorageBackend string `hcl:"StorageBackend,optional" default:"PureFlatfile"`
+		PubSubBackend  string `hcl:"PubSubBackend,optional" default:"Integrated"`
 		SnowflakeStart int64  `hcl:"SnowflakeStart,optional" default:"0"`
 		UseCORS        bool   `hcl:"UseCORS,optional" default:"true"`
 
@@ -56,13 +57,67 @@ type Config struct {
 			Sessions struct {
 				Duration time.Duration `hcl:"Duration,optional" default:"172800000000000"`
 			} `hcl:"Sessions,block"`
+			Presence struct {
+				IdleTimeout     time.Duration `hcl:"IdleTimeout,optional" default:"60000000000"`
+				InstanceTimeout time.Duration `hcl:"InstanceTimeout,optional" default:"90000000000"`
+			} `hcl:"Presence,block"`
+			Heartbeat struct {
+				Interval  time.Duration `hcl:"Interval,optional" default:"30000000000"`
+				MaxMissed int32         `hcl:"MaxMissed,optional" default:"3"`
+			} `hcl:"Heartbeat,block"`
+			Threads struct {
+				ArchiveAfter  time.Duration `hcl:"ArchiveAfter,optional" default:"86400000000000"`
+				CheckInterval time.Duration `hcl:"CheckInterval,optional" default:"60000000000"`
+			} `hcl:"Threads,block"`
+			ScheduledMessages struct {
+				MaximumPending int           `hcl:"MaximumPending,optional" default:"50"`
+				CheckInterval  time.Duration `hcl:"CheckInterval,optional" default:"10000000000"`
+				BatchSize      int32         `hcl:"BatchSize,optional" default:"100"`
+				// how long an instance has to send a claimed message before
+				// another one may claim it
+				LeaseDuration   time.Duration `hcl:"LeaseDuration,optional" default:"300000000000"`
+				RetryDelay      time.Duration `hcl:"RetryDelay,optional" default:"60000000000"`
+				MaximumAttempts int           `hcl:"MaximumAttempts,optional" default:"5"`
+			} `hcl:"ScheduledMessages,block"`
+			// revisions past either limit are dropped, 0 keeps them forever
+			MessageHistory struct {
+				MaximumRevisions int           `hcl:"MaximumRevisions,optional" default:"50"`
+				MaximumAge       time.Duration `hcl:"MaximumAge,optional" default:"0"`
+			} `hcl:"MessageHistory,block"`
+			DeletedMessages struct {
+				GracePeriod time.Duration `hcl:"GracePeriod,optional" default:"2592000000000000"`
+			} `hcl:"DeletedMessages,block"`
+			Retention struct {
+				CheckInterval time.Duration `hcl:"CheckInterval,optional" default:"60000000000"`
+				BatchSize     int32         `hcl:"BatchSize,optional" default:"500"`
+			} `hcl:"Retention,block"`
+			Automod struct {
+				MaximumRules   int           `hcl:"MaximumRules,optional" default:"50"`
+				MaximumTimeout time.Duration `hcl:"MaximumTimeout,optional" default:"2419200000000000"`
+			} `hcl:"Automod,block"`
+			SlowMode struct {
+				MaximumInterval time.Duration `hcl:"MaximumInterval,optional" default:"21600000000000"`
+			} `hcl:"SlowMode,block"`
+			LinkPreviews struct {
+				Enabled              bool          `hcl:"Enabled,optional" default:"true"`
+				MaximumLinks         int           `hcl:"MaximumLinks,optional" default:"5"`
+				MaximumSize          int64         `hcl:"MaximumSize,optional" default:"1048576"`
+				Timeout              time.Duration `hcl:"Timeout,optional" default:"5000000000"`
+				CacheSize            int           `hcl:"CacheSize,optional" default:"4096"`
+				CacheDuration        time.Duration `hcl:"CacheDuration,optional" default:"3600000000000"`
+				FailureCacheDuration time.Duration `hcl:"FailureCacheDuration,optional" default:"600000000000"`
+				MaximumImageSize     int64         `hcl:"MaximumImageSize,optional" default:"8388608"`
+				Workers              int           `hcl:"Workers,optional" default:"4"`
+				QueueLength          int           `hcl:"QueueLength,optional" default:"256"`
+			} `hcl:"LinkPreviews,block"`
 			MaximumCacheSizes struct {
 				Owner    int `hcl:"Owner,optional" default:"5096"`
 				Sessions int `hcl:"Sessions,optional" default:"5096"`
 			} `hcl:"Caches,block"`
 			APIs struct {
 				Messages struct {
-					MaximumGetAmount int `hcl:"MaximumGetAmount,optional" default:"50"`
+					MaximumGetAmount        int `hcl:"MaximumGetAmount,optional" default:"50"`
+					MaximumBulkDeleteAmount int `hcl:"MaximumBulkDeleteAmount,optional" default:"500"`
 				} `hcl:"Messages,block"`
 			} `hcl:"APIs,block"`
 			Federation struct {
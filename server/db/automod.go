@@ -0,0 +1,91 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// AddAutomodRule stores a new automod rule of a guild
+func (db *HarmonyDB) AddAutomodRule(guildID, ruleID uint64, data []byte) error {
+	err := tracerr.Wrap(db.queries.AddAutomodRule(ctx, queries.AddAutomodRuleParams{
+		RuleID:  ruleID,
+		GuildID: guildID,
+		Data:    data,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// UpdateAutomodRule replaces an automod rule, returning false if the guild
+// has no such rule
+func (db *HarmonyDB) UpdateAutomodRule(guildID, ruleID uint64, data []byte) (bool, error) {
+	rows, err := db.queries.UpdateAutomodRule(ctx, queries.UpdateAutomodRuleParams{
+		Data:    data,
+		RuleID:  ruleID,
+		GuildID: guildID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return rows > 0, err
+}
+
+// DeleteAutomodRule deletes an automod rule, returning false if the guild
+// has no such rule
+func (db *HarmonyDB) DeleteAutomodRule(guildID, ruleID uint64) (bool, error) {
+	rows, err := db.queries.DeleteAutomodRule(ctx, queries.DeleteAutomodRuleParams{
+		RuleID:  ruleID,
+		GuildID: guildID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return rows > 0, err
+}
+
+// GetAutomodRules gets the automod rules of a guild
+func (db *HarmonyDB) GetAutomodRules(guildID uint64) ([]queries.AutomodRule, error) {
+	rules, err := db.queries.GetAutomodRules(ctx, guildID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return rules, err
+}
+
+// TimeoutMember stops a member from sending messages in a guild until the
+// given time. A longer timeout the member already has is kept.
+func (db *HarmonyDB) TimeoutMember(guildID, userID uint64, until time.Time) error {
+	err := tracerr.Wrap(db.queries.TimeoutMember(ctx, queries.TimeoutMemberParams{
+		GuildID:       guildID,
+		UserID:        userID,
+		TimedOutUntil: until,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// GetMemberTimeout gets how long a member is still timed out for in a
+// guild, 0 if they aren't
+func (db *HarmonyDB) GetMemberTimeout(guildID, userID uint64) (time.Duration, error) {
+	until, err := db.queries.GetMemberTimeout(ctx, queries.GetMemberTimeoutParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return 0, err
+	}
+	if remaining := until.Sub(time.Now().UTC()); remaining > 0 {
+		return remaining, nil
+	}
+	return 0, nil
+}
+
+// ExpireMemberTimeouts forgets the timeouts that are over
+func (db *HarmonyDB) ExpireMemberTimeouts() error {
+	return tracerr.Wrap(db.queries.ExpireMemberTimeouts(ctx, time.Now().UTC()))
+}
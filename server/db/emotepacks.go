@@ -77,3 +77,14 @@ func (db HarmonyDB) DequipEmotePack(userID, packID uint64) error {
 		UserID: userID,
 	}))
 }
+
+// HasEquippedEmote checks whether an emote is in one of the packs a user has equipped
+func (db HarmonyDB) HasEquippedEmote(userID uint64, imageID string) (bool, error) {
+	ok, err := db.queries.HasEquippedEmote(ctx, queries.HasEquippedEmoteParams{
+		UserID:  userID,
+		ImageID: imageID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return ok, err
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// Notify sends a payload to everyone listening on a Postgres channel
+func (db *HarmonyDB) Notify(channel, payload string) error {
+	return tracerr.Wrap(db.queries.Notify(ctx, queries.NotifyParams{
+		Channel: channel,
+		Payload: payload,
+	}))
+}
+
+// AddQueuedEvent stores an event that is too large to be sent as a payload
+func (db *HarmonyDB) AddQueuedEvent(data []byte) (uint64, error) {
+	eventID, err := db.queries.AddQueuedEvent(ctx, data)
+	err = tracerr.Wrap(err)
+	return eventID, err
+}
+
+// GetQueuedEvent gets the data of a queued event
+func (db *HarmonyDB) GetQueuedEvent(eventID uint64) ([]byte, error) {
+	data, err := db.queries.GetQueuedEvent(ctx, eventID)
+	err = tracerr.Wrap(err)
+	return data, err
+}
+
+// ExpireQueuedEvents deletes queued events every instance has had the chance to read
+func (db *HarmonyDB) ExpireQueuedEvents() error {
+	return tracerr.Wrap(db.queries.ExpireQueuedEvents(ctx))
+}
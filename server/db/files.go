@@ -35,5 +35,17 @@ func (db *HarmonyDB) GetFileMetadata(fileID string) (queries.GetFileMetadataRow,
 }
 
 func (db *HarmonyDB) DeleteFileMeta(fileID string) error {
+	if err := db.queries.DeleteFileHashes(ctx, fileID); err != nil {
+		return tracerr.Wrap(err)
+	}
 	return tracerr.Wrap(db.queries.DeleteFileMetadata(ctx, fileID))
 }
+
+// FileInUse checks whether a file is still referenced by a message, an
+// edit revision, an avatar, a guild picture, an emote or a link preview
+func (db *HarmonyDB) FileInUse(fileID string) (bool, error) {
+	inUse, err := db.queries.FileInUse(ctx, fileID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return inUse, err
+}
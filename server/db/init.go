@@ -73,6 +73,8 @@ type PermissionsData struct {
 type IHarmonyDB interface {
 	Migrate() error
 	SessionExpireRoutine()
+	RevisionExpireRoutine()
+	TimeoutExpireRoutine()
 	CreateGuild(owner, id, channelID uint64, guildName, picture string) (*queries.Guild, error)
 	DeleteGuild(guildID uint64) error
 	GetOwner(guildID uint64) (uint64, error)
@@ -82,17 +84,52 @@ type IHarmonyDB interface {
 	AddMemberToGuild(userID, guildID uint64) error
 	AddChannelToGuild(guildID uint64, channelName string, previous, next uint64, category bool, kind string) (queries.Channel, error)
 	DeleteChannelFromGuild(guildID, channelID uint64) error
-	AddMessage(channelID, guildID, userID, messageID uint64, message string, attachments []string, embeds, actions, overrides []byte, replyTo sql.NullInt64) (*queries.Message, error)
-	DeleteMessage(messageID, channelID, guildID uint64) error
+	AddMessage(channelID, guildID, userID, messageID uint64, message string, attachments []string, embeds, actions, overrides []byte, replyTo, threadID sql.NullInt64) (*queries.Message, error)
+	DeleteMessage(messageID, channelID, guildID, deletedBy uint64) error
+	RestoreMessage(messageID, channelID, guildID uint64) (bool, error)
+	GetDeletedMessages(guildID, channelID, beforeMessage uint64, limit int32) ([]queries.Message, error)
+	PurgeDeletedMessages(limit int32) ([]queries.PurgeDeletedMessagesRow, error)
+	BulkDeleteMessages(guildID, channelID, deletedBy uint64, messageIDs []uint64, authorID uint64, after, before time.Time, limit int32) ([]uint64, error)
 	GetMessageOwner(messageID uint64) (uint64, error)
+	PinMessage(channelID, messageID, userID uint64) (bool, error)
+	UnpinMessage(channelID, messageID uint64) (bool, error)
+	GetPinnedMessages(channelID uint64) ([]queries.Message, error)
+	AddReaction(messageID, userID uint64, emoji, emote string) (bool, error)
+	RemoveReaction(messageID, userID uint64, emoji, emote string) (bool, error)
+	CountReaction(messageID uint64, emoji, emote string) (int64, error)
+	GetReactions(messageIDs []uint64) ([]queries.GetReactionsRow, error)
+	CreateThread(threadID, guildID, channelID, userID uint64, name string) (queries.Thread, error)
+	GetThread(threadID uint64) (queries.Thread, error)
+	GetActiveThreads(channelID uint64) ([]queries.Thread, error)
+	TouchThread(threadID uint64) error
+	ArchiveInactiveThreads(before time.Time) ([]queries.ArchiveInactiveThreadsRow, error)
+	GetThreadMessages(threadID, beforeMessage uint64, limit int32) ([]queries.Message, error)
+	ResolveMentions(guildID uint64, users, roles []uint64, everyone, anyRole bool) ([]uint64, error)
+	SetMentions(messageID, guildID, channelID uint64, users []uint64) ([]uint64, error)
+	GetMentions(userID, beforeMessage uint64, limit int32) ([]queries.Message, error)
+	AckChannel(userID, guildID, channelID, messageID uint64) error
+	GetReadStates(guildID, userID uint64) ([]queries.GetReadStatesRow, error)
+	AddScheduledMessage(scheduledID, guildID, channelID, userID uint64, sendAt time.Time, request []byte) error
+	CountScheduledMessages(guildID, userID uint64) (int64, error)
+	GetScheduledMessage(scheduledID uint64) (queries.ScheduledMessage, error)
+	GetScheduledMessages(guildID, userID uint64) ([]queries.ScheduledMessage, error)
+	UpdateScheduledMessage(scheduledID, userID uint64, sendAt time.Time, request []byte) (bool, error)
+	CancelScheduledMessage(scheduledID, userID uint64) (bool, error)
+	ClaimDueScheduledMessages(now, leaseUntil time.Time, limit int32) ([]queries.ScheduledMessage, error)
+	RetryScheduledMessage(scheduledID uint64, sendAt time.Time) error
+	SetScheduledMessageID(scheduledID, messageID uint64) error
+	ScheduledMessageSent(messageID uint64) (bool, error)
+	FinishScheduledMessage(scheduledID uint64) error
+	GetMessageRevisions(messageID uint64) ([]queries.MessageRevision, error)
+	ExpireMessageRevisions() error
 	ResolveGuildID(inviteID string) (uint64, error)
 	IncrementInvite(inviteID string) error
 	DeleteInvite(inviteID string) error
 	SessionToUserID(session string) (uint64, error)
 	UserInGuild(userID, guildID uint64) (bool, error)
-	GetMessageDate(messageID uint64) (time.Time, error)
-	GetMessages(guildID, channelID uint64) ([]queries.Message, error)
-	GetMessagesBefore(guildID, channelID uint64, date time.Time) ([]queries.Message, error)
+	GetMessages(guildID, channelID uint64, limit int32) ([]queries.Message, error)
+	GetMessagesBefore(guildID, channelID, messageID uint64, limit int32) ([]queries.Message, error)
+	GetMessagesAfter(guildID, channelID, messageID uint64, limit int32) ([]queries.Message, error)
 	UpdateGuildName(guildID uint64, newName string) error
 	GetGuildPicture(guildID uint64) (string, error)
 	SetGuildPicture(guildID uint64, pictureURL string) error
@@ -102,6 +139,7 @@ type IHarmonyDB interface {
 	ChannelsForGuild(guildID uint64) ([]queries.Channel, error)
 	MembersInGuild(guildID uint64) ([]uint64, error)
 	GetMessage(messageID uint64) (queries.Message, error)
+	SearchMessages(arg queries.SearchMessagesParams) ([]queries.Message, error)
 	GetUserByEmail(email string) (queries.GetUserByEmailRow, error)
 	GetUserByID(userID uint64) (queries.GetUserRow, error)
 	AddSession(userID uint64, session string) error
@@ -110,6 +148,8 @@ type IHarmonyDB interface {
 	AddForeignUser(homeServer string, userID, localUserID uint64, username, avatar string) (uint64, error)
 	EmailExists(email string) (bool, error)
 	ExpireSessions() error
+	AddStreamTicket(ticket, session string, expiration time.Time) error
+	RedeemStreamTicket(ticket string) (string, error)
 	UpdateUsername(userID uint64, username string) error
 	GetAvatar(userID uint64) (sql.NullString, error)
 	UpdateAvatar(userID uint64, avatar string) error
@@ -118,7 +158,20 @@ type IHarmonyDB interface {
 	HasMessageWithID(guildID, channelID, messageID uint64) (bool, error)
 	GetGuildByID(guildID uint64) (queries.Guild, error)
 	UpdateMessage(messageID uint64, content *string, embeds, actions, overrides *[]byte, attachments *[]string) (time.Time, error)
+	SetMessageEmbeds(messageID uint64, content string, embeds []byte, previewImages []string) (bool, error)
+	UsePreviewImage(url string) (string, error)
+	AddPreviewImage(url, fileID string) (string, error)
+	ExpirePreviewImages(usedBefore time.Time, limit int32) ([]string, error)
 	SetStatus(userID uint64, status harmonytypesv1.UserStatus) error
+	GetChosenStatus(userID uint64) (harmonytypesv1.UserStatus, error)
+	SetChosenStatus(userID uint64, status harmonytypesv1.UserStatus) error
+	ResetStatuses() error
+	AddPresenceInstance(instanceID string) error
+	TouchPresenceInstance(instanceID string) (bool, error)
+	SetPresenceStreams(instanceID string, userID uint64, streams int32) error
+	RemovePresenceStreams(instanceID string, userID uint64) error
+	UserHasStreams(userID uint64) (bool, error)
+	ExpirePresenceInstances(seenBefore time.Time) ([]uint64, error)
 	GetUserMetadata(userID uint64, appID string) (string, error)
 	GetNonceInfo(nonce string) (queries.GetNonceInfoRow, error)
 	AddNonce(nonce string, userID uint64, homeServer string) error
@@ -138,6 +191,7 @@ type IHarmonyDB interface {
 	GetEmotePacks(userID uint64) ([]queries.GetEmotePacksRow, error)
 	GetEmotePackEmotes(packID uint64) ([]queries.GetEmotePackEmotesRow, error)
 	DequipEmotePack(userID, packID uint64) error
+	HasEquippedEmote(userID uint64, imageID string) (bool, error)
 	AddRoleToGuild(guildID uint64, role *chatv1.Role) error
 	RemoveRoleFromGuild(guildID, roleID uint64) error
 	GetRolePositions(guildID, before, previous uint64) (pos string, retErr error)
@@ -154,6 +208,38 @@ type IHarmonyDB interface {
 	AddFileHash(fileID string, hash []byte) error
 	SetFileMetadata(fileID string, contentType, name string, size int32) error
 	GetFileMetadata(fileID string) (queries.GetFileMetadataRow, error)
+	FileInUse(fileID string) (bool, error)
+	SetGuildRetention(guildID uint64, maxAge int64) error
+	SetChannelRetention(guildID, channelID uint64, maxAge int64) error
+	ClearChannelRetention(channelID uint64) error
+	GetRetention(guildID uint64) (int64, []queries.ChannelRetention, error)
+	PruneExpiredMessages(limit int32) ([]queries.PruneExpiredMessagesRow, error)
+	SetChannelSlowMode(guildID, channelID uint64, interval int32) (bool, error)
+	GetChannelSlowMode(channelID uint64) (int32, error)
+	ClaimSlowMode(channelID, userID uint64, interval int32, now time.Time) (time.Duration, error)
+	ReleaseSlowMode(channelID, userID uint64, claimedAt time.Time) error
+	AddAutomodRule(guildID, ruleID uint64, data []byte) error
+	UpdateAutomodRule(guildID, ruleID uint64, data []byte) (bool, error)
+	DeleteAutomodRule(guildID, ruleID uint64) (bool, error)
+	GetAutomodRules(guildID uint64) ([]queries.AutomodRule, error)
+	TimeoutMember(guildID, userID uint64, until time.Time) error
+	GetMemberTimeout(guildID, userID uint64) (time.Duration, error)
+	Notify(channel, payload string) error
+	AddQueuedEvent(data []byte) (uint64, error)
+	GetQueuedEvent(eventID uint64) ([]byte, error)
+	ExpireQueuedEvents() error
+}
+
+// ConnectionString creates the Postgres connection string for a config
+func ConnectionString(cfg *config.Config) string {
+	return fmt.Sprintf("user=%v password=%v dbname=%v host=%v port=%v sslmode=%v",
+		cfg.Database.Username,
+		cfg.Database.Password,
+		cfg.Database.Name,
+		cfg.Database.Host,
+		cfg.Database.Port,
+		map[bool]string{true: "enable", false: "disable"}[cfg.Database.SSL],
+	)
 }
 
 // New creates a new DB connection
@@ -163,14 +249,7 @@ func New(cfg *config.Config, logger logger.ILogger, idgen *sonyflake.Sonyflake)
 	db.Logger = logger
 	db.Sonyflake = idgen
 	var err error
-	if db.DB, err = sql.Open("postgres", fmt.Sprintf("user=%v password=%v dbname=%v host=%v port=%v sslmode=%v",
-		cfg.Database.Username,
-		cfg.Database.Password,
-		cfg.Database.Name,
-		cfg.Database.Host,
-		cfg.Database.Port,
-		map[bool]string{true: "enable", false: "disable"}[cfg.Database.SSL],
-	)); err != nil {
+	if db.DB, err = sql.Open("postgres", ConnectionString(cfg)); err != nil {
 		return nil, tracerr.Wrap(err)
 	}
 	if err = db.Ping(); err != nil {
@@ -189,5 +268,7 @@ func New(cfg *config.Config, logger logger.ILogger, idgen *sonyflake.Sonyflake)
 		return nil, tracerr.Wrap(err)
 	}
 	go db.SessionExpireRoutine()
+	go db.RevisionExpireRoutine()
+	go db.TimeoutExpireRoutine()
 	return db, nil
 }
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// ResolveMentions gets the guild members reached by the mentioned users and
+// roles, or by everyone. Roles that aren't pingable only count if anyRole is
+// set.
+func (db *HarmonyDB) ResolveMentions(guildID uint64, users, roles []uint64, everyone, anyRole bool) ([]uint64, error) {
+	mentioned, err := db.queries.ResolveMentions(ctx, queries.ResolveMentionsParams{
+		Guildid:  guildID,
+		Everyone: everyone,
+		Users:    toInt64Array(users),
+		Roles:    toInt64Array(roles),
+		Anyrole:  anyRole,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return mentioned, err
+}
+
+// SetMentions replaces the users mentioned by a message, returning the ones
+// that weren't mentioned by it before
+func (db *HarmonyDB) SetMentions(messageID, guildID, channelID uint64, users []uint64) ([]uint64, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return nil, err
+	}
+	tq := db.queries.WithTx(tx)
+	if err := tq.RemoveStaleMentions(ctx, queries.RemoveStaleMentionsParams{
+		Messageid: messageID,
+		Users:     toInt64Array(users),
+	}); err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		tx.Rollback()
+		return nil, err
+	}
+	added, err := tq.AddMentions(ctx, queries.AddMentionsParams{
+		Messageid: int64(messageID),
+		Users:     toInt64Array(users),
+		Guildid:   int64(guildID),
+		Channelid: int64(channelID),
+	})
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return nil, err
+	}
+	return added, nil
+}
+
+// GetMentions gets the latest messages mentioning a user across guilds
+func (db *HarmonyDB) GetMentions(userID, beforeMessage uint64, limit int32) ([]queries.Message, error) {
+	messages, err := db.queries.GetMentions(ctx, queries.GetMentionsParams{
+		Userid:        userID,
+		Beforemessage: int64(beforeMessage),
+		Max:           limit,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return messages, err
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // AddMessage adds a message to a channel
-func (db *HarmonyDB) AddMessage(channelID, guildID, userID, messageID uint64, message string, attachments []string, embeds, actions, overrides []byte, replyTo sql.NullInt64) (*queries.Message, error) {
+func (db *HarmonyDB) AddMessage(channelID, guildID, userID, messageID uint64, message string, attachments []string, embeds, actions, overrides []byte, replyTo, threadID sql.NullInt64) (*queries.Message, error) {
 	tx, err := db.Begin()
 	if err != nil {
 		err = tracerr.Wrap(err)
@@ -28,6 +28,7 @@ func (db *HarmonyDB) AddMessage(channelID, guildID, userID, messageID uint64, me
 		Overrides:   overrides,
 		Attachments: attachments,
 		ReplyToID:   replyTo,
+		ThreadID:    threadID,
 	})
 	if err != nil {
 		err = tracerr.Wrap(err)
@@ -42,8 +43,9 @@ func (db *HarmonyDB) AddMessage(channelID, guildID, userID, messageID uint64, me
 	return &msg, nil
 }
 
-// DeleteMessage deletes a message
-func (db *HarmonyDB) DeleteMessage(messageID, channelID, guildID uint64) (err error) {
+// DeleteMessage tombstones a message, it's kept along with who deleted it
+// until it is restored or purged
+func (db *HarmonyDB) DeleteMessage(messageID, channelID, guildID, deletedBy uint64) (err error) {
 	tx, err := db.Begin()
 	if err != nil {
 		err = tracerr.Wrap(err)
@@ -51,10 +53,20 @@ func (db *HarmonyDB) DeleteMessage(messageID, channelID, guildID uint64) (err er
 		return err
 	}
 	tq := db.queries.WithTx(tx)
-	numRows, err := tq.DeleteMessage(ctx, queries.DeleteMessageParams{
-		MessageID: messageID,
+	if _, err := tq.UnpinMessage(ctx, queries.UnpinMessageParams{
 		ChannelID: channelID,
-		GuildID:   guildID,
+		MessageID: messageID,
+	}); err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		tx.Rollback()
+		return err
+	}
+	numRows, err := tq.DeleteMessage(ctx, queries.DeleteMessageParams{
+		Deletedby: int64(deletedBy),
+		Messageid: messageID,
+		Channelid: channelID,
+		Guildid:   guildID,
 	})
 	if err != nil {
 		err = tracerr.Wrap(err)
@@ -72,6 +84,99 @@ func (db *HarmonyDB) DeleteMessage(messageID, channelID, guildID uint64) (err er
 	return nil
 }
 
+// RestoreMessage undoes the deletion of a message, returning whether it was
+// deleted
+func (db *HarmonyDB) RestoreMessage(messageID, channelID, guildID uint64) (bool, error) {
+	numRows, err := db.queries.RestoreMessage(ctx, queries.RestoreMessageParams{
+		MessageID: messageID,
+		ChannelID: channelID,
+		GuildID:   guildID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return numRows > 0, err
+}
+
+// GetDeletedMessages gets the deleted messages of a channel that weren't
+// purged yet, newest first
+func (db *HarmonyDB) GetDeletedMessages(guildID, channelID, beforeMessage uint64, limit int32) ([]queries.Message, error) {
+	messages, err := db.queries.GetDeletedMessages(ctx, queries.GetDeletedMessagesParams{
+		Guildid:       guildID,
+		Channelid:     channelID,
+		Beforemessage: int64(beforeMessage),
+		Max:           limit,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return messages, err
+}
+
+// PurgeDeletedMessages permanently removes up to limit messages deleted
+// longer than the grace period ago. Like with pruning, a thread's message is
+// kept until its replies are gone.
+func (db *HarmonyDB) PurgeDeletedMessages(limit int32) ([]queries.PurgeDeletedMessagesRow, error) {
+	purged, err := db.queries.PurgeDeletedMessages(ctx, queries.PurgeDeletedMessagesParams{
+		Deletedbefore: time.Now().UTC().Add(-db.Config.Server.Policies.DeletedMessages.GracePeriod),
+		Max:           limit,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return purged, err
+}
+
+// BulkDeleteMessages tombstones the given messages of a channel, or the newest
+// ones matching the filter if messageIDs is empty, returning the IDs of the
+// deleted messages
+func (db *HarmonyDB) BulkDeleteMessages(guildID, channelID, deletedBy uint64, messageIDs []uint64, authorID uint64, after, before time.Time, limit int32) ([]uint64, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return nil, err
+	}
+	tq := db.queries.WithTx(tx)
+	var deleted []uint64
+	if len(messageIDs) > 0 {
+		deleted, err = tq.BulkDeleteMessages(ctx, queries.BulkDeleteMessagesParams{
+			Deletedby:  int64(deletedBy),
+			Guildid:    guildID,
+			Channelid:  channelID,
+			Messageids: toInt64Array(messageIDs),
+		})
+	} else {
+		deleted, err = tq.BulkDeleteFilteredMessages(ctx, queries.BulkDeleteFilteredMessagesParams{
+			Deletedby: int64(deletedBy),
+			Guildid:   guildID,
+			Channelid: channelID,
+			Authorid:  int64(authorID),
+			After:     after,
+			Before:    before,
+			Max:       limit,
+		})
+	}
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tq.UnpinMessages(ctx, queries.UnpinMessagesParams{
+		Channelid:  channelID,
+		Messageids: toInt64Array(deleted),
+	}); err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return nil, err
+	}
+	return deleted, nil
+}
+
 // GetMessageOwner gets the owner of a messageID
 func (db *HarmonyDB) GetMessageOwner(messageID uint64) (owner uint64, err error) {
 	owner, err = db.queries.GetMessageAuthor(ctx, messageID)
@@ -80,33 +185,42 @@ func (db *HarmonyDB) GetMessageOwner(messageID uint64) (owner uint64, err error)
 	return owner, err
 }
 
-// GetMessageDate gets the date for a message
-func (db *HarmonyDB) GetMessageDate(messageID uint64) (t time.Time, err error) {
-	msgDate, err := db.queries.GetMessageDate(ctx, messageID)
+// GetMessages gets the newest messages of a channel, newest first
+func (db *HarmonyDB) GetMessages(guildID, channelID uint64, limit int32) (r []queries.Message, err error) {
+	msgs, err := db.queries.GetMessages(ctx, queries.GetMessagesParams{
+		Guildid:   guildID,
+		Channelid: channelID,
+		Max:       limit,
+	})
 	err = tracerr.Wrap(err)
 	db.Logger.CheckException(err)
-	return msgDate, err
+	return msgs, err
 }
 
-// GetMessages gets the newest messages from a guild
-func (db *HarmonyDB) GetMessages(guildID, channelID uint64) (r []queries.Message, err error) {
-	msgs, err := db.GetMessagesBefore(guildID, channelID, time.Now())
+// GetMessagesBefore gets the messages older than a given message, newest first
+func (db *HarmonyDB) GetMessagesBefore(guildID, channelID, messageID uint64, limit int32) (r []queries.Message, err error) {
+	msgsBefore, err := db.queries.GetMessagesBefore(ctx, queries.GetMessagesBeforeParams{
+		Guildid:   guildID,
+		Channelid: channelID,
+		Cursor:    messageID,
+		Max:       limit,
+	})
 	err = tracerr.Wrap(err)
 	db.Logger.CheckException(err)
-	return msgs, err
+	return msgsBefore, err
 }
 
-// GetMessagesBefore gets messages before a given message in a guild
-func (db *HarmonyDB) GetMessagesBefore(guildID, channelID uint64, date time.Time) (r []queries.Message, err error) {
-	msgsBefore, err := db.queries.GetMessages(ctx, queries.GetMessagesParams{
+// GetMessagesAfter gets the messages newer than a given message, oldest first
+func (db *HarmonyDB) GetMessagesAfter(guildID, channelID, messageID uint64, limit int32) (r []queries.Message, err error) {
+	msgsAfter, err := db.queries.GetMessagesAfter(ctx, queries.GetMessagesAfterParams{
 		Guildid:   guildID,
 		Channelid: channelID,
-		Before:    date,
-		Max:       int32(db.Config.Server.Policies.APIs.Messages.MaximumGetAmount),
+		Cursor:    messageID,
+		Max:       limit,
 	})
 	err = tracerr.Wrap(err)
 	db.Logger.CheckException(err)
-	return msgsBefore, err
+	return msgsAfter, err
 }
 
 // GetMessage gets the data of a message
@@ -117,6 +231,14 @@ func (db *HarmonyDB) GetMessage(messageID uint64) (r queries.Message, err error)
 	return
 }
 
+// SearchMessages finds the messages in a guild matching a search
+func (db *HarmonyDB) SearchMessages(arg queries.SearchMessagesParams) ([]queries.Message, error) {
+	msgs, err := db.queries.SearchMessages(ctx, arg)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return msgs, err
+}
+
 func (db *HarmonyDB) UpdateMessage(messageID uint64, content *string, embeds, actions, overrides *[]byte, attachments *[]string) (r time.Time, err error) {
 	tx, err := db.Begin()
 	if err != nil {
@@ -127,6 +249,27 @@ func (db *HarmonyDB) UpdateMessage(messageID uint64, content *string, embeds, ac
 	tq := db.queries.WithTx(tx)
 	var editedAt time.Time
 	e := executor{}
+	revised := false
+	if content != nil || attachments != nil {
+		e.Execute(func() error {
+			current, err := tq.GetMessage(ctx, messageID)
+			revised = changesMessage(current, content, attachments)
+			return tracerr.Wrap(err)
+		})
+	}
+	if revised {
+		e.Execute(func() error {
+			return tracerr.Wrap(tq.AddMessageRevision(ctx, messageID))
+		})
+		if max := db.Config.Server.Policies.MessageHistory.MaximumRevisions; max > 0 {
+			e.Execute(func() error {
+				return tracerr.Wrap(tq.TrimMessageRevisions(ctx, queries.TrimMessageRevisionsParams{
+					MessageID: messageID,
+					Limit:     int32(max),
+				}))
+			})
+		}
+	}
 	if content != nil {
 		e.Execute(func() error {
 			data, err := tq.UpdateMessageContent(ctx, queries.UpdateMessageContentParams{
@@ -191,6 +334,62 @@ func (db *HarmonyDB) UpdateMessage(messageID uint64, content *string, embeds, ac
 	return editedAt, nil
 }
 
+// SetMessageEmbeds replaces the embeds of a message without counting it as
+// an edit, returning false if the message was deleted or its content changed.
+// The stored preview images the embeds show are kept as long as the message.
+func (db *HarmonyDB) SetMessageEmbeds(messageID uint64, content string, embeds []byte, previewImages []string) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return false, err
+	}
+	tq := db.queries.WithTx(tx)
+	numRows, err := tq.SetMessageEmbeds(ctx, queries.SetMessageEmbedsParams{
+		MessageID: messageID,
+		Embeds:    embeds,
+		Content:   content,
+	})
+	if err == nil && numRows > 0 && len(previewImages) > 0 {
+		err = tq.AddMessagePreviewImages(ctx, queries.AddMessagePreviewImagesParams{
+			Messageid: int64(messageID),
+			Fileids:   previewImages,
+		})
+	}
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return false, err
+	}
+	return numRows > 0, nil
+}
+
+// changesMessage checks whether an edit changes what users see of a message,
+// only those edits are kept in its history
+func changesMessage(current queries.Message, content *string, attachments *[]string) bool {
+	if content != nil && *content != current.Content {
+		return true
+	}
+	if attachments == nil {
+		return false
+	}
+	if len(*attachments) != len(current.Attachments) {
+		return true
+	}
+	for i, attachment := range *attachments {
+		if attachment != current.Attachments[i] {
+			return true
+		}
+	}
+	return false
+}
+
 func (db HarmonyDB) HasMessageWithID(guildID, channelID, messageID uint64) (r bool, err error) {
 	r, err = db.queries.MessageWithIDExists(ctx, queries.MessageWithIDExistsParams{
 		GuildID:   guildID,
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/harmony-development/legato/server/db/queries"
+)
+
+func TestChangesMessage(t *testing.T) {
+	current := queries.Message{Content: "hello", Attachments: []string{"a", "b"}}
+	content := func(s string) *string { return &s }
+	attachments := func(s ...string) *[]string { return &s }
+
+	cases := []struct {
+		name        string
+		content     *string
+		attachments *[]string
+		changes     bool
+	}{
+		{"embeds only", nil, nil, false},
+		{"same content", content("hello"), nil, false},
+		{"new content", content("hello!"), nil, true},
+		{"same attachments", nil, attachments("a", "b"), false},
+		{"reordered attachments", nil, attachments("b", "a"), true},
+		{"removed attachment", nil, attachments("a"), true},
+	}
+	for _, c := range cases {
+		if changes := changesMessage(current, c.content, c.attachments); changes != c.changes {
+			t.Errorf("%s: expected %v, got %v", c.name, c.changes, changes)
+		}
+	}
+}
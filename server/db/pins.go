@@ -0,0 +1,37 @@
+package db
+
+import (
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// PinMessage pins a message in its channel, returning false if it already was
+func (db *HarmonyDB) PinMessage(channelID, messageID, userID uint64) (bool, error) {
+	numRows, err := db.queries.PinMessage(ctx, queries.PinMessageParams{
+		ChannelID: channelID,
+		MessageID: messageID,
+		PinnedBy:  userID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return numRows > 0, err
+}
+
+// UnpinMessage unpins a message, returning false if it wasn't pinned
+func (db *HarmonyDB) UnpinMessage(channelID, messageID uint64) (bool, error) {
+	numRows, err := db.queries.UnpinMessage(ctx, queries.UnpinMessageParams{
+		ChannelID: channelID,
+		MessageID: messageID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return numRows > 0, err
+}
+
+// GetPinnedMessages gets the pinned messages of a channel, most recently pinned first
+func (db *HarmonyDB) GetPinnedMessages(channelID uint64) ([]queries.Message, error) {
+	msgs, err := db.queries.GetPinnedMessages(ctx, channelID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return msgs, err
+}
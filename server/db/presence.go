@@ -0,0 +1,84 @@
+package db
+
+import (
+	"time"
+
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// AddPresenceInstance registers an instance serving event streams, or
+// brings it back after it was expired
+func (db *HarmonyDB) AddPresenceInstance(instanceID string) error {
+	err := tracerr.Wrap(db.queries.AddPresenceInstance(ctx, instanceID))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// TouchPresenceInstance records that an instance is still running,
+// returning false if it was expired in the meantime
+func (db *HarmonyDB) TouchPresenceInstance(instanceID string) (bool, error) {
+	rows, err := db.queries.TouchPresenceInstance(ctx, instanceID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return rows > 0, err
+}
+
+// SetPresenceStreams sets how many event streams of a user an instance has
+// open. Instances keep a row with no streams while the user is idle.
+func (db *HarmonyDB) SetPresenceStreams(instanceID string, userID uint64, streams int32) error {
+	err := tracerr.Wrap(db.queries.SetPresenceStreams(ctx, queries.SetPresenceStreamsParams{
+		InstanceID: instanceID,
+		UserID:     userID,
+		Streams:    streams,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// RemovePresenceStreams forgets the streams of a user on an instance
+func (db *HarmonyDB) RemovePresenceStreams(instanceID string, userID uint64) error {
+	err := tracerr.Wrap(db.queries.RemovePresenceStreams(ctx, queries.RemovePresenceStreamsParams{
+		InstanceID: instanceID,
+		UserID:     userID,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// UserHasStreams checks whether any instance has streams of a user
+func (db *HarmonyDB) UserHasStreams(userID uint64) (bool, error) {
+	ok, err := db.queries.UserHasStreams(ctx, userID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return ok, err
+}
+
+// ExpirePresenceInstances forgets the instances which haven't checked in
+// since a given time along with their streams, returning the users they had
+// streams of
+func (db *HarmonyDB) ExpirePresenceInstances(seenBefore time.Time) ([]uint64, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return nil, err
+	}
+	tq := db.queries.WithTx(tx)
+	users, err := tq.ExpirePresenceStreams(ctx, seenBefore)
+	if err == nil {
+		err = tq.ExpirePresenceInstances(ctx, seenBefore)
+	}
+	if err != nil {
+		tx.Rollback()
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return nil, err
+	}
+	return users, nil
+}
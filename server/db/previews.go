@@ -0,0 +1,45 @@
+package db
+
+import (
+	"time"
+
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// UsePreviewImage gets the stored copy of the preview image at a URL, and
+// marks it as used so that it isn't cleaned up while previews show it
+func (db *HarmonyDB) UsePreviewImage(url string) (string, error) {
+	fileID, err := db.queries.UsePreviewImage(ctx, queries.UsePreviewImageParams{
+		Now: time.Now().UTC(),
+		Url: url,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return fileID, err
+}
+
+// AddPreviewImage records the stored copy of the preview image at a URL. If
+// another copy was recorded first, that one is returned instead.
+func (db *HarmonyDB) AddPreviewImage(url, fileID string) (string, error) {
+	stored, err := db.queries.AddPreviewImage(ctx, queries.AddPreviewImageParams{
+		Url:    url,
+		Fileid: fileID,
+		Now:    time.Now().UTC(),
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return stored, err
+}
+
+// ExpirePreviewImages forgets up to limit preview images that no message
+// shows and that weren't used since usedBefore, returning their files
+func (db *HarmonyDB) ExpirePreviewImages(usedBefore time.Time, limit int32) ([]string, error) {
+	fileIDs, err := db.queries.ExpirePreviewImages(ctx, queries.ExpirePreviewImagesParams{
+		Usedbefore: usedBefore,
+		Max:        limit,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return fileIDs, err
+}
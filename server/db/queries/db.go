@@ -22,9 +22,15 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.ackChannelStmt, err = db.PrepareContext(ctx, ackChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query AckChannel: %w", err)
+	}
 	if q.acquireEmotePackStmt, err = db.PrepareContext(ctx, acquireEmotePack); err != nil {
 		return nil, fmt.Errorf("error preparing query AcquireEmotePack: %w", err)
 	}
+	if q.addAutomodRuleStmt, err = db.PrepareContext(ctx, addAutomodRule); err != nil {
+		return nil, fmt.Errorf("error preparing query AddAutomodRule: %w", err)
+	}
 	if q.addEmoteToPackStmt, err = db.PrepareContext(ctx, addEmoteToPack); err != nil {
 		return nil, fmt.Errorf("error preparing query AddEmoteToPack: %w", err)
 	}
@@ -40,18 +46,45 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addLocalUserStmt, err = db.PrepareContext(ctx, addLocalUser); err != nil {
 		return nil, fmt.Errorf("error preparing query AddLocalUser: %w", err)
 	}
+	if q.addMentionsStmt, err = db.PrepareContext(ctx, addMentions); err != nil {
+		return nil, fmt.Errorf("error preparing query AddMentions: %w", err)
+	}
 	if q.addMessageStmt, err = db.PrepareContext(ctx, addMessage); err != nil {
 		return nil, fmt.Errorf("error preparing query AddMessage: %w", err)
 	}
+	if q.addMessagePreviewImagesStmt, err = db.PrepareContext(ctx, addMessagePreviewImages); err != nil {
+		return nil, fmt.Errorf("error preparing query AddMessagePreviewImages: %w", err)
+	}
+	if q.addMessageRevisionStmt, err = db.PrepareContext(ctx, addMessageRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query AddMessageRevision: %w", err)
+	}
 	if q.addNonceStmt, err = db.PrepareContext(ctx, addNonce); err != nil {
 		return nil, fmt.Errorf("error preparing query AddNonce: %w", err)
 	}
+	if q.addPresenceInstanceStmt, err = db.PrepareContext(ctx, addPresenceInstance); err != nil {
+		return nil, fmt.Errorf("error preparing query AddPresenceInstance: %w", err)
+	}
+	if q.addPreviewImageStmt, err = db.PrepareContext(ctx, addPreviewImage); err != nil {
+		return nil, fmt.Errorf("error preparing query AddPreviewImage: %w", err)
+	}
 	if q.addProfileStmt, err = db.PrepareContext(ctx, addProfile); err != nil {
 		return nil, fmt.Errorf("error preparing query AddProfile: %w", err)
 	}
+	if q.addQueuedEventStmt, err = db.PrepareContext(ctx, addQueuedEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query AddQueuedEvent: %w", err)
+	}
+	if q.addReactionStmt, err = db.PrepareContext(ctx, addReaction); err != nil {
+		return nil, fmt.Errorf("error preparing query AddReaction: %w", err)
+	}
+	if q.addScheduledMessageStmt, err = db.PrepareContext(ctx, addScheduledMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query AddScheduledMessage: %w", err)
+	}
 	if q.addSessionStmt, err = db.PrepareContext(ctx, addSession); err != nil {
 		return nil, fmt.Errorf("error preparing query AddSession: %w", err)
 	}
+	if q.addStreamTicketStmt, err = db.PrepareContext(ctx, addStreamTicket); err != nil {
+		return nil, fmt.Errorf("error preparing query AddStreamTicket: %w", err)
+	}
 	if q.addToGuildListStmt, err = db.PrepareContext(ctx, addToGuildList); err != nil {
 		return nil, fmt.Errorf("error preparing query AddToGuildList: %w", err)
 	}
@@ -64,6 +97,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addUserToRoleStmt, err = db.PrepareContext(ctx, addUserToRole); err != nil {
 		return nil, fmt.Errorf("error preparing query AddUserToRole: %w", err)
 	}
+	if q.archiveInactiveThreadsStmt, err = db.PrepareContext(ctx, archiveInactiveThreads); err != nil {
+		return nil, fmt.Errorf("error preparing query ArchiveInactiveThreads: %w", err)
+	}
+	if q.bulkDeleteFilteredMessagesStmt, err = db.PrepareContext(ctx, bulkDeleteFilteredMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query BulkDeleteFilteredMessages: %w", err)
+	}
+	if q.bulkDeleteMessagesStmt, err = db.PrepareContext(ctx, bulkDeleteMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query BulkDeleteMessages: %w", err)
+	}
+	if q.cancelScheduledMessageStmt, err = db.PrepareContext(ctx, cancelScheduledMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelScheduledMessage: %w", err)
+	}
+	if q.claimDueScheduledMessagesStmt, err = db.PrepareContext(ctx, claimDueScheduledMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimDueScheduledMessages: %w", err)
+	}
+	if q.claimSlowModeCooldownStmt, err = db.PrepareContext(ctx, claimSlowModeCooldown); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimSlowModeCooldown: %w", err)
+	}
+	if q.clearChannelRetentionStmt, err = db.PrepareContext(ctx, clearChannelRetention); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearChannelRetention: %w", err)
+	}
+	if q.clearSlowModeCooldownsStmt, err = db.PrepareContext(ctx, clearSlowModeCooldowns); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearSlowModeCooldowns: %w", err)
+	}
+	if q.countReactionStmt, err = db.PrepareContext(ctx, countReaction); err != nil {
+		return nil, fmt.Errorf("error preparing query CountReaction: %w", err)
+	}
+	if q.countScheduledMessagesStmt, err = db.PrepareContext(ctx, countScheduledMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query CountScheduledMessages: %w", err)
+	}
 	if q.createChannelStmt, err = db.PrepareContext(ctx, createChannel); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateChannel: %w", err)
 	}
@@ -79,6 +142,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createRoleStmt, err = db.PrepareContext(ctx, createRole); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRole: %w", err)
 	}
+	if q.createThreadStmt, err = db.PrepareContext(ctx, createThread); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateThread: %w", err)
+	}
+	if q.deleteAutomodRuleStmt, err = db.PrepareContext(ctx, deleteAutomodRule); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAutomodRule: %w", err)
+	}
 	if q.deleteChannelStmt, err = db.PrepareContext(ctx, deleteChannel); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteChannel: %w", err)
 	}
@@ -88,6 +157,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteEmotePackStmt, err = db.PrepareContext(ctx, deleteEmotePack); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteEmotePack: %w", err)
 	}
+	if q.deleteFileHashesStmt, err = db.PrepareContext(ctx, deleteFileHashes); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteFileHashes: %w", err)
+	}
 	if q.deleteFileMetadataStmt, err = db.PrepareContext(ctx, deleteFileMetadata); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteFileMetadata: %w", err)
 	}
@@ -109,18 +181,63 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.emailExistsStmt, err = db.PrepareContext(ctx, emailExists); err != nil {
 		return nil, fmt.Errorf("error preparing query EmailExists: %w", err)
 	}
+	if q.expireMemberTimeoutsStmt, err = db.PrepareContext(ctx, expireMemberTimeouts); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpireMemberTimeouts: %w", err)
+	}
+	if q.expireMessageRevisionsStmt, err = db.PrepareContext(ctx, expireMessageRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpireMessageRevisions: %w", err)
+	}
+	if q.expirePresenceInstancesStmt, err = db.PrepareContext(ctx, expirePresenceInstances); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpirePresenceInstances: %w", err)
+	}
+	if q.expirePresenceStreamsStmt, err = db.PrepareContext(ctx, expirePresenceStreams); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpirePresenceStreams: %w", err)
+	}
+	if q.expirePreviewImagesStmt, err = db.PrepareContext(ctx, expirePreviewImages); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpirePreviewImages: %w", err)
+	}
+	if q.expireQueuedEventsStmt, err = db.PrepareContext(ctx, expireQueuedEvents); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpireQueuedEvents: %w", err)
+	}
 	if q.expireSessionsStmt, err = db.PrepareContext(ctx, expireSessions); err != nil {
 		return nil, fmt.Errorf("error preparing query ExpireSessions: %w", err)
 	}
+	if q.expireStreamTicketsStmt, err = db.PrepareContext(ctx, expireStreamTickets); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpireStreamTickets: %w", err)
+	}
+	if q.fileInUseStmt, err = db.PrepareContext(ctx, fileInUse); err != nil {
+		return nil, fmt.Errorf("error preparing query FileInUse: %w", err)
+	}
+	if q.finishScheduledMessageStmt, err = db.PrepareContext(ctx, finishScheduledMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query FinishScheduledMessage: %w", err)
+	}
+	if q.getActiveThreadsStmt, err = db.PrepareContext(ctx, getActiveThreads); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveThreads: %w", err)
+	}
+	if q.getAutomodRulesStmt, err = db.PrepareContext(ctx, getAutomodRules); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAutomodRules: %w", err)
+	}
 	if q.getAvatarStmt, err = db.PrepareContext(ctx, getAvatar); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAvatar: %w", err)
 	}
 	if q.getChannelPositionStmt, err = db.PrepareContext(ctx, getChannelPosition); err != nil {
 		return nil, fmt.Errorf("error preparing query GetChannelPosition: %w", err)
 	}
+	if q.getChannelRetentionsStmt, err = db.PrepareContext(ctx, getChannelRetentions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetChannelRetentions: %w", err)
+	}
+	if q.getChannelSlowModeStmt, err = db.PrepareContext(ctx, getChannelSlowMode); err != nil {
+		return nil, fmt.Errorf("error preparing query GetChannelSlowMode: %w", err)
+	}
 	if q.getChannelsStmt, err = db.PrepareContext(ctx, getChannels); err != nil {
 		return nil, fmt.Errorf("error preparing query GetChannels: %w", err)
 	}
+	if q.getChosenStatusStmt, err = db.PrepareContext(ctx, getChosenStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query GetChosenStatus: %w", err)
+	}
+	if q.getDeletedMessagesStmt, err = db.PrepareContext(ctx, getDeletedMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDeletedMessages: %w", err)
+	}
 	if q.getEmotePackEmotesStmt, err = db.PrepareContext(ctx, getEmotePackEmotes); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEmotePackEmotes: %w", err)
 	}
@@ -151,24 +268,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getGuildPictureStmt, err = db.PrepareContext(ctx, getGuildPicture); err != nil {
 		return nil, fmt.Errorf("error preparing query GetGuildPicture: %w", err)
 	}
+	if q.getGuildRetentionStmt, err = db.PrepareContext(ctx, getGuildRetention); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGuildRetention: %w", err)
+	}
 	if q.getLastGuildPositionInListStmt, err = db.PrepareContext(ctx, getLastGuildPositionInList); err != nil {
 		return nil, fmt.Errorf("error preparing query GetLastGuildPositionInList: %w", err)
 	}
 	if q.getLocalUserIDStmt, err = db.PrepareContext(ctx, getLocalUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetLocalUserID: %w", err)
 	}
+	if q.getMemberTimeoutStmt, err = db.PrepareContext(ctx, getMemberTimeout); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMemberTimeout: %w", err)
+	}
+	if q.getMentionsStmt, err = db.PrepareContext(ctx, getMentions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMentions: %w", err)
+	}
 	if q.getMessageStmt, err = db.PrepareContext(ctx, getMessage); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMessage: %w", err)
 	}
 	if q.getMessageAuthorStmt, err = db.PrepareContext(ctx, getMessageAuthor); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMessageAuthor: %w", err)
 	}
-	if q.getMessageDateStmt, err = db.PrepareContext(ctx, getMessageDate); err != nil {
-		return nil, fmt.Errorf("error preparing query GetMessageDate: %w", err)
+	if q.getMessageRevisionsStmt, err = db.PrepareContext(ctx, getMessageRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMessageRevisions: %w", err)
 	}
 	if q.getMessagesStmt, err = db.PrepareContext(ctx, getMessages); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMessages: %w", err)
 	}
+	if q.getMessagesAfterStmt, err = db.PrepareContext(ctx, getMessagesAfter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMessagesAfter: %w", err)
+	}
+	if q.getMessagesBeforeStmt, err = db.PrepareContext(ctx, getMessagesBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMessagesBefore: %w", err)
+	}
 	if q.getNonceInfoStmt, err = db.PrepareContext(ctx, getNonceInfo); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNonceInfo: %w", err)
 	}
@@ -187,12 +319,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getPermissionsWithoutRoleStmt, err = db.PrepareContext(ctx, getPermissionsWithoutRole); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPermissionsWithoutRole: %w", err)
 	}
+	if q.getPinnedMessagesStmt, err = db.PrepareContext(ctx, getPinnedMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPinnedMessages: %w", err)
+	}
+	if q.getQueuedEventStmt, err = db.PrepareContext(ctx, getQueuedEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query GetQueuedEvent: %w", err)
+	}
+	if q.getReactionsStmt, err = db.PrepareContext(ctx, getReactions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReactions: %w", err)
+	}
+	if q.getReadStatesStmt, err = db.PrepareContext(ctx, getReadStates); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReadStates: %w", err)
+	}
 	if q.getRolePositionStmt, err = db.PrepareContext(ctx, getRolePosition); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRolePosition: %w", err)
 	}
 	if q.getRolesForGuildStmt, err = db.PrepareContext(ctx, getRolesForGuild); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRolesForGuild: %w", err)
 	}
+	if q.getScheduledMessageStmt, err = db.PrepareContext(ctx, getScheduledMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query GetScheduledMessage: %w", err)
+	}
+	if q.getScheduledMessagesStmt, err = db.PrepareContext(ctx, getScheduledMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query GetScheduledMessages: %w", err)
+	}
+	if q.getSlowModeCooldownStmt, err = db.PrepareContext(ctx, getSlowModeCooldown); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSlowModeCooldown: %w", err)
+	}
+	if q.getThreadStmt, err = db.PrepareContext(ctx, getThread); err != nil {
+		return nil, fmt.Errorf("error preparing query GetThread: %w", err)
+	}
+	if q.getThreadMessagesStmt, err = db.PrepareContext(ctx, getThreadMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query GetThreadMessages: %w", err)
+	}
 	if q.getUserStmt, err = db.PrepareContext(ctx, getUser); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUser: %w", err)
 	}
@@ -211,6 +370,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.guildsForUserWithDataStmt, err = db.PrepareContext(ctx, guildsForUserWithData); err != nil {
 		return nil, fmt.Errorf("error preparing query GuildsForUserWithData: %w", err)
 	}
+	if q.hasEquippedEmoteStmt, err = db.PrepareContext(ctx, hasEquippedEmote); err != nil {
+		return nil, fmt.Errorf("error preparing query HasEquippedEmote: %w", err)
+	}
 	if q.incrementInviteStmt, err = db.PrepareContext(ctx, incrementInvite); err != nil {
 		return nil, fmt.Errorf("error preparing query IncrementInvite: %w", err)
 	}
@@ -232,6 +394,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.moveRoleStmt, err = db.PrepareContext(ctx, moveRole); err != nil {
 		return nil, fmt.Errorf("error preparing query MoveRole: %w", err)
 	}
+	if q.notifyStmt, err = db.PrepareContext(ctx, notify); err != nil {
+		return nil, fmt.Errorf("error preparing query Notify: %w", err)
+	}
 	if q.numChannelsWithIDStmt, err = db.PrepareContext(ctx, numChannelsWithID); err != nil {
 		return nil, fmt.Errorf("error preparing query NumChannelsWithID: %w", err)
 	}
@@ -250,33 +415,93 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.permissionsExistsWithoutRoleStmt, err = db.PrepareContext(ctx, permissionsExistsWithoutRole); err != nil {
 		return nil, fmt.Errorf("error preparing query PermissionsExistsWithoutRole: %w", err)
 	}
+	if q.pinMessageStmt, err = db.PrepareContext(ctx, pinMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query PinMessage: %w", err)
+	}
+	if q.pruneExpiredMessagesStmt, err = db.PrepareContext(ctx, pruneExpiredMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query PruneExpiredMessages: %w", err)
+	}
+	if q.purgeDeletedMessagesStmt, err = db.PrepareContext(ctx, purgeDeletedMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query PurgeDeletedMessages: %w", err)
+	}
+	if q.redeemStreamTicketStmt, err = db.PrepareContext(ctx, redeemStreamTicket); err != nil {
+		return nil, fmt.Errorf("error preparing query RedeemStreamTicket: %w", err)
+	}
+	if q.releaseSlowModeCooldownStmt, err = db.PrepareContext(ctx, releaseSlowModeCooldown); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseSlowModeCooldown: %w", err)
+	}
 	if q.removeGuildFromListStmt, err = db.PrepareContext(ctx, removeGuildFromList); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveGuildFromList: %w", err)
 	}
+	if q.removePresenceStreamsStmt, err = db.PrepareContext(ctx, removePresenceStreams); err != nil {
+		return nil, fmt.Errorf("error preparing query RemovePresenceStreams: %w", err)
+	}
+	if q.removeReactionStmt, err = db.PrepareContext(ctx, removeReaction); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveReaction: %w", err)
+	}
+	if q.removeStaleMentionsStmt, err = db.PrepareContext(ctx, removeStaleMentions); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveStaleMentions: %w", err)
+	}
 	if q.removeUserFromGuildStmt, err = db.PrepareContext(ctx, removeUserFromGuild); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveUserFromGuild: %w", err)
 	}
 	if q.removeUserFromRoleStmt, err = db.PrepareContext(ctx, removeUserFromRole); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveUserFromRole: %w", err)
 	}
+	if q.resetStatusesStmt, err = db.PrepareContext(ctx, resetStatuses); err != nil {
+		return nil, fmt.Errorf("error preparing query ResetStatuses: %w", err)
+	}
 	if q.resolveGuildIDStmt, err = db.PrepareContext(ctx, resolveGuildID); err != nil {
 		return nil, fmt.Errorf("error preparing query ResolveGuildID: %w", err)
 	}
+	if q.resolveMentionsStmt, err = db.PrepareContext(ctx, resolveMentions); err != nil {
+		return nil, fmt.Errorf("error preparing query ResolveMentions: %w", err)
+	}
+	if q.restoreMessageStmt, err = db.PrepareContext(ctx, restoreMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query RestoreMessage: %w", err)
+	}
+	if q.retryScheduledMessageStmt, err = db.PrepareContext(ctx, retryScheduledMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query RetryScheduledMessage: %w", err)
+	}
 	if q.rolesForUserStmt, err = db.PrepareContext(ctx, rolesForUser); err != nil {
 		return nil, fmt.Errorf("error preparing query RolesForUser: %w", err)
 	}
+	if q.scheduledMessageSentStmt, err = db.PrepareContext(ctx, scheduledMessageSent); err != nil {
+		return nil, fmt.Errorf("error preparing query ScheduledMessageSent: %w", err)
+	}
+	if q.searchMessagesStmt, err = db.PrepareContext(ctx, searchMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query SearchMessages: %w", err)
+	}
 	if q.sessionToUserIDStmt, err = db.PrepareContext(ctx, sessionToUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query SessionToUserID: %w", err)
 	}
+	if q.setChannelRetentionStmt, err = db.PrepareContext(ctx, setChannelRetention); err != nil {
+		return nil, fmt.Errorf("error preparing query SetChannelRetention: %w", err)
+	}
+	if q.setChannelSlowModeStmt, err = db.PrepareContext(ctx, setChannelSlowMode); err != nil {
+		return nil, fmt.Errorf("error preparing query SetChannelSlowMode: %w", err)
+	}
+	if q.setChosenStatusStmt, err = db.PrepareContext(ctx, setChosenStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query SetChosenStatus: %w", err)
+	}
 	if q.setGuildNameStmt, err = db.PrepareContext(ctx, setGuildName); err != nil {
 		return nil, fmt.Errorf("error preparing query SetGuildName: %w", err)
 	}
 	if q.setGuildPictureStmt, err = db.PrepareContext(ctx, setGuildPicture); err != nil {
 		return nil, fmt.Errorf("error preparing query SetGuildPicture: %w", err)
 	}
+	if q.setGuildRetentionStmt, err = db.PrepareContext(ctx, setGuildRetention); err != nil {
+		return nil, fmt.Errorf("error preparing query SetGuildRetention: %w", err)
+	}
+	if q.setMessageEmbedsStmt, err = db.PrepareContext(ctx, setMessageEmbeds); err != nil {
+		return nil, fmt.Errorf("error preparing query SetMessageEmbeds: %w", err)
+	}
 	if q.setPermissionsStmt, err = db.PrepareContext(ctx, setPermissions); err != nil {
 		return nil, fmt.Errorf("error preparing query SetPermissions: %w", err)
 	}
+	if q.setPresenceStreamsStmt, err = db.PrepareContext(ctx, setPresenceStreams); err != nil {
+		return nil, fmt.Errorf("error preparing query SetPresenceStreams: %w", err)
+	}
 	if q.setRoleColorStmt, err = db.PrepareContext(ctx, setRoleColor); err != nil {
 		return nil, fmt.Errorf("error preparing query SetRoleColor: %w", err)
 	}
@@ -289,9 +514,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.setRolePingableStmt, err = db.PrepareContext(ctx, setRolePingable); err != nil {
 		return nil, fmt.Errorf("error preparing query SetRolePingable: %w", err)
 	}
+	if q.setScheduledMessageIDStmt, err = db.PrepareContext(ctx, setScheduledMessageID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetScheduledMessageID: %w", err)
+	}
 	if q.setStatusStmt, err = db.PrepareContext(ctx, setStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query SetStatus: %w", err)
 	}
+	if q.timeoutMemberStmt, err = db.PrepareContext(ctx, timeoutMember); err != nil {
+		return nil, fmt.Errorf("error preparing query TimeoutMember: %w", err)
+	}
+	if q.touchPresenceInstanceStmt, err = db.PrepareContext(ctx, touchPresenceInstance); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchPresenceInstance: %w", err)
+	}
+	if q.touchThreadStmt, err = db.PrepareContext(ctx, touchThread); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchThread: %w", err)
+	}
+	if q.trimMessageRevisionsStmt, err = db.PrepareContext(ctx, trimMessageRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query TrimMessageRevisions: %w", err)
+	}
+	if q.unpinMessageStmt, err = db.PrepareContext(ctx, unpinMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query UnpinMessage: %w", err)
+	}
+	if q.unpinMessagesStmt, err = db.PrepareContext(ctx, unpinMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query UnpinMessages: %w", err)
+	}
+	if q.updateAutomodRuleStmt, err = db.PrepareContext(ctx, updateAutomodRule); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateAutomodRule: %w", err)
+	}
 	if q.updateAvatarStmt, err = db.PrepareContext(ctx, updateAvatar); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateAvatar: %w", err)
 	}
@@ -325,9 +574,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updatePermissionsWithoutRoleStmt, err = db.PrepareContext(ctx, updatePermissionsWithoutRole); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdatePermissionsWithoutRole: %w", err)
 	}
+	if q.updateScheduledMessageStmt, err = db.PrepareContext(ctx, updateScheduledMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateScheduledMessage: %w", err)
+	}
 	if q.updateUsernameStmt, err = db.PrepareContext(ctx, updateUsername); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUsername: %w", err)
 	}
+	if q.usePreviewImageStmt, err = db.PrepareContext(ctx, usePreviewImage); err != nil {
+		return nil, fmt.Errorf("error preparing query UsePreviewImage: %w", err)
+	}
+	if q.userHasStreamsStmt, err = db.PrepareContext(ctx, userHasStreams); err != nil {
+		return nil, fmt.Errorf("error preparing query UserHasStreams: %w", err)
+	}
 	if q.userInGuildStmt, err = db.PrepareContext(ctx, userInGuild); err != nil {
 		return nil, fmt.Errorf("error preparing query UserInGuild: %w", err)
 	}
@@ -339,11 +597,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 
 func (q *Queries) Close() error {
 	var err error
+	if q.ackChannelStmt != nil {
+		if cerr := q.ackChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing ackChannelStmt: %w", cerr)
+		}
+	}
 	if q.acquireEmotePackStmt != nil {
 		if cerr := q.acquireEmotePackStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing acquireEmotePackStmt: %w", cerr)
 		}
 	}
+	if q.addAutomodRuleStmt != nil {
+		if cerr := q.addAutomodRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addAutomodRuleStmt: %w", cerr)
+		}
+	}
 	if q.addEmoteToPackStmt != nil {
 		if cerr := q.addEmoteToPackStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addEmoteToPackStmt: %w", cerr)
@@ -369,26 +637,71 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addLocalUserStmt: %w", cerr)
 		}
 	}
+	if q.addMentionsStmt != nil {
+		if cerr := q.addMentionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addMentionsStmt: %w", cerr)
+		}
+	}
 	if q.addMessageStmt != nil {
 		if cerr := q.addMessageStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addMessageStmt: %w", cerr)
 		}
 	}
+	if q.addMessagePreviewImagesStmt != nil {
+		if cerr := q.addMessagePreviewImagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addMessagePreviewImagesStmt: %w", cerr)
+		}
+	}
+	if q.addMessageRevisionStmt != nil {
+		if cerr := q.addMessageRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addMessageRevisionStmt: %w", cerr)
+		}
+	}
 	if q.addNonceStmt != nil {
 		if cerr := q.addNonceStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addNonceStmt: %w", cerr)
 		}
 	}
+	if q.addPresenceInstanceStmt != nil {
+		if cerr := q.addPresenceInstanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addPresenceInstanceStmt: %w", cerr)
+		}
+	}
+	if q.addPreviewImageStmt != nil {
+		if cerr := q.addPreviewImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addPreviewImageStmt: %w", cerr)
+		}
+	}
 	if q.addProfileStmt != nil {
 		if cerr := q.addProfileStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addProfileStmt: %w", cerr)
 		}
 	}
+	if q.addQueuedEventStmt != nil {
+		if cerr := q.addQueuedEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addQueuedEventStmt: %w", cerr)
+		}
+	}
+	if q.addReactionStmt != nil {
+		if cerr := q.addReactionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addReactionStmt: %w", cerr)
+		}
+	}
+	if q.addScheduledMessageStmt != nil {
+		if cerr := q.addScheduledMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addScheduledMessageStmt: %w", cerr)
+		}
+	}
 	if q.addSessionStmt != nil {
 		if cerr := q.addSessionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addSessionStmt: %w", cerr)
 		}
 	}
+	if q.addStreamTicketStmt != nil {
+		if cerr := q.addStreamTicketStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addStreamTicketStmt: %w", cerr)
+		}
+	}
 	if q.addToGuildListStmt != nil {
 		if cerr := q.addToGuildListStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addToGuildListStmt: %w", cerr)
@@ -409,6 +722,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addUserToRoleStmt: %w", cerr)
 		}
 	}
+	if q.archiveInactiveThreadsStmt != nil {
+		if cerr := q.archiveInactiveThreadsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing archiveInactiveThreadsStmt: %w", cerr)
+		}
+	}
+	if q.bulkDeleteFilteredMessagesStmt != nil {
+		if cerr := q.bulkDeleteFilteredMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing bulkDeleteFilteredMessagesStmt: %w", cerr)
+		}
+	}
+	if q.bulkDeleteMessagesStmt != nil {
+		if cerr := q.bulkDeleteMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing bulkDeleteMessagesStmt: %w", cerr)
+		}
+	}
+	if q.cancelScheduledMessageStmt != nil {
+		if cerr := q.cancelScheduledMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelScheduledMessageStmt: %w", cerr)
+		}
+	}
+	if q.claimDueScheduledMessagesStmt != nil {
+		if cerr := q.claimDueScheduledMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimDueScheduledMessagesStmt: %w", cerr)
+		}
+	}
+	if q.claimSlowModeCooldownStmt != nil {
+		if cerr := q.claimSlowModeCooldownStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimSlowModeCooldownStmt: %w", cerr)
+		}
+	}
+	if q.clearChannelRetentionStmt != nil {
+		if cerr := q.clearChannelRetentionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearChannelRetentionStmt: %w", cerr)
+		}
+	}
+	if q.clearSlowModeCooldownsStmt != nil {
+		if cerr := q.clearSlowModeCooldownsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearSlowModeCooldownsStmt: %w", cerr)
+		}
+	}
+	if q.countReactionStmt != nil {
+		if cerr := q.countReactionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countReactionStmt: %w", cerr)
+		}
+	}
+	if q.countScheduledMessagesStmt != nil {
+		if cerr := q.countScheduledMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countScheduledMessagesStmt: %w", cerr)
+		}
+	}
 	if q.createChannelStmt != nil {
 		if cerr := q.createChannelStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createChannelStmt: %w", cerr)
@@ -434,6 +797,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createRoleStmt: %w", cerr)
 		}
 	}
+	if q.createThreadStmt != nil {
+		if cerr := q.createThreadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createThreadStmt: %w", cerr)
+		}
+	}
+	if q.deleteAutomodRuleStmt != nil {
+		if cerr := q.deleteAutomodRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAutomodRuleStmt: %w", cerr)
+		}
+	}
 	if q.deleteChannelStmt != nil {
 		if cerr := q.deleteChannelStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteChannelStmt: %w", cerr)
@@ -449,6 +822,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteEmotePackStmt: %w", cerr)
 		}
 	}
+	if q.deleteFileHashesStmt != nil {
+		if cerr := q.deleteFileHashesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteFileHashesStmt: %w", cerr)
+		}
+	}
 	if q.deleteFileMetadataStmt != nil {
 		if cerr := q.deleteFileMetadataStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteFileMetadataStmt: %w", cerr)
@@ -484,11 +862,66 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing emailExistsStmt: %w", cerr)
 		}
 	}
+	if q.expireMemberTimeoutsStmt != nil {
+		if cerr := q.expireMemberTimeoutsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expireMemberTimeoutsStmt: %w", cerr)
+		}
+	}
+	if q.expireMessageRevisionsStmt != nil {
+		if cerr := q.expireMessageRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expireMessageRevisionsStmt: %w", cerr)
+		}
+	}
+	if q.expirePresenceInstancesStmt != nil {
+		if cerr := q.expirePresenceInstancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expirePresenceInstancesStmt: %w", cerr)
+		}
+	}
+	if q.expirePresenceStreamsStmt != nil {
+		if cerr := q.expirePresenceStreamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expirePresenceStreamsStmt: %w", cerr)
+		}
+	}
+	if q.expirePreviewImagesStmt != nil {
+		if cerr := q.expirePreviewImagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expirePreviewImagesStmt: %w", cerr)
+		}
+	}
+	if q.expireQueuedEventsStmt != nil {
+		if cerr := q.expireQueuedEventsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expireQueuedEventsStmt: %w", cerr)
+		}
+	}
 	if q.expireSessionsStmt != nil {
 		if cerr := q.expireSessionsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing expireSessionsStmt: %w", cerr)
 		}
 	}
+	if q.expireStreamTicketsStmt != nil {
+		if cerr := q.expireStreamTicketsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expireStreamTicketsStmt: %w", cerr)
+		}
+	}
+	if q.fileInUseStmt != nil {
+		if cerr := q.fileInUseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing fileInUseStmt: %w", cerr)
+		}
+	}
+	if q.finishScheduledMessageStmt != nil {
+		if cerr := q.finishScheduledMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing finishScheduledMessageStmt: %w", cerr)
+		}
+	}
+	if q.getActiveThreadsStmt != nil {
+		if cerr := q.getActiveThreadsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveThreadsStmt: %w", cerr)
+		}
+	}
+	if q.getAutomodRulesStmt != nil {
+		if cerr := q.getAutomodRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAutomodRulesStmt: %w", cerr)
+		}
+	}
 	if q.getAvatarStmt != nil {
 		if cerr := q.getAvatarStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAvatarStmt: %w", cerr)
@@ -499,11 +932,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getChannelPositionStmt: %w", cerr)
 		}
 	}
+	if q.getChannelRetentionsStmt != nil {
+		if cerr := q.getChannelRetentionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getChannelRetentionsStmt: %w", cerr)
+		}
+	}
+	if q.getChannelSlowModeStmt != nil {
+		if cerr := q.getChannelSlowModeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getChannelSlowModeStmt: %w", cerr)
+		}
+	}
 	if q.getChannelsStmt != nil {
 		if cerr := q.getChannelsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getChannelsStmt: %w", cerr)
 		}
 	}
+	if q.getChosenStatusStmt != nil {
+		if cerr := q.getChosenStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getChosenStatusStmt: %w", cerr)
+		}
+	}
+	if q.getDeletedMessagesStmt != nil {
+		if cerr := q.getDeletedMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDeletedMessagesStmt: %w", cerr)
+		}
+	}
 	if q.getEmotePackEmotesStmt != nil {
 		if cerr := q.getEmotePackEmotesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEmotePackEmotesStmt: %w", cerr)
@@ -554,6 +1007,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getGuildPictureStmt: %w", cerr)
 		}
 	}
+	if q.getGuildRetentionStmt != nil {
+		if cerr := q.getGuildRetentionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGuildRetentionStmt: %w", cerr)
+		}
+	}
 	if q.getLastGuildPositionInListStmt != nil {
 		if cerr := q.getLastGuildPositionInListStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getLastGuildPositionInListStmt: %w", cerr)
@@ -564,6 +1022,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getLocalUserIDStmt: %w", cerr)
 		}
 	}
+	if q.getMemberTimeoutStmt != nil {
+		if cerr := q.getMemberTimeoutStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMemberTimeoutStmt: %w", cerr)
+		}
+	}
+	if q.getMentionsStmt != nil {
+		if cerr := q.getMentionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMentionsStmt: %w", cerr)
+		}
+	}
 	if q.getMessageStmt != nil {
 		if cerr := q.getMessageStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getMessageStmt: %w", cerr)
@@ -574,9 +1042,9 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getMessageAuthorStmt: %w", cerr)
 		}
 	}
-	if q.getMessageDateStmt != nil {
-		if cerr := q.getMessageDateStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getMessageDateStmt: %w", cerr)
+	if q.getMessageRevisionsStmt != nil {
+		if cerr := q.getMessageRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMessageRevisionsStmt: %w", cerr)
 		}
 	}
 	if q.getMessagesStmt != nil {
@@ -584,6 +1052,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getMessagesStmt: %w", cerr)
 		}
 	}
+	if q.getMessagesAfterStmt != nil {
+		if cerr := q.getMessagesAfterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMessagesAfterStmt: %w", cerr)
+		}
+	}
+	if q.getMessagesBeforeStmt != nil {
+		if cerr := q.getMessagesBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMessagesBeforeStmt: %w", cerr)
+		}
+	}
 	if q.getNonceInfoStmt != nil {
 		if cerr := q.getNonceInfoStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getNonceInfoStmt: %w", cerr)
@@ -614,6 +1092,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPermissionsWithoutRoleStmt: %w", cerr)
 		}
 	}
+	if q.getPinnedMessagesStmt != nil {
+		if cerr := q.getPinnedMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPinnedMessagesStmt: %w", cerr)
+		}
+	}
+	if q.getQueuedEventStmt != nil {
+		if cerr := q.getQueuedEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getQueuedEventStmt: %w", cerr)
+		}
+	}
+	if q.getReactionsStmt != nil {
+		if cerr := q.getReactionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReactionsStmt: %w", cerr)
+		}
+	}
+	if q.getReadStatesStmt != nil {
+		if cerr := q.getReadStatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReadStatesStmt: %w", cerr)
+		}
+	}
 	if q.getRolePositionStmt != nil {
 		if cerr := q.getRolePositionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRolePositionStmt: %w", cerr)
@@ -624,6 +1122,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRolesForGuildStmt: %w", cerr)
 		}
 	}
+	if q.getScheduledMessageStmt != nil {
+		if cerr := q.getScheduledMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getScheduledMessageStmt: %w", cerr)
+		}
+	}
+	if q.getScheduledMessagesStmt != nil {
+		if cerr := q.getScheduledMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getScheduledMessagesStmt: %w", cerr)
+		}
+	}
+	if q.getSlowModeCooldownStmt != nil {
+		if cerr := q.getSlowModeCooldownStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSlowModeCooldownStmt: %w", cerr)
+		}
+	}
+	if q.getThreadStmt != nil {
+		if cerr := q.getThreadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getThreadStmt: %w", cerr)
+		}
+	}
+	if q.getThreadMessagesStmt != nil {
+		if cerr := q.getThreadMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getThreadMessagesStmt: %w", cerr)
+		}
+	}
 	if q.getUserStmt != nil {
 		if cerr := q.getUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserStmt: %w", cerr)
@@ -654,6 +1177,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing guildsForUserWithDataStmt: %w", cerr)
 		}
 	}
+	if q.hasEquippedEmoteStmt != nil {
+		if cerr := q.hasEquippedEmoteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing hasEquippedEmoteStmt: %w", cerr)
+		}
+	}
 	if q.incrementInviteStmt != nil {
 		if cerr := q.incrementInviteStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing incrementInviteStmt: %w", cerr)
@@ -689,6 +1217,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing moveRoleStmt: %w", cerr)
 		}
 	}
+	if q.notifyStmt != nil {
+		if cerr := q.notifyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing notifyStmt: %w", cerr)
+		}
+	}
 	if q.numChannelsWithIDStmt != nil {
 		if cerr := q.numChannelsWithIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing numChannelsWithIDStmt: %w", cerr)
@@ -719,11 +1252,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing permissionsExistsWithoutRoleStmt: %w", cerr)
 		}
 	}
+	if q.pinMessageStmt != nil {
+		if cerr := q.pinMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing pinMessageStmt: %w", cerr)
+		}
+	}
+	if q.pruneExpiredMessagesStmt != nil {
+		if cerr := q.pruneExpiredMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing pruneExpiredMessagesStmt: %w", cerr)
+		}
+	}
+	if q.purgeDeletedMessagesStmt != nil {
+		if cerr := q.purgeDeletedMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing purgeDeletedMessagesStmt: %w", cerr)
+		}
+	}
+	if q.redeemStreamTicketStmt != nil {
+		if cerr := q.redeemStreamTicketStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing redeemStreamTicketStmt: %w", cerr)
+		}
+	}
+	if q.releaseSlowModeCooldownStmt != nil {
+		if cerr := q.releaseSlowModeCooldownStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseSlowModeCooldownStmt: %w", cerr)
+		}
+	}
 	if q.removeGuildFromListStmt != nil {
 		if cerr := q.removeGuildFromListStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeGuildFromListStmt: %w", cerr)
 		}
 	}
+	if q.removePresenceStreamsStmt != nil {
+		if cerr := q.removePresenceStreamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removePresenceStreamsStmt: %w", cerr)
+		}
+	}
+	if q.removeReactionStmt != nil {
+		if cerr := q.removeReactionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeReactionStmt: %w", cerr)
+		}
+	}
+	if q.removeStaleMentionsStmt != nil {
+		if cerr := q.removeStaleMentionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeStaleMentionsStmt: %w", cerr)
+		}
+	}
 	if q.removeUserFromGuildStmt != nil {
 		if cerr := q.removeUserFromGuildStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeUserFromGuildStmt: %w", cerr)
@@ -734,21 +1307,66 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing removeUserFromRoleStmt: %w", cerr)
 		}
 	}
+	if q.resetStatusesStmt != nil {
+		if cerr := q.resetStatusesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resetStatusesStmt: %w", cerr)
+		}
+	}
 	if q.resolveGuildIDStmt != nil {
 		if cerr := q.resolveGuildIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing resolveGuildIDStmt: %w", cerr)
 		}
 	}
+	if q.resolveMentionsStmt != nil {
+		if cerr := q.resolveMentionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resolveMentionsStmt: %w", cerr)
+		}
+	}
+	if q.restoreMessageStmt != nil {
+		if cerr := q.restoreMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing restoreMessageStmt: %w", cerr)
+		}
+	}
+	if q.retryScheduledMessageStmt != nil {
+		if cerr := q.retryScheduledMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing retryScheduledMessageStmt: %w", cerr)
+		}
+	}
 	if q.rolesForUserStmt != nil {
 		if cerr := q.rolesForUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing rolesForUserStmt: %w", cerr)
 		}
 	}
+	if q.scheduledMessageSentStmt != nil {
+		if cerr := q.scheduledMessageSentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing scheduledMessageSentStmt: %w", cerr)
+		}
+	}
+	if q.searchMessagesStmt != nil {
+		if cerr := q.searchMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing searchMessagesStmt: %w", cerr)
+		}
+	}
 	if q.sessionToUserIDStmt != nil {
 		if cerr := q.sessionToUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing sessionToUserIDStmt: %w", cerr)
 		}
 	}
+	if q.setChannelRetentionStmt != nil {
+		if cerr := q.setChannelRetentionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setChannelRetentionStmt: %w", cerr)
+		}
+	}
+	if q.setChannelSlowModeStmt != nil {
+		if cerr := q.setChannelSlowModeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setChannelSlowModeStmt: %w", cerr)
+		}
+	}
+	if q.setChosenStatusStmt != nil {
+		if cerr := q.setChosenStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setChosenStatusStmt: %w", cerr)
+		}
+	}
 	if q.setGuildNameStmt != nil {
 		if cerr := q.setGuildNameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setGuildNameStmt: %w", cerr)
@@ -759,11 +1377,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setGuildPictureStmt: %w", cerr)
 		}
 	}
+	if q.setGuildRetentionStmt != nil {
+		if cerr := q.setGuildRetentionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setGuildRetentionStmt: %w", cerr)
+		}
+	}
+	if q.setMessageEmbedsStmt != nil {
+		if cerr := q.setMessageEmbedsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setMessageEmbedsStmt: %w", cerr)
+		}
+	}
 	if q.setPermissionsStmt != nil {
 		if cerr := q.setPermissionsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setPermissionsStmt: %w", cerr)
 		}
 	}
+	if q.setPresenceStreamsStmt != nil {
+		if cerr := q.setPresenceStreamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setPresenceStreamsStmt: %w", cerr)
+		}
+	}
 	if q.setRoleColorStmt != nil {
 		if cerr := q.setRoleColorStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setRoleColorStmt: %w", cerr)
@@ -784,11 +1417,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setRolePingableStmt: %w", cerr)
 		}
 	}
+	if q.setScheduledMessageIDStmt != nil {
+		if cerr := q.setScheduledMessageIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setScheduledMessageIDStmt: %w", cerr)
+		}
+	}
 	if q.setStatusStmt != nil {
 		if cerr := q.setStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setStatusStmt: %w", cerr)
 		}
 	}
+	if q.timeoutMemberStmt != nil {
+		if cerr := q.timeoutMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing timeoutMemberStmt: %w", cerr)
+		}
+	}
+	if q.touchPresenceInstanceStmt != nil {
+		if cerr := q.touchPresenceInstanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchPresenceInstanceStmt: %w", cerr)
+		}
+	}
+	if q.touchThreadStmt != nil {
+		if cerr := q.touchThreadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchThreadStmt: %w", cerr)
+		}
+	}
+	if q.trimMessageRevisionsStmt != nil {
+		if cerr := q.trimMessageRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing trimMessageRevisionsStmt: %w", cerr)
+		}
+	}
+	if q.unpinMessageStmt != nil {
+		if cerr := q.unpinMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unpinMessageStmt: %w", cerr)
+		}
+	}
+	if q.unpinMessagesStmt != nil {
+		if cerr := q.unpinMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unpinMessagesStmt: %w", cerr)
+		}
+	}
+	if q.updateAutomodRuleStmt != nil {
+		if cerr := q.updateAutomodRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateAutomodRuleStmt: %w", cerr)
+		}
+	}
 	if q.updateAvatarStmt != nil {
 		if cerr := q.updateAvatarStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateAvatarStmt: %w", cerr)
@@ -844,11 +1517,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updatePermissionsWithoutRoleStmt: %w", cerr)
 		}
 	}
+	if q.updateScheduledMessageStmt != nil {
+		if cerr := q.updateScheduledMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateScheduledMessageStmt: %w", cerr)
+		}
+	}
 	if q.updateUsernameStmt != nil {
 		if cerr := q.updateUsernameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUsernameStmt: %w", cerr)
 		}
 	}
+	if q.usePreviewImageStmt != nil {
+		if cerr := q.usePreviewImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing usePreviewImageStmt: %w", cerr)
+		}
+	}
+	if q.userHasStreamsStmt != nil {
+		if cerr := q.userHasStreamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing userHasStreamsStmt: %w", cerr)
+		}
+	}
 	if q.userInGuildStmt != nil {
 		if cerr := q.userInGuildStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing userInGuildStmt: %w", cerr)
@@ -898,28 +1586,52 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                                             DBTX
 	tx                                             *sql.Tx
+	ackChannelStmt                                 *sql.Stmt
 	acquireEmotePackStmt                           *sql.Stmt
+	addAutomodRuleStmt                             *sql.Stmt
 	addEmoteToPackStmt                             *sql.Stmt
 	addFileMetadataStmt                            *sql.Stmt
 	addForeignUserStmt                             *sql.Stmt
 	addHashStmt                                    *sql.Stmt
 	addLocalUserStmt                               *sql.Stmt
+	addMentionsStmt                                *sql.Stmt
 	addMessageStmt                                 *sql.Stmt
+	addMessagePreviewImagesStmt                    *sql.Stmt
+	addMessageRevisionStmt                         *sql.Stmt
 	addNonceStmt                                   *sql.Stmt
+	addPresenceInstanceStmt                        *sql.Stmt
+	addPreviewImageStmt                            *sql.Stmt
 	addProfileStmt                                 *sql.Stmt
+	addQueuedEventStmt                             *sql.Stmt
+	addReactionStmt                                *sql.Stmt
+	addScheduledMessageStmt                        *sql.Stmt
 	addSessionStmt                                 *sql.Stmt
+	addStreamTicketStmt                            *sql.Stmt
 	addToGuildListStmt                             *sql.Stmt
 	addUserStmt                                    *sql.Stmt
 	addUserToGuildStmt                             *sql.Stmt
 	addUserToRoleStmt                              *sql.Stmt
+	archiveInactiveThreadsStmt                     *sql.Stmt
+	bulkDeleteFilteredMessagesStmt                 *sql.Stmt
+	bulkDeleteMessagesStmt                         *sql.Stmt
+	cancelScheduledMessageStmt                     *sql.Stmt
+	claimDueScheduledMessagesStmt                  *sql.Stmt
+	claimSlowModeCooldownStmt                      *sql.Stmt
+	clearChannelRetentionStmt                      *sql.Stmt
+	clearSlowModeCooldownsStmt                     *sql.Stmt
+	countReactionStmt                              *sql.Stmt
+	countScheduledMessagesStmt                     *sql.Stmt
 	createChannelStmt                              *sql.Stmt
 	createEmotePackStmt                            *sql.Stmt
 	createGuildStmt                                *sql.Stmt
 	createGuildInviteStmt                          *sql.Stmt
 	createRoleStmt                                 *sql.Stmt
+	createThreadStmt                               *sql.Stmt
+	deleteAutomodRuleStmt                          *sql.Stmt
 	deleteChannelStmt                              *sql.Stmt
 	deleteEmoteFromPackStmt                        *sql.Stmt
 	deleteEmotePackStmt                            *sql.Stmt
+	deleteFileHashesStmt                           *sql.Stmt
 	deleteFileMetadataStmt                         *sql.Stmt
 	deleteGuildStmt                                *sql.Stmt
 	deleteInviteStmt                               *sql.Stmt
@@ -927,10 +1639,25 @@ type Queries struct {
 	deleteRoleStmt                                 *sql.Stmt
 	dequipEmotePackStmt                            *sql.Stmt
 	emailExistsStmt                                *sql.Stmt
+	expireMemberTimeoutsStmt                       *sql.Stmt
+	expireMessageRevisionsStmt                     *sql.Stmt
+	expirePresenceInstancesStmt                    *sql.Stmt
+	expirePresenceStreamsStmt                      *sql.Stmt
+	expirePreviewImagesStmt                        *sql.Stmt
+	expireQueuedEventsStmt                         *sql.Stmt
 	expireSessionsStmt                             *sql.Stmt
+	expireStreamTicketsStmt                        *sql.Stmt
+	fileInUseStmt                                  *sql.Stmt
+	finishScheduledMessageStmt                     *sql.Stmt
+	getActiveThreadsStmt                           *sql.Stmt
+	getAutomodRulesStmt                            *sql.Stmt
 	getAvatarStmt                                  *sql.Stmt
 	getChannelPositionStmt                         *sql.Stmt
+	getChannelRetentionsStmt                       *sql.Stmt
+	getChannelSlowModeStmt                         *sql.Stmt
 	getChannelsStmt                                *sql.Stmt
+	getChosenStatusStmt                            *sql.Stmt
+	getDeletedMessagesStmt                         *sql.Stmt
 	getEmotePackEmotesStmt                         *sql.Stmt
 	getEmotePacksStmt                              *sql.Stmt
 	getFileIDByHashStmt                            *sql.Stmt
@@ -941,26 +1668,41 @@ type Queries struct {
 	getGuildMembersStmt                            *sql.Stmt
 	getGuildOwnerStmt                              *sql.Stmt
 	getGuildPictureStmt                            *sql.Stmt
+	getGuildRetentionStmt                          *sql.Stmt
 	getLastGuildPositionInListStmt                 *sql.Stmt
 	getLocalUserIDStmt                             *sql.Stmt
+	getMemberTimeoutStmt                           *sql.Stmt
+	getMentionsStmt                                *sql.Stmt
 	getMessageStmt                                 *sql.Stmt
 	getMessageAuthorStmt                           *sql.Stmt
-	getMessageDateStmt                             *sql.Stmt
+	getMessageRevisionsStmt                        *sql.Stmt
 	getMessagesStmt                                *sql.Stmt
+	getMessagesAfterStmt                           *sql.Stmt
+	getMessagesBeforeStmt                          *sql.Stmt
 	getNonceInfoStmt                               *sql.Stmt
 	getPackOwnerStmt                               *sql.Stmt
 	getPermissionsStmt                             *sql.Stmt
 	getPermissionsWithoutChannelStmt               *sql.Stmt
 	getPermissionsWithoutChannelWithoutRoleStmt    *sql.Stmt
 	getPermissionsWithoutRoleStmt                  *sql.Stmt
+	getPinnedMessagesStmt                          *sql.Stmt
+	getQueuedEventStmt                             *sql.Stmt
+	getReactionsStmt                               *sql.Stmt
+	getReadStatesStmt                              *sql.Stmt
 	getRolePositionStmt                            *sql.Stmt
 	getRolesForGuildStmt                           *sql.Stmt
+	getScheduledMessageStmt                        *sql.Stmt
+	getScheduledMessagesStmt                       *sql.Stmt
+	getSlowModeCooldownStmt                        *sql.Stmt
+	getThreadStmt                                  *sql.Stmt
+	getThreadMessagesStmt                          *sql.Stmt
 	getUserStmt                                    *sql.Stmt
 	getUserByEmailStmt                             *sql.Stmt
 	getUserMetadataStmt                            *sql.Stmt
 	guildWithIDExistsStmt                          *sql.Stmt
 	guildsForUserStmt                              *sql.Stmt
 	guildsForUserWithDataStmt                      *sql.Stmt
+	hasEquippedEmoteStmt                           *sql.Stmt
 	incrementInviteStmt                            *sql.Stmt
 	isIPWhitelistedStmt                            *sql.Stmt
 	isUserWhitelistedStmt                          *sql.Stmt
@@ -968,26 +1710,55 @@ type Queries struct {
 	moveChannelStmt                                *sql.Stmt
 	moveGuildStmt                                  *sql.Stmt
 	moveRoleStmt                                   *sql.Stmt
+	notifyStmt                                     *sql.Stmt
 	numChannelsWithIDStmt                          *sql.Stmt
 	openInvitesStmt                                *sql.Stmt
 	permissionExistsWithoutChannelStmt             *sql.Stmt
 	permissionExistsWithoutChannelWithoutRoleStmt  *sql.Stmt
 	permissionsExistsStmt                          *sql.Stmt
 	permissionsExistsWithoutRoleStmt               *sql.Stmt
+	pinMessageStmt                                 *sql.Stmt
+	pruneExpiredMessagesStmt                       *sql.Stmt
+	purgeDeletedMessagesStmt                       *sql.Stmt
+	redeemStreamTicketStmt                         *sql.Stmt
+	releaseSlowModeCooldownStmt                    *sql.Stmt
 	removeGuildFromListStmt                        *sql.Stmt
+	removePresenceStreamsStmt                      *sql.Stmt
+	removeReactionStmt                             *sql.Stmt
+	removeStaleMentionsStmt                        *sql.Stmt
 	removeUserFromGuildStmt                        *sql.Stmt
 	removeUserFromRoleStmt                         *sql.Stmt
+	resetStatusesStmt                              *sql.Stmt
 	resolveGuildIDStmt                             *sql.Stmt
+	resolveMentionsStmt                            *sql.Stmt
+	restoreMessageStmt                             *sql.Stmt
+	retryScheduledMessageStmt                      *sql.Stmt
 	rolesForUserStmt                               *sql.Stmt
+	scheduledMessageSentStmt                       *sql.Stmt
+	searchMessagesStmt                             *sql.Stmt
 	sessionToUserIDStmt                            *sql.Stmt
+	setChannelRetentionStmt                        *sql.Stmt
+	setChannelSlowModeStmt                         *sql.Stmt
+	setChosenStatusStmt                            *sql.Stmt
 	setGuildNameStmt                               *sql.Stmt
 	setGuildPictureStmt                            *sql.Stmt
+	setGuildRetentionStmt                          *sql.Stmt
+	setMessageEmbedsStmt                           *sql.Stmt
 	setPermissionsStmt                             *sql.Stmt
+	setPresenceStreamsStmt                         *sql.Stmt
 	setRoleColorStmt                               *sql.Stmt
 	setRoleHoistStmt                               *sql.Stmt
 	setRoleNameStmt                                *sql.Stmt
 	setRolePingableStmt                            *sql.Stmt
+	setScheduledMessageIDStmt                      *sql.Stmt
 	setStatusStmt                                  *sql.Stmt
+	timeoutMemberStmt                              *sql.Stmt
+	touchPresenceInstanceStmt                      *sql.Stmt
+	touchThreadStmt                                *sql.Stmt
+	trimMessageRevisionsStmt                       *sql.Stmt
+	unpinMessageStmt                               *sql.Stmt
+	unpinMessagesStmt                              *sql.Stmt
+	updateAutomodRuleStmt                          *sql.Stmt
 	updateAvatarStmt                               *sql.Stmt
 	updateChannelNameStmt                          *sql.Stmt
 	updateMessageActionsStmt                       *sql.Stmt
@@ -999,78 +1770,135 @@ type Queries struct {
 	updatePermissionsWithoutChannelStmt            *sql.Stmt
 	updatePermissionsWithoutChannelWithoutRoleStmt *sql.Stmt
 	updatePermissionsWithoutRoleStmt               *sql.Stmt
+	updateScheduledMessageStmt                     *sql.Stmt
 	updateUsernameStmt                             *sql.Stmt
+	usePreviewImageStmt                            *sql.Stmt
+	userHasStreamsStmt                             *sql.Stmt
 	userInGuildStmt                                *sql.Stmt
 	userIsLocalStmt                                *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                               tx,
-		tx:                               tx,
-		acquireEmotePackStmt:             q.acquireEmotePackStmt,
-		addEmoteToPackStmt:               q.addEmoteToPackStmt,
-		addFileMetadataStmt:              q.addFileMetadataStmt,
-		addForeignUserStmt:               q.addForeignUserStmt,
-		addHashStmt:                      q.addHashStmt,
-		addLocalUserStmt:                 q.addLocalUserStmt,
-		addMessageStmt:                   q.addMessageStmt,
-		addNonceStmt:                     q.addNonceStmt,
-		addProfileStmt:                   q.addProfileStmt,
-		addSessionStmt:                   q.addSessionStmt,
-		addToGuildListStmt:               q.addToGuildListStmt,
-		addUserStmt:                      q.addUserStmt,
-		addUserToGuildStmt:               q.addUserToGuildStmt,
-		addUserToRoleStmt:                q.addUserToRoleStmt,
-		createChannelStmt:                q.createChannelStmt,
-		createEmotePackStmt:              q.createEmotePackStmt,
-		createGuildStmt:                  q.createGuildStmt,
-		createGuildInviteStmt:            q.createGuildInviteStmt,
-		createRoleStmt:                   q.createRoleStmt,
-		deleteChannelStmt:                q.deleteChannelStmt,
-		deleteEmoteFromPackStmt:          q.deleteEmoteFromPackStmt,
-		deleteEmotePackStmt:              q.deleteEmotePackStmt,
-		deleteFileMetadataStmt:           q.deleteFileMetadataStmt,
-		deleteGuildStmt:                  q.deleteGuildStmt,
-		deleteInviteStmt:                 q.deleteInviteStmt,
-		deleteMessageStmt:                q.deleteMessageStmt,
-		deleteRoleStmt:                   q.deleteRoleStmt,
-		dequipEmotePackStmt:              q.dequipEmotePackStmt,
-		emailExistsStmt:                  q.emailExistsStmt,
-		expireSessionsStmt:               q.expireSessionsStmt,
-		getAvatarStmt:                    q.getAvatarStmt,
-		getChannelPositionStmt:           q.getChannelPositionStmt,
-		getChannelsStmt:                  q.getChannelsStmt,
-		getEmotePackEmotesStmt:           q.getEmotePackEmotesStmt,
-		getEmotePacksStmt:                q.getEmotePacksStmt,
-		getFileIDByHashStmt:              q.getFileIDByHashStmt,
-		getFileMetadataStmt:              q.getFileMetadataStmt,
-		getGuildDataStmt:                 q.getGuildDataStmt,
-		getGuildListStmt:                 q.getGuildListStmt,
-		getGuildListPositionStmt:         q.getGuildListPositionStmt,
-		getGuildMembersStmt:              q.getGuildMembersStmt,
-		getGuildOwnerStmt:                q.getGuildOwnerStmt,
-		getGuildPictureStmt:              q.getGuildPictureStmt,
-		getLastGuildPositionInListStmt:   q.getLastGuildPositionInListStmt,
-		getLocalUserIDStmt:               q.getLocalUserIDStmt,
-		getMessageStmt:                   q.getMessageStmt,
-		getMessageAuthorStmt:             q.getMessageAuthorStmt,
-		getMessageDateStmt:               q.getMessageDateStmt,
-		getMessagesStmt:                  q.getMessagesStmt,
-		getNonceInfoStmt:                 q.getNonceInfoStmt,
-		getPackOwnerStmt:                 q.getPackOwnerStmt,
-		getPermissionsStmt:               q.getPermissionsStmt,
-		getPermissionsWithoutChannelStmt: q.getPermissionsWithoutChannelStmt,
+		db:                                             tx,
+		tx:                                             tx,
+		ackChannelStmt:                                 q.ackChannelStmt,
+		acquireEmotePackStmt:                           q.acquireEmotePackStmt,
+		addAutomodRuleStmt:                             q.addAutomodRuleStmt,
+		addEmoteToPackStmt:                             q.addEmoteToPackStmt,
+		addFileMetadataStmt:                            q.addFileMetadataStmt,
+		addForeignUserStmt:                             q.addForeignUserStmt,
+		addHashStmt:                                    q.addHashStmt,
+		addLocalUserStmt:                               q.addLocalUserStmt,
+		addMentionsStmt:                                q.addMentionsStmt,
+		addMessageStmt:                                 q.addMessageStmt,
+		addMessagePreviewImagesStmt:                    q.addMessagePreviewImagesStmt,
+		addMessageRevisionStmt:                         q.addMessageRevisionStmt,
+		addNonceStmt:                                   q.addNonceStmt,
+		addPresenceInstanceStmt:                        q.addPresenceInstanceStmt,
+		addPreviewImageStmt:                            q.addPreviewImageStmt,
+		addProfileStmt:                                 q.addProfileStmt,
+		addQueuedEventStmt:                             q.addQueuedEventStmt,
+		addReactionStmt:                                q.addReactionStmt,
+		addScheduledMessageStmt:                        q.addScheduledMessageStmt,
+		addSessionStmt:                                 q.addSessionStmt,
+		addStreamTicketStmt:                            q.addStreamTicketStmt,
+		addToGuildListStmt:                             q.addToGuildListStmt,
+		addUserStmt:                                    q.addUserStmt,
+		addUserToGuildStmt:                             q.addUserToGuildStmt,
+		addUserToRoleStmt:                              q.addUserToRoleStmt,
+		archiveInactiveThreadsStmt:                     q.archiveInactiveThreadsStmt,
+		bulkDeleteFilteredMessagesStmt:                 q.bulkDeleteFilteredMessagesStmt,
+		bulkDeleteMessagesStmt:                         q.bulkDeleteMessagesStmt,
+		cancelScheduledMessageStmt:                     q.cancelScheduledMessageStmt,
+		claimDueScheduledMessagesStmt:                  q.claimDueScheduledMessagesStmt,
+		claimSlowModeCooldownStmt:                      q.claimSlowModeCooldownStmt,
+		clearChannelRetentionStmt:                      q.clearChannelRetentionStmt,
+		clearSlowModeCooldownsStmt:                     q.clearSlowModeCooldownsStmt,
+		countReactionStmt:                              q.countReactionStmt,
+		countScheduledMessagesStmt:                     q.countScheduledMessagesStmt,
+		createChannelStmt:                              q.createChannelStmt,
+		createEmotePackStmt:                            q.createEmotePackStmt,
+		createGuildStmt:                                q.createGuildStmt,
+		createGuildInviteStmt:                          q.createGuildInviteStmt,
+		createRoleStmt:                                 q.createRoleStmt,
+		createThreadStmt:                               q.createThreadStmt,
+		deleteAutomodRuleStmt:                          q.deleteAutomodRuleStmt,
+		deleteChannelStmt:                              q.deleteChannelStmt,
+		deleteEmoteFromPackStmt:                        q.deleteEmoteFromPackStmt,
+		deleteEmotePackStmt:                            q.deleteEmotePackStmt,
+		deleteFileHashesStmt:                           q.deleteFileHashesStmt,
+		deleteFileMetadataStmt:                         q.deleteFileMetadataStmt,
+		deleteGuildStmt:                                q.deleteGuildStmt,
+		deleteInviteStmt:                               q.deleteInviteStmt,
+		deleteMessageStmt:                              q.deleteMessageStmt,
+		deleteRoleStmt:                                 q.deleteRoleStmt,
+		dequipEmotePackStmt:                            q.dequipEmotePackStmt,
+		emailExistsStmt:                                q.emailExistsStmt,
+		expireMemberTimeoutsStmt:                       q.expireMemberTimeoutsStmt,
+		expireMessageRevisionsStmt:                     q.expireMessageRevisionsStmt,
+		expirePresenceInstancesStmt:                    q.expirePresenceInstancesStmt,
+		expirePresenceStreamsStmt:                      q.expirePresenceStreamsStmt,
+		expirePreviewImagesStmt:                        q.expirePreviewImagesStmt,
+		expireQueuedEventsStmt:                         q.expireQueuedEventsStmt,
+		expireSessionsStmt:                             q.expireSessionsStmt,
+		expireStreamTicketsStmt:                        q.expireStreamTicketsStmt,
+		fileInUseStmt:                                  q.fileInUseStmt,
+		finishScheduledMessageStmt:                     q.finishScheduledMessageStmt,
+		getActiveThreadsStmt:                           q.getActiveThreadsStmt,
+		getAutomodRulesStmt:                            q.getAutomodRulesStmt,
+		getAvatarStmt:                                  q.getAvatarStmt,
+		getChannelPositionStmt:                         q.getChannelPositionStmt,
+		getChannelRetentionsStmt:                       q.getChannelRetentionsStmt,
+		getChannelSlowModeStmt:                         q.getChannelSlowModeStmt,
+		getChannelsStmt:                                q.getChannelsStmt,
+		getChosenStatusStmt:                            q.getChosenStatusStmt,
+		getDeletedMessagesStmt:                         q.getDeletedMessagesStmt,
+		getEmotePackEmotesStmt:                         q.getEmotePackEmotesStmt,
+		getEmotePacksStmt:                              q.getEmotePacksStmt,
+		getFileIDByHashStmt:                            q.getFileIDByHashStmt,
+		getFileMetadataStmt:                            q.getFileMetadataStmt,
+		getGuildDataStmt:                               q.getGuildDataStmt,
+		getGuildListStmt:                               q.getGuildListStmt,
+		getGuildListPositionStmt:                       q.getGuildListPositionStmt,
+		getGuildMembersStmt:                            q.getGuildMembersStmt,
+		getGuildOwnerStmt:                              q.getGuildOwnerStmt,
+		getGuildPictureStmt:                            q.getGuildPictureStmt,
+		getGuildRetentionStmt:                          q.getGuildRetentionStmt,
+		getLastGuildPositionInListStmt:                 q.getLastGuildPositionInListStmt,
+		getLocalUserIDStmt:                             q.getLocalUserIDStmt,
+		getMemberTimeoutStmt:                           q.getMemberTimeoutStmt,
+		getMentionsStmt:                                q.getMentionsStmt,
+		getMessageStmt:                                 q.getMessageStmt,
+		getMessageAuthorStmt:                           q.getMessageAuthorStmt,
+		getMessageRevisionsStmt:                        q.getMessageRevisionsStmt,
+		getMessagesStmt:                                q.getMessagesStmt,
+		getMessagesAfterStmt:                           q.getMessagesAfterStmt,
+		getMessagesBeforeStmt:                          q.getMessagesBeforeStmt,
+		getNonceInfoStmt:                               q.getNonceInfoStmt,
+		getPackOwnerStmt:                               q.getPackOwnerStmt,
+		getPermissionsStmt:                             q.getPermissionsStmt,
+		getPermissionsWithoutChannelStmt:               q.getPermissionsWithoutChannelStmt,
 		getPermissionsWithoutChannelWithoutRoleStmt:    q.getPermissionsWithoutChannelWithoutRoleStmt,
 		getPermissionsWithoutRoleStmt:                  q.getPermissionsWithoutRoleStmt,
+		getPinnedMessagesStmt:                          q.getPinnedMessagesStmt,
+		getQueuedEventStmt:                             q.getQueuedEventStmt,
+		getReactionsStmt:                               q.getReactionsStmt,
+		getReadStatesStmt:                              q.getReadStatesStmt,
 		getRolePositionStmt:                            q.getRolePositionStmt,
 		getRolesForGuildStmt:                           q.getRolesForGuildStmt,
+		getScheduledMessageStmt:                        q.getScheduledMessageStmt,
+		getScheduledMessagesStmt:                       q.getScheduledMessagesStmt,
+		getSlowModeCooldownStmt:                        q.getSlowModeCooldownStmt,
+		getThreadStmt:                                  q.getThreadStmt,
+		getThreadMessagesStmt:                          q.getThreadMessagesStmt,
 		getUserStmt:                                    q.getUserStmt,
 		getUserByEmailStmt:                             q.getUserByEmailStmt,
 		getUserMetadataStmt:                            q.getUserMetadataStmt,
 		guildWithIDExistsStmt:                          q.guildWithIDExistsStmt,
 		guildsForUserStmt:                              q.guildsForUserStmt,
 		guildsForUserWithDataStmt:                      q.guildsForUserWithDataStmt,
+		hasEquippedEmoteStmt:                           q.hasEquippedEmoteStmt,
 		incrementInviteStmt:                            q.incrementInviteStmt,
 		isIPWhitelistedStmt:                            q.isIPWhitelistedStmt,
 		isUserWhitelistedStmt:                          q.isUserWhitelistedStmt,
@@ -1078,26 +1906,55 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		moveChannelStmt:                                q.moveChannelStmt,
 		moveGuildStmt:                                  q.moveGuildStmt,
 		moveRoleStmt:                                   q.moveRoleStmt,
+		notifyStmt:                                     q.notifyStmt,
 		numChannelsWithIDStmt:                          q.numChannelsWithIDStmt,
 		openInvitesStmt:                                q.openInvitesStmt,
 		permissionExistsWithoutChannelStmt:             q.permissionExistsWithoutChannelStmt,
 		permissionExistsWithoutChannelWithoutRoleStmt:  q.permissionExistsWithoutChannelWithoutRoleStmt,
 		permissionsExistsStmt:                          q.permissionsExistsStmt,
 		permissionsExistsWithoutRoleStmt:               q.permissionsExistsWithoutRoleStmt,
+		pinMessageStmt:                                 q.pinMessageStmt,
+		pruneExpiredMessagesStmt:                       q.pruneExpiredMessagesStmt,
+		purgeDeletedMessagesStmt:                       q.purgeDeletedMessagesStmt,
+		redeemStreamTicketStmt:                         q.redeemStreamTicketStmt,
+		releaseSlowModeCooldownStmt:                    q.releaseSlowModeCooldownStmt,
 		removeGuildFromListStmt:                        q.removeGuildFromListStmt,
+		removePresenceStreamsStmt:                      q.removePresenceStreamsStmt,
+		removeReactionStmt:                             q.removeReactionStmt,
+		removeStaleMentionsStmt:                        q.removeStaleMentionsStmt,
 		removeUserFromGuildStmt:                        q.removeUserFromGuildStmt,
 		removeUserFromRoleStmt:                         q.removeUserFromRoleStmt,
+		resetStatusesStmt:                              q.resetStatusesStmt,
 		resolveGuildIDStmt:                             q.resolveGuildIDStmt,
+		resolveMentionsStmt:                            q.resolveMentionsStmt,
+		restoreMessageStmt:                             q.restoreMessageStmt,
+		retryScheduledMessageStmt:                      q.retryScheduledMessageStmt,
 		rolesForUserStmt:                               q.rolesForUserStmt,
+		scheduledMessageSentStmt:                       q.scheduledMessageSentStmt,
+		searchMessagesStmt:                             q.searchMessagesStmt,
 		sessionToUserIDStmt:                            q.sessionToUserIDStmt,
+		setChannelRetentionStmt:                        q.setChannelRetentionStmt,
+		setChannelSlowModeStmt:                         q.setChannelSlowModeStmt,
+		setChosenStatusStmt:                            q.setChosenStatusStmt,
 		setGuildNameStmt:                               q.setGuildNameStmt,
 		setGuildPictureStmt:                            q.setGuildPictureStmt,
+		setGuildRetentionStmt:                          q.setGuildRetentionStmt,
+		setMessageEmbedsStmt:                           q.setMessageEmbedsStmt,
 		setPermissionsStmt:                             q.setPermissionsStmt,
+		setPresenceStreamsStmt:                         q.setPresenceStreamsStmt,
 		setRoleColorStmt:                               q.setRoleColorStmt,
 		setRoleHoistStmt:                               q.setRoleHoistStmt,
 		setRoleNameStmt:                                q.setRoleNameStmt,
 		setRolePingableStmt:                            q.setRolePingableStmt,
+		setScheduledMessageIDStmt:                      q.setScheduledMessageIDStmt,
 		setStatusStmt:                                  q.setStatusStmt,
+		timeoutMemberStmt:                              q.timeoutMemberStmt,
+		touchPresenceInstanceStmt:                      q.touchPresenceInstanceStmt,
+		touchThreadStmt:                                q.touchThreadStmt,
+		trimMessageRevisionsStmt:                       q.trimMessageRevisionsStmt,
+		unpinMessageStmt:                               q.unpinMessageStmt,
+		unpinMessagesStmt:                              q.unpinMessagesStmt,
+		updateAutomodRuleStmt:                          q.updateAutomodRuleStmt,
 		updateAvatarStmt:                               q.updateAvatarStmt,
 		updateChannelNameStmt:                          q.updateChannelNameStmt,
 		updateMessageActionsStmt:                       q.updateMessageActionsStmt,
@@ -1109,7 +1966,10 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		updatePermissionsWithoutChannelStmt:            q.updatePermissionsWithoutChannelStmt,
 		updatePermissionsWithoutChannelWithoutRoleStmt: q.updatePermissionsWithoutChannelWithoutRoleStmt,
 		updatePermissionsWithoutRoleStmt:               q.updatePermissionsWithoutRoleStmt,
+		updateScheduledMessageStmt:                     q.updateScheduledMessageStmt,
 		updateUsernameStmt:                             q.updateUsernameStmt,
+		usePreviewImageStmt:                            q.usePreviewImageStmt,
+		userHasStreamsStmt:                             q.userHasStreamsStmt,
 		userInGuildStmt:                                q.userInGuildStmt,
 		userIsLocalStmt:                                q.userIsLocalStmt,
 	}
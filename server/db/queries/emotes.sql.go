@@ -187,3 +187,25 @@ func (q *Queries) GetPackOwner(ctx context.Context, packID uint64) (uint64, erro
 	err := row.Scan(&pack_id)
 	return pack_id, err
 }
+
+const hasEquippedEmote = `-- name: HasEquippedEmote :one
+SELECT EXISTS (
+		SELECT 1
+		FROM Emote_Pack_Emotes
+			INNER JOIN Acquired_Emote_Packs ON Acquired_Emote_Packs.Pack_ID = Emote_Pack_Emotes.Pack_ID
+		WHERE Acquired_Emote_Packs.User_ID = $1
+			AND Emote_Pack_Emotes.Image_ID = $2
+	)
+`
+
+type HasEquippedEmoteParams struct {
+	UserID  uint64 `json:"user_id"`
+	ImageID string `json:"image_id"`
+}
+
+func (q *Queries) HasEquippedEmote(ctx context.Context, arg HasEquippedEmoteParams) (bool, error) {
+	row := q.queryRow(ctx, q.hasEquippedEmoteStmt, hasEquippedEmote, arg.UserID, arg.ImageID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
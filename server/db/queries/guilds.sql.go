@@ -32,7 +32,7 @@ INSERT INTO Channels (
         Category,
         Kind
     )
-VALUES ($1, $2, $3, $4, $5, $6) RETURNING channel_id, guild_id, channel_name, position, category, kind
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING channel_id, guild_id, channel_name, position, category, kind, slow_mode
 `
 
 type CreateChannelParams struct {
@@ -61,6 +61,7 @@ func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (C
 		&i.Position,
 		&i.Category,
 		&i.Kind,
+		&i.SlowMode,
 	)
 	return i, err
 }
@@ -145,7 +146,7 @@ func (q *Queries) GetChannelPosition(ctx context.Context, arg GetChannelPosition
 }
 
 const getChannels = `-- name: GetChannels :many
-SELECT channel_id, guild_id, channel_name, position, category, kind
+SELECT channel_id, guild_id, channel_name, position, category, kind, slow_mode
 FROM Channels
 WHERE Guild_ID = $1
 ORDER BY Position
@@ -167,6 +168,7 @@ func (q *Queries) GetChannels(ctx context.Context, guildID sql.NullInt64) ([]Cha
 			&i.Position,
 			&i.Category,
 			&i.Kind,
+			&i.SlowMode,
 		); err != nil {
 			return nil, err
 		}
@@ -24,9 +24,10 @@ INSERT INTO Messages (
     Created_At,
     Reply_to_ID,
     Overrides,
-    Attachments
+    Attachments,
+    Thread_ID
   )
-VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), $8, $9, $10) RETURNING message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), $8, $9, $10, $11) RETURNING message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments, thread_id, deleted_at, deleted_by
 `
 
 type AddMessageParams struct {
@@ -40,6 +41,7 @@ type AddMessageParams struct {
 	ReplyToID   sql.NullInt64   `json:"reply_to_id"`
 	Overrides   []byte          `json:"overrides"`
 	Attachments []string        `json:"attachments"`
+	ThreadID    sql.NullInt64   `json:"thread_id"`
 }
 
 func (q *Queries) AddMessage(ctx context.Context, arg AddMessageParams) (Message, error) {
@@ -54,6 +56,7 @@ func (q *Queries) AddMessage(ctx context.Context, arg AddMessageParams) (Message
 		arg.ReplyToID,
 		arg.Overrides,
 		pq.Array(arg.Attachments),
+		arg.ThreadID,
 	)
 	var i Message
 	err := row.Scan(
@@ -69,33 +72,220 @@ func (q *Queries) AddMessage(ctx context.Context, arg AddMessageParams) (Message
 		&i.Overrides,
 		&i.ReplyToID,
 		pq.Array(&i.Attachments),
+		&i.ThreadID,
+		&i.DeletedAt,
+		&i.DeletedBy,
 	)
 	return i, err
 }
 
+const bulkDeleteFilteredMessages = `-- name: BulkDeleteFilteredMessages :many
+UPDATE Messages
+SET Deleted_At = NOW(),
+  Deleted_By = $1::BIGINT
+WHERE Message_ID IN (
+    SELECT Message_ID
+    FROM Messages
+    WHERE Guild_ID = $2
+      AND Channel_ID = $3
+      AND Deleted_At IS NULL
+      AND (
+        $4::BIGINT = 0
+        OR User_ID = $4
+      )
+      AND Created_At >= $5::TIMESTAMP
+      AND Created_At < $6::TIMESTAMP
+    ORDER BY Created_At DESC,
+      Message_ID DESC
+    LIMIT $7
+  )
+RETURNING Message_ID
+`
+
+type BulkDeleteFilteredMessagesParams struct {
+	Deletedby int64     `json:"deletedby"`
+	Guildid   uint64    `json:"guildid"`
+	Channelid uint64    `json:"channelid"`
+	Authorid  int64     `json:"authorid"`
+	After     time.Time `json:"after"`
+	Before    time.Time `json:"before"`
+	Max       int32     `json:"max"`
+}
+
+func (q *Queries) BulkDeleteFilteredMessages(ctx context.Context, arg BulkDeleteFilteredMessagesParams) ([]uint64, error) {
+	rows, err := q.query(ctx, q.bulkDeleteFilteredMessagesStmt, bulkDeleteFilteredMessages,
+		arg.Deletedby,
+		arg.Guildid,
+		arg.Channelid,
+		arg.Authorid,
+		arg.After,
+		arg.Before,
+		arg.Max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uint64
+	for rows.Next() {
+		var message_id uint64
+		if err := rows.Scan(&message_id); err != nil {
+			return nil, err
+		}
+		items = append(items, message_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const bulkDeleteMessages = `-- name: BulkDeleteMessages :many
+UPDATE Messages
+SET Deleted_At = NOW(),
+  Deleted_By = $1::BIGINT
+WHERE Guild_ID = $2
+  AND Channel_ID = $3
+  AND Message_ID = ANY($4::BIGINT[])
+  AND Deleted_At IS NULL
+RETURNING Message_ID
+`
+
+type BulkDeleteMessagesParams struct {
+	Deletedby  int64   `json:"deletedby"`
+	Guildid    uint64  `json:"guildid"`
+	Channelid  uint64  `json:"channelid"`
+	Messageids []int64 `json:"messageids"`
+}
+
+func (q *Queries) BulkDeleteMessages(ctx context.Context, arg BulkDeleteMessagesParams) ([]uint64, error) {
+	rows, err := q.query(ctx, q.bulkDeleteMessagesStmt, bulkDeleteMessages,
+		arg.Deletedby,
+		arg.Guildid,
+		arg.Channelid,
+		pq.Array(arg.Messageids),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uint64
+	for rows.Next() {
+		var message_id uint64
+		if err := rows.Scan(&message_id); err != nil {
+			return nil, err
+		}
+		items = append(items, message_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteMessage = `-- name: DeleteMessage :execrows
-DELETE FROM Messages
-WHERE Message_ID = $1
-  AND Channel_ID = $2
-  AND Guild_ID = $3
+UPDATE Messages
+SET Deleted_At = NOW(),
+  Deleted_By = $1::BIGINT
+WHERE Message_ID = $2
+  AND Channel_ID = $3
+  AND Guild_ID = $4
+  AND Deleted_At IS NULL
 `
 
 type DeleteMessageParams struct {
-	MessageID uint64 `json:"message_id"`
-	ChannelID uint64 `json:"channel_id"`
-	GuildID   uint64 `json:"guild_id"`
+	Deletedby int64  `json:"deletedby"`
+	Messageid uint64 `json:"messageid"`
+	Channelid uint64 `json:"channelid"`
+	Guildid   uint64 `json:"guildid"`
 }
 
 func (q *Queries) DeleteMessage(ctx context.Context, arg DeleteMessageParams) (int64, error) {
-	result, err := q.exec(ctx, q.deleteMessageStmt, deleteMessage, arg.MessageID, arg.ChannelID, arg.GuildID)
+	result, err := q.exec(ctx, q.deleteMessageStmt, deleteMessage,
+		arg.Deletedby,
+		arg.Messageid,
+		arg.Channelid,
+		arg.Guildid,
+	)
 	if err != nil {
 		return 0, err
 	}
 	return result.RowsAffected()
 }
 
+const getDeletedMessages = `-- name: GetDeletedMessages :many
+SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments, thread_id, deleted_at, deleted_by
+FROM Messages
+WHERE Guild_ID = $1
+  AND Channel_ID = $2
+  AND Deleted_At IS NOT NULL
+  AND (
+    $3::BIGINT = 0
+    OR Message_ID < $3
+  )
+ORDER BY Message_ID DESC
+LIMIT $4
+`
+
+type GetDeletedMessagesParams struct {
+	Guildid       uint64 `json:"guildid"`
+	Channelid     uint64 `json:"channelid"`
+	Beforemessage int64  `json:"beforemessage"`
+	Max           int32  `json:"max"`
+}
+
+func (q *Queries) GetDeletedMessages(ctx context.Context, arg GetDeletedMessagesParams) ([]Message, error) {
+	rows, err := q.query(ctx, q.getDeletedMessagesStmt, getDeletedMessages,
+		arg.Guildid,
+		arg.Channelid,
+		arg.Beforemessage,
+		arg.Max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.GuildID,
+			&i.ChannelID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.Content,
+			&i.Embeds,
+			&i.Actions,
+			&i.Overrides,
+			&i.ReplyToID,
+			pq.Array(&i.Attachments),
+			&i.ThreadID,
+			&i.DeletedAt,
+			&i.DeletedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMessage = `-- name: GetMessage :one
-SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments
+SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments, thread_id, deleted_at, deleted_by
 FROM Messages
 WHERE Message_ID = $1
 `
@@ -116,6 +306,9 @@ func (q *Queries) GetMessage(ctx context.Context, messageID uint64) (Message, er
 		&i.Overrides,
 		&i.ReplyToID,
 		pq.Array(&i.Attachments),
+		&i.ThreadID,
+		&i.DeletedAt,
+		&i.DeletedBy,
 	)
 	return i, err
 }
@@ -133,41 +326,93 @@ func (q *Queries) GetMessageAuthor(ctx context.Context, messageID uint64) (uint6
 	return user_id, err
 }
 
-const getMessageDate = `-- name: GetMessageDate :one
-SELECT Created_At
+const getMessages = `-- name: GetMessages :many
+SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments, thread_id, deleted_at, deleted_by
 FROM Messages
-WHERE Message_ID = $1
+WHERE Guild_ID = $1
+  AND Channel_ID = $2
+  AND Thread_ID IS NULL
+  AND Deleted_At IS NULL
+ORDER BY Created_At DESC,
+  Message_ID DESC
+LIMIT $3
 `
 
-func (q *Queries) GetMessageDate(ctx context.Context, messageID uint64) (time.Time, error) {
-	row := q.queryRow(ctx, q.getMessageDateStmt, getMessageDate, messageID)
-	var created_at time.Time
-	err := row.Scan(&created_at)
-	return created_at, err
+type GetMessagesParams struct {
+	Guildid   uint64 `json:"guildid"`
+	Channelid uint64 `json:"channelid"`
+	Max       int32  `json:"max"`
 }
 
-const getMessages = `-- name: GetMessages :many
-SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments
+func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Message, error) {
+	rows, err := q.query(ctx, q.getMessagesStmt, getMessages, arg.Guildid, arg.Channelid, arg.Max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.GuildID,
+			&i.ChannelID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.Content,
+			&i.Embeds,
+			&i.Actions,
+			&i.Overrides,
+			&i.ReplyToID,
+			pq.Array(&i.Attachments),
+			&i.ThreadID,
+			&i.DeletedAt,
+			&i.DeletedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesAfter = `-- name: GetMessagesAfter :many
+SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments, thread_id, deleted_at, deleted_by
 FROM Messages
 WHERE Guild_ID = $1
   AND Channel_ID = $2
-  AND Created_At < $3
-ORDER BY Created_At DESC
+  AND Thread_ID IS NULL
+  AND Deleted_At IS NULL
+  AND (Created_At, Message_ID) > (
+    SELECT Created_At,
+      Message_ID
+    FROM Messages
+    WHERE Message_ID = $3
+  )
+ORDER BY Created_At ASC,
+  Message_ID ASC
 LIMIT $4
 `
 
-type GetMessagesParams struct {
-	Guildid   uint64    `json:"guildid"`
-	Channelid uint64    `json:"channelid"`
-	Before    time.Time `json:"before"`
-	Max       int32     `json:"max"`
+type GetMessagesAfterParams struct {
+	Guildid   uint64 `json:"guildid"`
+	Channelid uint64 `json:"channelid"`
+	Cursor    uint64 `json:"cursor"`
+	Max       int32  `json:"max"`
 }
 
-func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Message, error) {
-	rows, err := q.query(ctx, q.getMessagesStmt, getMessages,
+func (q *Queries) GetMessagesAfter(ctx context.Context, arg GetMessagesAfterParams) ([]Message, error) {
+	rows, err := q.query(ctx, q.getMessagesAfterStmt, getMessagesAfter,
 		arg.Guildid,
 		arg.Channelid,
-		arg.Before,
+		arg.Cursor,
 		arg.Max,
 	)
 	if err != nil {
@@ -190,6 +435,78 @@ func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Mes
 			&i.Overrides,
 			&i.ReplyToID,
 			pq.Array(&i.Attachments),
+			&i.ThreadID,
+			&i.DeletedAt,
+			&i.DeletedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesBefore = `-- name: GetMessagesBefore :many
+SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments, thread_id, deleted_at, deleted_by
+FROM Messages
+WHERE Guild_ID = $1
+  AND Channel_ID = $2
+  AND Thread_ID IS NULL
+  AND Deleted_At IS NULL
+  AND (Created_At, Message_ID) < (
+    SELECT Created_At,
+      Message_ID
+    FROM Messages
+    WHERE Message_ID = $3
+  )
+ORDER BY Created_At DESC,
+  Message_ID DESC
+LIMIT $4
+`
+
+type GetMessagesBeforeParams struct {
+	Guildid   uint64 `json:"guildid"`
+	Channelid uint64 `json:"channelid"`
+	Cursor    uint64 `json:"cursor"`
+	Max       int32  `json:"max"`
+}
+
+func (q *Queries) GetMessagesBefore(ctx context.Context, arg GetMessagesBeforeParams) ([]Message, error) {
+	rows, err := q.query(ctx, q.getMessagesBeforeStmt, getMessagesBefore,
+		arg.Guildid,
+		arg.Channelid,
+		arg.Cursor,
+		arg.Max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.GuildID,
+			&i.ChannelID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.Content,
+			&i.Embeds,
+			&i.Actions,
+			&i.Overrides,
+			&i.ReplyToID,
+			pq.Array(&i.Attachments),
+			&i.ThreadID,
+			&i.DeletedAt,
+			&i.DeletedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -211,6 +528,7 @@ SELECT EXISTS (
     WHERE Guild_ID = $1
       AND Channel_ID = $2
       AND Message_ID = $3
+      AND Deleted_At IS NULL
   )
 `
 
@@ -227,6 +545,197 @@ func (q *Queries) MessageWithIDExists(ctx context.Context, arg MessageWithIDExis
 	return exists, err
 }
 
+const purgeDeletedMessages = `-- name: PurgeDeletedMessages :many
+DELETE FROM Messages
+WHERE Message_ID IN (
+    SELECT Messages.Message_ID
+    FROM Messages
+    WHERE Messages.Deleted_At < $1::TIMESTAMP
+      AND NOT EXISTS (
+        SELECT 1
+        FROM Messages AS Replies
+        WHERE Replies.Thread_ID = Messages.Message_ID
+      )
+    LIMIT $2 FOR UPDATE OF Messages SKIP LOCKED
+  )
+RETURNING Message_ID,
+  Attachments
+`
+
+type PurgeDeletedMessagesParams struct {
+	Deletedbefore time.Time `json:"deletedbefore"`
+	Max           int32     `json:"max"`
+}
+
+type PurgeDeletedMessagesRow struct {
+	MessageID   uint64   `json:"message_id"`
+	Attachments []string `json:"attachments"`
+}
+
+func (q *Queries) PurgeDeletedMessages(ctx context.Context, arg PurgeDeletedMessagesParams) ([]PurgeDeletedMessagesRow, error) {
+	rows, err := q.query(ctx, q.purgeDeletedMessagesStmt, purgeDeletedMessages, arg.Deletedbefore, arg.Max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PurgeDeletedMessagesRow
+	for rows.Next() {
+		var i PurgeDeletedMessagesRow
+		if err := rows.Scan(&i.MessageID, pq.Array(&i.Attachments)); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreMessage = `-- name: RestoreMessage :execrows
+UPDATE Messages
+SET Deleted_At = NULL,
+  Deleted_By = NULL
+WHERE Message_ID = $1
+  AND Channel_ID = $2
+  AND Guild_ID = $3
+  AND Deleted_At IS NOT NULL
+`
+
+type RestoreMessageParams struct {
+	MessageID uint64 `json:"message_id"`
+	ChannelID uint64 `json:"channel_id"`
+	GuildID   uint64 `json:"guild_id"`
+}
+
+func (q *Queries) RestoreMessage(ctx context.Context, arg RestoreMessageParams) (int64, error) {
+	result, err := q.exec(ctx, q.restoreMessageStmt, restoreMessage, arg.MessageID, arg.ChannelID, arg.GuildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const searchMessages = `-- name: SearchMessages :many
+SELECT message_id, guild_id, channel_id, user_id, created_at, edited_at, content, embeds, actions, overrides, reply_to_id, attachments, thread_id, deleted_at, deleted_by
+FROM Messages
+WHERE Guild_ID = $1
+  AND Channel_ID = ANY($2::BIGINT[])
+  AND Deleted_At IS NULL
+  AND (
+    $3::TEXT = ''
+    OR to_tsvector('english', Content) @@ plainto_tsquery('english', $3)
+  )
+  AND (
+    $4::BIGINT = 0
+    OR User_ID = $4
+  )
+  AND Created_At >= $5::TIMESTAMP
+  AND Created_At < $6::TIMESTAMP
+  AND (
+    NOT $7::BOOLEAN
+    OR cardinality(Attachments) > 0
+  )
+  AND (
+    $8::BIGINT = 0
+    OR Reply_To_ID = $8
+  )
+  AND (
+    $9::BIGINT = 0
+    OR Message_ID < $9
+  )
+ORDER BY Message_ID DESC
+LIMIT $10
+`
+
+type SearchMessagesParams struct {
+	Guildid       uint64    `json:"guildid"`
+	Channels      []int64   `json:"channels"`
+	Query         string    `json:"query"`
+	Authorid      int64     `json:"authorid"`
+	After         time.Time `json:"after"`
+	Before        time.Time `json:"before"`
+	Hasattachment bool      `json:"hasattachment"`
+	Replyto       int64     `json:"replyto"`
+	Beforemessage int64     `json:"beforemessage"`
+	Max           int32     `json:"max"`
+}
+
+func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]Message, error) {
+	rows, err := q.query(ctx, q.searchMessagesStmt, searchMessages,
+		arg.Guildid,
+		pq.Array(arg.Channels),
+		arg.Query,
+		arg.Authorid,
+		arg.After,
+		arg.Before,
+		arg.Hasattachment,
+		arg.Replyto,
+		arg.Beforemessage,
+		arg.Max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.GuildID,
+			&i.ChannelID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.Content,
+			&i.Embeds,
+			&i.Actions,
+			&i.Overrides,
+			&i.ReplyToID,
+			pq.Array(&i.Attachments),
+			&i.ThreadID,
+			&i.DeletedAt,
+			&i.DeletedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setMessageEmbeds = `-- name: SetMessageEmbeds :execrows
+UPDATE Messages
+SET Embeds = $2
+WHERE Message_ID = $1
+  AND Content = $3
+  AND Deleted_At IS NULL
+`
+
+type SetMessageEmbedsParams struct {
+	MessageID uint64          `json:"message_id"`
+	Embeds    json.RawMessage `json:"embeds"`
+	Content   string          `json:"content"`
+}
+
+func (q *Queries) SetMessageEmbeds(ctx context.Context, arg SetMessageEmbedsParams) (int64, error) {
+	result, err := q.exec(ctx, q.setMessageEmbedsStmt, setMessageEmbeds, arg.MessageID, arg.Embeds, arg.Content)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateMessageActions = `-- name: UpdateMessageActions :one
 UPDATE Messages
 SET Actions = $2,
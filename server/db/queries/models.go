@@ -13,6 +13,12 @@ type AcquiredEmotePack struct {
 	UserID uint64 `json:"user_id"`
 }
 
+type AutomodRule struct {
+	RuleID  uint64 `json:"rule_id"`
+	GuildID uint64 `json:"guild_id"`
+	Data    []byte `json:"data"`
+}
+
 type Channel struct {
 	ChannelID   uint64         `json:"channel_id"`
 	GuildID     sql.NullInt64  `json:"guild_id"`
@@ -20,6 +26,13 @@ type Channel struct {
 	Position    string         `json:"position"`
 	Category    bool           `json:"category"`
 	Kind        sql.NullString `json:"kind"`
+	SlowMode    int32          `json:"slow_mode"`
+}
+
+type ChannelRetention struct {
+	ChannelID uint64 `json:"channel_id"`
+	GuildID   uint64 `json:"guild_id"`
+	MaxAge    int64  `json:"max_age"`
 }
 
 type EmotePack struct {
@@ -34,6 +47,12 @@ type EmotePackEmote struct {
 	EmoteName string `json:"emote_name"`
 }
 
+type EventQueue struct {
+	EventID   uint64    `json:"event_id"`
+	Data      []byte    `json:"data"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type FederationNonce struct {
 	Nonce      string `json:"nonce"`
 	UserID     uint64 `json:"user_id"`
@@ -72,6 +91,11 @@ type GuildMember struct {
 	GuildID uint64 `json:"guild_id"`
 }
 
+type GuildRetention struct {
+	GuildID uint64 `json:"guild_id"`
+	MaxAge  int64  `json:"max_age"`
+}
+
 type Hash struct {
 	Hash   []byte `json:"hash"`
 	FileID string `json:"file_id"`
@@ -90,6 +114,20 @@ type LocalUser struct {
 	Password []byte `json:"password"`
 }
 
+type MemberTimeout struct {
+	GuildID       uint64    `json:"guild_id"`
+	UserID        uint64    `json:"user_id"`
+	TimedOutUntil time.Time `json:"timed_out_until"`
+}
+
+type Mention struct {
+	MessageID   uint64    `json:"message_id"`
+	UserID      uint64    `json:"user_id"`
+	GuildID     uint64    `json:"guild_id"`
+	ChannelID   uint64    `json:"channel_id"`
+	MentionedAt time.Time `json:"mentioned_at"`
+}
+
 type Message struct {
 	MessageID   uint64          `json:"message_id"`
 	GuildID     uint64          `json:"guild_id"`
@@ -103,6 +141,26 @@ type Message struct {
 	Overrides   []byte          `json:"overrides"`
 	ReplyToID   sql.NullInt64   `json:"reply_to_id"`
 	Attachments []string        `json:"attachments"`
+	ThreadID    sql.NullInt64   `json:"thread_id"`
+	DeletedAt   sql.NullTime    `json:"deleted_at"`
+	DeletedBy   sql.NullInt64   `json:"deleted_by"`
+}
+
+type MessageRevision struct {
+	RevisionID  uint64          `json:"revision_id"`
+	MessageID   uint64          `json:"message_id"`
+	Content     string          `json:"content"`
+	Embeds      json.RawMessage `json:"embeds"`
+	Actions     json.RawMessage `json:"actions"`
+	Overrides   []byte          `json:"overrides"`
+	Attachments []string        `json:"attachments"`
+	WrittenAt   time.Time       `json:"written_at"`
+	ReplacedAt  time.Time       `json:"replaced_at"`
+}
+
+type MessagePreviewImage struct {
+	MessageID int64  `json:"message_id"`
+	FileID    string `json:"file_id"`
 }
 
 type Permission struct {
@@ -112,11 +170,36 @@ type Permission struct {
 	Nodes     json.RawMessage `json:"nodes"`
 }
 
+type PinnedMessage struct {
+	ChannelID uint64    `json:"channel_id"`
+	MessageID uint64    `json:"message_id"`
+	PinnedBy  uint64    `json:"pinned_by"`
+	PinnedAt  time.Time `json:"pinned_at"`
+}
+
+type PresenceInstance struct {
+	InstanceID string    `json:"instance_id"`
+	SeenAt     time.Time `json:"seen_at"`
+}
+
+type PresenceStream struct {
+	InstanceID string `json:"instance_id"`
+	UserID     uint64 `json:"user_id"`
+	Streams    int32  `json:"streams"`
+}
+
+type PreviewImage struct {
+	Url    string    `json:"url"`
+	FileID string    `json:"file_id"`
+	UsedAt time.Time `json:"used_at"`
+}
+
 type Profile struct {
-	UserID   uint64         `json:"user_id"`
-	Username string         `json:"username"`
-	Avatar   sql.NullString `json:"avatar"`
-	Status   int16          `json:"status"`
+	UserID       uint64         `json:"user_id"`
+	Username     string         `json:"username"`
+	Avatar       sql.NullString `json:"avatar"`
+	Status       int16          `json:"status"`
+	ChosenStatus int16          `json:"chosen_status"`
 }
 
 type RateLimitWhitelistIp struct {
@@ -127,6 +210,22 @@ type RateLimitWhitelistUser struct {
 	UserID uint64 `json:"user_id"`
 }
 
+type Reaction struct {
+	MessageID uint64    `json:"message_id"`
+	UserID    uint64    `json:"user_id"`
+	Emoji     string    `json:"emoji"`
+	Emote     string    `json:"emote"`
+	ReactedAt time.Time `json:"reacted_at"`
+}
+
+type ReadState struct {
+	UserID     uint64    `json:"user_id"`
+	GuildID    uint64    `json:"guild_id"`
+	ChannelID  uint64    `json:"channel_id"`
+	LastReadID int64     `json:"last_read_id"`
+	ReadAt     time.Time `json:"read_at"`
+}
+
 type Role struct {
 	GuildID  uint64 `json:"guild_id"`
 	RoleID   uint64 `json:"role_id"`
@@ -143,12 +242,47 @@ type RolesMember struct {
 	MemberID uint64 `json:"member_id"`
 }
 
+type ScheduledMessage struct {
+	ScheduledID  uint64        `json:"scheduled_id"`
+	GuildID      uint64        `json:"guild_id"`
+	ChannelID    uint64        `json:"channel_id"`
+	UserID       uint64        `json:"user_id"`
+	SendAt       time.Time     `json:"send_at"`
+	Request      []byte        `json:"request"`
+	ClaimedUntil sql.NullTime  `json:"claimed_until"`
+	Attempts     int32         `json:"attempts"`
+	MessageID    sql.NullInt64 `json:"message_id"`
+}
+
 type Session struct {
 	Session    string `json:"session"`
 	UserID     uint64 `json:"user_id"`
 	Expiration int64  `json:"expiration"`
 }
 
+type SlowModeCooldown struct {
+	ChannelID uint64    `json:"channel_id"`
+	UserID    uint64    `json:"user_id"`
+	LastSent  time.Time `json:"last_sent"`
+}
+
+type StreamTicket struct {
+	Ticket     string `json:"ticket"`
+	Session    string `json:"session"`
+	Expiration int64  `json:"expiration"`
+}
+
+type Thread struct {
+	ThreadID      uint64    `json:"thread_id"`
+	GuildID       uint64    `json:"guild_id"`
+	ChannelID     uint64    `json:"channel_id"`
+	Name          string    `json:"name"`
+	CreatedBy     uint64    `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+	LastMessageAt time.Time `json:"last_message_at"`
+	Archived      bool      `json:"archived"`
+}
+
 type User struct {
 	UserID uint64 `json:"user_id"`
 }
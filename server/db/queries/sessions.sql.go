@@ -26,6 +26,25 @@ func (q *Queries) AddSession(ctx context.Context, arg AddSessionParams) error {
 	return err
 }
 
+const addStreamTicket = `-- name: AddStreamTicket :exec
+INSERT INTO Stream_Tickets
+(Ticket,
+ Session,
+ Expiration)
+VALUES ($1, $2, $3)
+`
+
+type AddStreamTicketParams struct {
+	Ticket     string `json:"ticket"`
+	Session    string `json:"session"`
+	Expiration int64  `json:"expiration"`
+}
+
+func (q *Queries) AddStreamTicket(ctx context.Context, arg AddStreamTicketParams) error {
+	_, err := q.exec(ctx, q.addStreamTicketStmt, addStreamTicket, arg.Ticket, arg.Session, arg.Expiration)
+	return err
+}
+
 const expireSessions = `-- name: ExpireSessions :exec
 DELETE
 FROM Sessions
@@ -37,6 +56,37 @@ func (q *Queries) ExpireSessions(ctx context.Context, expiration int64) error {
 	return err
 }
 
+const expireStreamTickets = `-- name: ExpireStreamTickets :exec
+DELETE
+FROM Stream_Tickets
+WHERE Expiration <= $1
+`
+
+func (q *Queries) ExpireStreamTickets(ctx context.Context, expiration int64) error {
+	_, err := q.exec(ctx, q.expireStreamTicketsStmt, expireStreamTickets, expiration)
+	return err
+}
+
+const redeemStreamTicket = `-- name: RedeemStreamTicket :one
+DELETE
+FROM Stream_Tickets
+WHERE Ticket = $1
+  AND Expiration > $2::BIGINT
+RETURNING Session
+`
+
+type RedeemStreamTicketParams struct {
+	Ticket string `json:"ticket"`
+	Now    int64  `json:"now"`
+}
+
+func (q *Queries) RedeemStreamTicket(ctx context.Context, arg RedeemStreamTicketParams) (string, error) {
+	row := q.queryRow(ctx, q.redeemStreamTicketStmt, redeemStreamTicket, arg.Ticket, arg.Now)
+	var session string
+	err := row.Scan(&session)
+	return session, err
+}
+
 const sessionToUserID = `-- name: SessionToUserID :one
 SELECT User_ID
 FROM Sessions
@@ -44,6 +44,16 @@ func (q *Queries) AddHash(ctx context.Context, arg AddHashParams) error {
 	return err
 }
 
+const deleteFileHashes = `-- name: DeleteFileHashes :exec
+DELETE FROM Hashes
+WHERE File_ID = $1
+`
+
+func (q *Queries) DeleteFileHashes(ctx context.Context, fileID string) error {
+	_, err := q.exec(ctx, q.deleteFileHashesStmt, deleteFileHashes, fileID)
+	return err
+}
+
 const deleteFileMetadata = `-- name: DeleteFileMetadata :exec
 DELETE FROM Files
 WHERE File_ID = $1
@@ -54,6 +64,48 @@ func (q *Queries) DeleteFileMetadata(ctx context.Context, fileID string) error {
 	return err
 }
 
+const fileInUse = `-- name: FileInUse :one
+SELECT (
+    EXISTS (
+      SELECT 1
+      FROM Messages
+      WHERE $1::TEXT = ANY(Attachments)
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Message_Revisions
+      WHERE $1::TEXT = ANY(Attachments)
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Profiles
+      WHERE Avatar LIKE '%' || $1::TEXT
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Guilds
+      WHERE Picture_URL LIKE '%' || $1::TEXT
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Emote_Pack_Emotes
+      WHERE Image_ID = $1::TEXT
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Preview_Images
+      WHERE File_ID = $1::TEXT
+    )
+  ) AS In_Use
+`
+
+func (q *Queries) FileInUse(ctx context.Context, fileid string) (bool, error) {
+	row := q.queryRow(ctx, q.fileInUseStmt, fileInUse, fileid)
+	var in_use bool
+	err := row.Scan(&in_use)
+	return in_use, err
+}
+
 const getFileIDByHash = `-- name: GetFileIDByHash :one
 SELECT File_ID
 FROM Hashes
@@ -124,6 +124,19 @@ func (q *Queries) GetAvatar(ctx context.Context, userID uint64) (sql.NullString,
 	return avatar, err
 }
 
+const getChosenStatus = `-- name: GetChosenStatus :one
+SELECT Chosen_Status
+FROM Profiles
+WHERE User_ID = $1
+`
+
+func (q *Queries) GetChosenStatus(ctx context.Context, userID uint64) (int16, error) {
+	row := q.queryRow(ctx, q.getChosenStatusStmt, getChosenStatus, userID)
+	var chosen_status int16
+	err := row.Scan(&chosen_status)
+	return chosen_status, err
+}
+
 const getGuildList = `-- name: GetGuildList :many
 SELECT Guild_ID,
   Home_Server
@@ -366,6 +379,33 @@ func (q *Queries) RemoveGuildFromList(ctx context.Context, arg RemoveGuildFromLi
 	return err
 }
 
+const resetStatuses = `-- name: ResetStatuses :exec
+UPDATE Profiles
+SET Status = $1
+WHERE Status <> $1
+`
+
+func (q *Queries) ResetStatuses(ctx context.Context, status int16) error {
+	_, err := q.exec(ctx, q.resetStatusesStmt, resetStatuses, status)
+	return err
+}
+
+const setChosenStatus = `-- name: SetChosenStatus :exec
+UPDATE Profiles
+SET Chosen_Status = $1
+WHERE User_ID = $2
+`
+
+type SetChosenStatusParams struct {
+	ChosenStatus int16  `json:"chosen_status"`
+	UserID       uint64 `json:"user_id"`
+}
+
+func (q *Queries) SetChosenStatus(ctx context.Context, arg SetChosenStatusParams) error {
+	_, err := q.exec(ctx, q.setChosenStatusStmt, setChosenStatus, arg.ChosenStatus, arg.UserID)
+	return err
+}
+
 const setStatus = `-- name: SetStatus :exec
 UPDATE Profiles
 SET Status = $1
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// AddReaction reacts to a message, returning false if the user already reacted with it
+func (db *HarmonyDB) AddReaction(messageID, userID uint64, emoji, emote string) (bool, error) {
+	numRows, err := db.queries.AddReaction(ctx, queries.AddReactionParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     emoji,
+		Emote:     emote,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return numRows > 0, err
+}
+
+// RemoveReaction removes a user's reaction, returning false if there was none
+func (db *HarmonyDB) RemoveReaction(messageID, userID uint64, emoji, emote string) (bool, error) {
+	numRows, err := db.queries.RemoveReaction(ctx, queries.RemoveReactionParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     emoji,
+		Emote:     emote,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return numRows > 0, err
+}
+
+// CountReaction counts the users who reacted to a message with an emoji or emote
+func (db *HarmonyDB) CountReaction(messageID uint64, emoji, emote string) (int64, error) {
+	count, err := db.queries.CountReaction(ctx, queries.CountReactionParams{
+		MessageID: messageID,
+		Emoji:     emoji,
+		Emote:     emote,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return count, err
+}
+
+// GetReactions gets the reaction counts of several messages, in the order they were first used
+func (db *HarmonyDB) GetReactions(messageIDs []uint64) ([]queries.GetReactionsRow, error) {
+	ids := make([]int64, len(messageIDs))
+	for i, id := range messageIDs {
+		ids[i] = int64(id)
+	}
+	reactions, err := db.queries.GetReactions(ctx, ids)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return reactions, err
+}
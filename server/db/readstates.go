@@ -0,0 +1,30 @@
+package db
+
+import (
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// AckChannel moves the read marker of a user in a channel to a message
+func (db *HarmonyDB) AckChannel(userID, guildID, channelID, messageID uint64) error {
+	err := tracerr.Wrap(db.queries.AckChannel(ctx, queries.AckChannelParams{
+		UserID:     userID,
+		GuildID:    guildID,
+		ChannelID:  channelID,
+		LastReadID: int64(messageID),
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// GetReadStates gets the read marker of a user along with the unread
+// message and mention counts for every channel of a guild
+func (db *HarmonyDB) GetReadStates(guildID, userID uint64) ([]queries.GetReadStatesRow, error) {
+	states, err := db.queries.GetReadStates(ctx, queries.GetReadStatesParams{
+		Userid:  userID,
+		Guildid: guildID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return states, err
+}
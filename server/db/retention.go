@@ -0,0 +1,66 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// SetGuildRetention sets the default message retention of a guild in seconds
+func (db *HarmonyDB) SetGuildRetention(guildID uint64, maxAge int64) error {
+	err := tracerr.Wrap(db.queries.SetGuildRetention(ctx, queries.SetGuildRetentionParams{
+		GuildID: guildID,
+		MaxAge:  maxAge,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// SetChannelRetention overrides the retention of a channel in seconds
+func (db *HarmonyDB) SetChannelRetention(guildID, channelID uint64, maxAge int64) error {
+	err := tracerr.Wrap(db.queries.SetChannelRetention(ctx, queries.SetChannelRetentionParams{
+		ChannelID: channelID,
+		GuildID:   guildID,
+		MaxAge:    maxAge,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// ClearChannelRetention makes a channel inherit the retention of its guild
+func (db *HarmonyDB) ClearChannelRetention(channelID uint64) error {
+	err := tracerr.Wrap(db.queries.ClearChannelRetention(ctx, channelID))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// GetRetention gets the default retention of a guild along with the channels
+// that override it. A guild without a retention policy has a max age of 0.
+func (db *HarmonyDB) GetRetention(guildID uint64) (int64, []queries.ChannelRetention, error) {
+	maxAge, err := db.queries.GetGuildRetention(ctx, guildID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return 0, nil, err
+	}
+	channels, err := db.queries.GetChannelRetentions(ctx, guildID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return maxAge, channels, err
+}
+
+// PruneExpiredMessages permanently deletes up to limit messages older than
+// the retention of their channel. Deleting a thread's message would take its
+// replies with it, so it's kept until all of them are gone.
+func (db *HarmonyDB) PruneExpiredMessages(limit int32) ([]queries.PruneExpiredMessagesRow, error) {
+	pruned, err := db.queries.PruneExpiredMessages(ctx, queries.PruneExpiredMessagesParams{
+		Now: time.Now().UTC(),
+		Max: limit,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return pruned, err
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/sirupsen/logrus"
+	"github.com/ztrue/tracerr"
+)
+
+// GetMessageRevisions gets the previous versions of a message, newest first
+func (db *HarmonyDB) GetMessageRevisions(messageID uint64) ([]queries.MessageRevision, error) {
+	revisions, err := db.queries.GetMessageRevisions(ctx, messageID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return revisions, err
+}
+
+// ExpireMessageRevisions drops the revisions older than the configured maximum age
+func (db *HarmonyDB) ExpireMessageRevisions() error {
+	maxAge := db.Config.Server.Policies.MessageHistory.MaximumAge
+	if maxAge == 0 {
+		return nil
+	}
+	return tracerr.Wrap(db.queries.ExpireMessageRevisions(ctx, time.Now().UTC().Add(-maxAge)))
+}
+
+// RevisionExpireRoutine drops expired message revisions every 15 minutes
+func (db *HarmonyDB) RevisionExpireRoutine() {
+	for {
+		time.Sleep(15 * time.Minute)
+		err := db.ExpireMessageRevisions()
+		if err != nil {
+			logrus.Warn(err)
+			sentry.CaptureException(err)
+		}
+	}
+}
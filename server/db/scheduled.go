@@ -0,0 +1,136 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// AddScheduledMessage stores a serialized SendMessageRequest to be sent at sendAt
+func (db *HarmonyDB) AddScheduledMessage(scheduledID, guildID, channelID, userID uint64, sendAt time.Time, request []byte) error {
+	err := tracerr.Wrap(db.queries.AddScheduledMessage(ctx, queries.AddScheduledMessageParams{
+		ScheduledID: scheduledID,
+		GuildID:     guildID,
+		ChannelID:   channelID,
+		UserID:      userID,
+		SendAt:      sendAt,
+		Request:     request,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// CountScheduledMessages counts the messages a user has pending in a guild
+func (db *HarmonyDB) CountScheduledMessages(guildID, userID uint64) (int64, error) {
+	count, err := db.queries.CountScheduledMessages(ctx, queries.CountScheduledMessagesParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return count, err
+}
+
+// GetScheduledMessage gets a pending scheduled message
+func (db *HarmonyDB) GetScheduledMessage(scheduledID uint64) (queries.ScheduledMessage, error) {
+	scheduled, err := db.queries.GetScheduledMessage(ctx, scheduledID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return scheduled, err
+}
+
+// GetScheduledMessages gets the messages a user has pending in a guild,
+// the soonest first
+func (db *HarmonyDB) GetScheduledMessages(guildID, userID uint64) ([]queries.ScheduledMessage, error) {
+	scheduled, err := db.queries.GetScheduledMessages(ctx, queries.GetScheduledMessagesParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return scheduled, err
+}
+
+// UpdateScheduledMessage replaces a pending message of a user, returning
+// whether it was still pending
+func (db *HarmonyDB) UpdateScheduledMessage(scheduledID, userID uint64, sendAt time.Time, request []byte) (bool, error) {
+	rows, err := db.queries.UpdateScheduledMessage(ctx, queries.UpdateScheduledMessageParams{
+		ScheduledID: scheduledID,
+		UserID:      userID,
+		SendAt:      sendAt,
+		Request:     request,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return rows > 0, err
+}
+
+// CancelScheduledMessage removes a pending message of a user, returning
+// whether it was still pending
+func (db *HarmonyDB) CancelScheduledMessage(scheduledID, userID uint64) (bool, error) {
+	rows, err := db.queries.CancelScheduledMessage(ctx, queries.CancelScheduledMessageParams{
+		ScheduledID: scheduledID,
+		UserID:      userID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return rows > 0, err
+}
+
+// ClaimDueScheduledMessages leases and returns up to limit messages that
+// are due. Rows locked by another instance or still leased are skipped, so
+// each message is only being sent by one instance at a time. A message is
+// claimed again once its lease runs out, in case its instance died.
+func (db *HarmonyDB) ClaimDueScheduledMessages(now, leaseUntil time.Time, limit int32) ([]queries.ScheduledMessage, error) {
+	due, err := db.queries.ClaimDueScheduledMessages(ctx, queries.ClaimDueScheduledMessagesParams{
+		Leaseuntil: leaseUntil,
+		Now:        now,
+		Max:        limit,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return due, err
+}
+
+// RetryScheduledMessage releases a claimed message to be sent again at sendAt
+func (db *HarmonyDB) RetryScheduledMessage(scheduledID uint64, sendAt time.Time) error {
+	err := tracerr.Wrap(db.queries.RetryScheduledMessage(ctx, queries.RetryScheduledMessageParams{
+		ScheduledID: scheduledID,
+		SendAt:      sendAt,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// SetScheduledMessageID records the ID a claimed message is about to be sent
+// with, so that whoever claims it next can tell whether it was sent
+func (db *HarmonyDB) SetScheduledMessageID(scheduledID, messageID uint64) error {
+	err := tracerr.Wrap(db.queries.SetScheduledMessageID(ctx, queries.SetScheduledMessageIDParams{
+		ScheduledID: scheduledID,
+		MessageID: sql.NullInt64{
+			Int64: int64(messageID),
+			Valid: true,
+		},
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// ScheduledMessageSent checks whether a message sent from the schedule
+// exists, including if it's been deleted since
+func (db *HarmonyDB) ScheduledMessageSent(messageID uint64) (bool, error) {
+	sent, err := db.queries.ScheduledMessageSent(ctx, messageID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return sent, err
+}
+
+// FinishScheduledMessage removes a claimed message once it's been sent or
+// given up on
+func (db *HarmonyDB) FinishScheduledMessage(scheduledID uint64) error {
+	err := tracerr.Wrap(db.queries.FinishScheduledMessage(ctx, scheduledID))
+	db.Logger.CheckException(err)
+	return err
+}
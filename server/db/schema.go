@@ -31,3 +31,14 @@ func (db *HarmonyDB) SessionExpireRoutine() {
 		}
 	}
 }
+
+func (db *HarmonyDB) TimeoutExpireRoutine() {
+	for {
+		time.Sleep(15 * time.Minute)
+		err := db.ExpireMemberTimeouts()
+		if err != nil {
+			logrus.Warn(err)
+			sentry.CaptureException(err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"time"
+
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// SetChannelSlowMode sets how many seconds users have to wait between
+// messages in a channel. Disabling slow mode forgets the cooldowns.
+func (db *HarmonyDB) SetChannelSlowMode(guildID, channelID uint64, interval int32) (bool, error) {
+	rows, err := db.queries.SetChannelSlowMode(ctx, queries.SetChannelSlowModeParams{
+		SlowMode:  interval,
+		ChannelID: channelID,
+		GuildID:   guildID,
+	})
+	if err == nil && interval == 0 {
+		err = db.queries.ClearSlowModeCooldowns(ctx, channelID)
+	}
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return rows > 0, err
+}
+
+// GetChannelSlowMode gets the slow mode interval of a channel in seconds
+func (db *HarmonyDB) GetChannelSlowMode(channelID uint64) (int32, error) {
+	interval, err := db.queries.GetChannelSlowMode(ctx, channelID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return interval, err
+}
+
+// ClaimSlowMode starts the cooldown of a user in a slow mode channel at now.
+// If the user is still cooling down nothing changes and the time left is
+// returned instead.
+func (db *HarmonyDB) ClaimSlowMode(channelID, userID uint64, interval int32, now time.Time) (time.Duration, error) {
+	claimed, err := db.queries.ClaimSlowModeCooldown(ctx, queries.ClaimSlowModeCooldownParams{
+		Channelid: channelID,
+		Userid:    userID,
+		Now:       now,
+		Interval:  int64(interval),
+	})
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return 0, err
+	}
+	if claimed > 0 {
+		return 0, nil
+	}
+	lastSent, err := db.queries.GetSlowModeCooldown(ctx, queries.GetSlowModeCooldownParams{
+		ChannelID: channelID,
+		UserID:    userID,
+	})
+	if err != nil {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+		return 0, err
+	}
+	remaining := lastSent.Add(time.Duration(interval) * time.Second).Sub(now)
+	if remaining < time.Second {
+		remaining = time.Second
+	}
+	return remaining, nil
+}
+
+// ReleaseSlowMode ends the cooldown claimed at claimedAt early, for a message
+// that couldn't be sent after all. A cooldown claimed since then is kept.
+func (db *HarmonyDB) ReleaseSlowMode(channelID, userID uint64, claimedAt time.Time) error {
+	err := tracerr.Wrap(db.queries.ReleaseSlowModeCooldown(ctx, queries.ReleaseSlowModeCooldownParams{
+		Channelid: channelID,
+		Userid:    userID,
+		Claimedat: claimedAt,
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
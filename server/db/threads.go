@@ -0,0 +1,69 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/harmony-development/legato/server/db/queries"
+	"github.com/ztrue/tracerr"
+)
+
+// CreateThread starts a thread branching off a message
+func (db *HarmonyDB) CreateThread(threadID, guildID, channelID, userID uint64, name string) (queries.Thread, error) {
+	thread, err := db.queries.CreateThread(ctx, queries.CreateThreadParams{
+		ThreadID:  threadID,
+		GuildID:   guildID,
+		ChannelID: channelID,
+		Name:      name,
+		CreatedBy: userID,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return thread, err
+}
+
+// GetThread gets a thread by its ID, sql.ErrNoRows is returned as is
+// if there's no such thread
+func (db *HarmonyDB) GetThread(threadID uint64) (queries.Thread, error) {
+	thread, err := db.queries.GetThread(ctx, threadID)
+	if err != nil && err != sql.ErrNoRows {
+		err = tracerr.Wrap(err)
+		db.Logger.CheckException(err)
+	}
+	return thread, err
+}
+
+// GetActiveThreads gets the threads of a channel that aren't archived
+func (db *HarmonyDB) GetActiveThreads(channelID uint64) ([]queries.Thread, error) {
+	threads, err := db.queries.GetActiveThreads(ctx, channelID)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return threads, err
+}
+
+// TouchThread marks a thread as active, unarchiving it
+func (db *HarmonyDB) TouchThread(threadID uint64) error {
+	err := tracerr.Wrap(db.queries.TouchThread(ctx, threadID))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// ArchiveInactiveThreads archives the threads without messages since a given time
+func (db *HarmonyDB) ArchiveInactiveThreads(before time.Time) ([]queries.ArchiveInactiveThreadsRow, error) {
+	threads, err := db.queries.ArchiveInactiveThreads(ctx, before)
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return threads, err
+}
+
+// GetThreadMessages gets the messages of a thread, optionally before a given message
+func (db *HarmonyDB) GetThreadMessages(threadID, beforeMessage uint64, limit int32) ([]queries.Message, error) {
+	msgs, err := db.queries.GetThreadMessages(ctx, queries.GetThreadMessagesParams{
+		Threadid:      int64(threadID),
+		Beforemessage: int64(beforeMessage),
+		Max:           limit,
+	})
+	err = tracerr.Wrap(err)
+	db.Logger.CheckException(err)
+	return msgs, err
+}
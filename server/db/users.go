@@ -149,13 +149,41 @@ func (db *HarmonyDB) EmailExists(email string) (bool, error) {
 }
 
 func (db *HarmonyDB) ExpireSessions() error {
-	if err := db.queries.ExpireSessions(ctx, time.Now().UTC().Unix()); err != nil {
+	now := time.Now().UTC().Unix()
+	if err := db.queries.ExpireSessions(ctx, now); err != nil {
+		err = tracerr.Wrap(err)
+		return err
+	}
+	if err := db.queries.ExpireStreamTickets(ctx, now); err != nil {
 		err = tracerr.Wrap(err)
 		return err
 	}
 	return nil
 }
 
+// AddStreamTicket stores a ticket standing in for a session until expiration,
+// for clients that can only authenticate their event streams in the URL
+func (db *HarmonyDB) AddStreamTicket(ticket, session string, expiration time.Time) error {
+	err := tracerr.Wrap(db.queries.AddStreamTicket(ctx, queries.AddStreamTicketParams{
+		Ticket:     ticket,
+		Session:    session,
+		Expiration: expiration.Unix(),
+	}))
+	db.Logger.CheckException(err)
+	return err
+}
+
+// RedeemStreamTicket gets the session of a ticket that hasn't expired and
+// removes the ticket, so that it only works once
+func (db *HarmonyDB) RedeemStreamTicket(ticket string) (string, error) {
+	session, err := db.queries.RedeemStreamTicket(ctx, queries.RedeemStreamTicketParams{
+		Ticket: ticket,
+		Now:    time.Now().UTC().Unix(),
+	})
+	db.Logger.CheckException(err)
+	return session, tracerr.Wrap(err)
+}
+
 func (db *HarmonyDB) UpdateUsername(userID uint64, username string) error {
 	return tracerr.Wrap(db.queries.UpdateUsername(ctx, queries.UpdateUsernameParams{
 		Username: username,
@@ -183,6 +211,23 @@ func (db *HarmonyDB) SetStatus(userID uint64, status harmonytypesv1.UserStatus)
 	}))
 }
 
+func (db *HarmonyDB) GetChosenStatus(userID uint64) (harmonytypesv1.UserStatus, error) {
+	status, err := db.queries.GetChosenStatus(ctx, userID)
+	return harmonytypesv1.UserStatus(status), tracerr.Wrap(err)
+}
+
+func (db *HarmonyDB) SetChosenStatus(userID uint64, status harmonytypesv1.UserStatus) error {
+	return tracerr.Wrap(db.queries.SetChosenStatus(ctx, queries.SetChosenStatusParams{
+		ChosenStatus: int16(status),
+		UserID:       userID,
+	}))
+}
+
+// ResetStatuses marks every user as offline
+func (db *HarmonyDB) ResetStatuses() error {
+	return tracerr.Wrap(db.queries.ResetStatuses(ctx, int16(harmonytypesv1.UserStatus_USER_STATUS_OFFLINE)))
+}
+
 func (db *HarmonyDB) GetUserMetadata(userID uint64, appID string) (string, error) {
 	metadata, err := db.queries.GetUserMetadata(ctx, queries.GetUserMetadataParams{
 		UserID: userID,
@@ -16,6 +16,14 @@ func toSqlInt64(input uint64) sql.NullInt64 {
 	return sql.NullInt64{Int64: int64(input), Valid: true}
 }
 
+func toInt64Array(input []uint64) []int64 {
+	ret := make([]int64, len(input))
+	for i, v := range input {
+		ret[i] = int64(v)
+	}
+	return ret
+}
+
 type executor struct {
 	err error
 }
@@ -65,3 +65,12 @@ func (b *Backend) GetMetadata(id string) (contentType, fileName string, size int
 	res, err := b.DB.GetFileMetadata(id)
 	return res.ContentType, res.Name, res.Size, err
 }
+
+// DeleteFile deletes a file along with its metadata
+func (b *Backend) DeleteFile(id string) error {
+	baseFileName := filepath.Base(id)
+	if err := os.Remove(path.Join(b.Config.Flatfile.MediaPath, baseFileName)); err != nil {
+		return err
+	}
+	return b.DB.DeleteFileMeta(id)
+}
@@ -102,3 +102,12 @@ func (b *Backend) GetMetadata(id string) (contentType, fileName string, size int
 
 	return fileData.ContentType, fileData.Filename, fileData.Size, err
 }
+
+// DeleteFile deletes a file along with its data
+func (b *Backend) DeleteFile(id string) error {
+	baseFileName := filepath.Base(id)
+	if err := os.Remove(filepath.Join(b.Config.Flatfile.MediaPath, baseFileName)); err != nil {
+		return err
+	}
+	return os.Remove(filepath.Join(b.Config.Flatfile.MediaPath, fmt.Sprintf("%s.data", baseFileName)))
+}
@@ -0,0 +1,249 @@
+package events
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/harmony-development/legato/server/config"
+	"github.com/harmony-development/legato/server/db"
+	"github.com/harmony-development/legato/server/http/hm"
+	"github.com/harmony-development/legato/server/http/responses"
+	"github.com/harmony-development/legato/server/http/routing"
+	"github.com/labstack/echo/v4"
+	"github.com/thanhpk/randstr"
+	"google.golang.org/grpc/metadata"
+)
+
+// Streamer is the handler behind the StreamEvents RPC
+type Streamer interface {
+	StreamEvents(chatv1.ChatService_StreamEventsServer) error
+}
+
+type Dependencies struct {
+	APIGroup *echo.Group
+	Router   routing.IRouter
+	DB       db.IHarmonyDB
+	Config   *config.Config
+	Streamer Streamer
+}
+
+// ticketDuration is how long a client has to open an event stream with a
+// ticket before it expires
+const ticketDuration = 30 * time.Second
+
+// API serves the event stream as protojson to clients that can't speak
+// grpc-web, over a WebSocket or Server-Sent Events
+type API struct {
+	*echo.Group
+	Dependencies
+	upgrader websocket.Upgrader
+	// sse are the Server-Sent Events streams open on this instance by ID
+	sse struct {
+		streams map[string]*sseStream
+		sync.Mutex
+	}
+}
+
+func New(deps Dependencies) *API {
+	api := &API{
+		Group:        deps.APIGroup,
+		Dependencies: deps,
+	}
+	if deps.Config.Server.UseCORS {
+		// browsers may call the rest of the API from other origins, so they
+		// may stream events from them too. Streams are authenticated with
+		// headers or tickets rather than cookies, so other pages can't open
+		// one on behalf of a user. Otherwise only the same origin is allowed.
+		api.upgrader.CheckOrigin = func(*http.Request) bool {
+			return true
+		}
+	}
+	api.sse.streams = make(map[string]*sseStream)
+
+	api.Use(api.sessionFromTicket)
+	api.Router.BindRoutes(api.Group, []routing.Route{
+		{
+			Path:    "",
+			Handler: api.EventsHandler,
+			Auth:    true,
+			RateLimit: &routing.RateLimit{
+				Duration: 5 * time.Second,
+				Burst:    5,
+			},
+			Method: routing.GET,
+		},
+		{
+			Path:    "/ticket",
+			Handler: api.TicketHandler,
+			Auth:    true,
+			RateLimit: &routing.RateLimit{
+				Duration: 5 * time.Second,
+				Burst:    5,
+			},
+			Method: routing.POST,
+		},
+		{
+			Path:    "/ack",
+			Handler: api.AckHandler,
+			Auth:    true,
+			RateLimit: &routing.RateLimit{
+				Duration: 5 * time.Second,
+				Burst:    5,
+			},
+			Method: routing.POST,
+		},
+	})
+	return api
+}
+
+// sessionFromTicket lets browsers, which can't set headers on WebSockets and
+// EventSources, open event streams with a ticket from TicketHandler passed
+// as ?ticket=. Only opening a stream takes tickets, so a ticket can't be
+// traded for another one.
+func (a *API) sessionFromTicket(handler echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ticket := c.QueryParam("ticket")
+		if ticket == "" || c.Request().Method != http.MethodGet || c.Request().Header.Get("Authorization") != "" {
+			return handler(c)
+		}
+		session, err := a.DB.RedeemStreamTicket(ticket)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, responses.InvalidSession)
+		}
+		c.Request().Header.Set("Authorization", session)
+		return handler(c)
+	}
+}
+
+// TicketResponse is a ticket an event stream can be opened with
+type TicketResponse struct {
+	Ticket string `json:"ticket"`
+}
+
+// TicketHandler exchanges the session of a client for a ticket to open an
+// event stream with. Tickets are put in URLs, which end up in the logs of
+// proxies and the like, so they only work once and expire quickly.
+func (a *API) TicketHandler(c echo.Context) error {
+	ctx := c.(hm.HarmonyContext)
+	ticket := randstr.Hex(16)
+	session := ctx.Request().Header.Get("Authorization")
+	if err := a.DB.AddStreamTicket(ticket, session, time.Now().UTC().Add(ticketDuration)); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, responses.InternalServerError)
+	}
+	return ctx.JSON(http.StatusOK, TicketResponse{
+		Ticket: ticket,
+	})
+}
+
+// EventsHandler streams events over a WebSocket if the client asks for an
+// upgrade, and as Server-Sent Events otherwise
+func (a *API) EventsHandler(c echo.Context) error {
+	ctx := c.(hm.HarmonyContext)
+	// StreamEvents authenticates from gRPC metadata
+	streamCtx := metadata.NewIncomingContext(
+		ctx.Request().Context(),
+		metadata.Pairs("auth", ctx.Request().Header.Get("Authorization")),
+	)
+
+	if websocket.IsWebSocketUpgrade(ctx.Request()) {
+		conn, err := a.upgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+		if err != nil {
+			return nil
+		}
+		defer conn.Close()
+		err = a.Streamer.StreamEvents(newWSStream(streamCtx, conn))
+		_ = conn.WriteControl(websocket.CloseMessage, closeMessage(err), time.Now().Add(time.Second))
+		return nil
+	}
+
+	requests, err := requestsFromQuery(ctx)
+	if err != nil {
+		return err
+	}
+	stream := newSSEStream(streamCtx, ctx.UserID, ctx.Response(), requests)
+	a.sse.Lock()
+	a.sse.streams[stream.id] = stream
+	a.sse.Unlock()
+
+	stream.end(a.Streamer.StreamEvents(stream))
+
+	a.sse.Lock()
+	delete(a.sse.streams, stream.id)
+	a.sse.Unlock()
+	return nil
+}
+
+// AckHandler acknowledges the heartbeats of a Server-Sent Events stream, whose
+// ID is given as ?stream=. Streams are kept by the instance serving them, so
+// acknowledgements have to reach the same instance.
+func (a *API) AckHandler(c echo.Context) error {
+	ctx := c.(hm.HarmonyContext)
+	a.sse.Lock()
+	stream, ok := a.sse.streams[ctx.QueryParam("stream")]
+	a.sse.Unlock()
+	if !ok || stream.userID != ctx.UserID {
+		return echo.NewHTTPError(http.StatusNotFound, responses.StreamNotFound)
+	}
+	stream.ack()
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// requestsFromQuery turns the query of a Server-Sent Events request into
+// the subscriptions a StreamEvents client would send, since the client
+// can't send anything once the stream is open
+func requestsFromQuery(c echo.Context) ([]*chatv1.StreamEventsRequest, error) {
+	var requests []*chatv1.StreamEventsRequest
+	for _, guild := range c.QueryParams()["guild"] {
+		guildID, err := strconv.ParseUint(guild, 10, 64)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid guild ID")
+		}
+		requests = append(requests, &chatv1.StreamEventsRequest{
+			Request: &chatv1.StreamEventsRequest_SubscribeToGuild_{
+				SubscribeToGuild: &chatv1.StreamEventsRequest_SubscribeToGuild{
+					GuildId: guildID,
+				},
+			},
+		})
+	}
+	if c.QueryParam("actions") == "true" {
+		requests = append(requests, &chatv1.StreamEventsRequest{
+			Request: &chatv1.StreamEventsRequest_SubscribeToActions_{
+				SubscribeToActions: &chatv1.StreamEventsRequest_SubscribeToActions{},
+			},
+		})
+	}
+	if c.QueryParam("homeserver") == "true" {
+		requests = append(requests, &chatv1.StreamEventsRequest{
+			Request: &chatv1.StreamEventsRequest_SubscribeToHomeserverEvents_{
+				SubscribeToHomeserverEvents: &chatv1.StreamEventsRequest_SubscribeToHomeserverEvents{},
+			},
+		})
+	}
+	return requests, nil
+}
+
+// streamBase implements the parts of grpc.ServerStream StreamEvents doesn't
+// use, there are no headers or trailers outside of gRPC
+type streamBase struct {
+	ctx context.Context
+}
+
+func (s *streamBase) Context() context.Context {
+	return s.ctx
+}
+
+func (s *streamBase) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *streamBase) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *streamBase) SetTrailer(metadata.MD) {}
@@ -0,0 +1,55 @@
+package events
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/harmony-development/legato/server/db"
+	"github.com/labstack/echo/v4"
+)
+
+// ticketDB redeems the tickets it holds once, every other method panics
+type ticketDB struct {
+	db.IHarmonyDB
+	tickets map[string]string
+}
+
+func (d *ticketDB) RedeemStreamTicket(ticket string) (string, error) {
+	session, ok := d.tickets[ticket]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+	delete(d.tickets, ticket)
+	return session, nil
+}
+
+func TestSessionFromTicket(t *testing.T) {
+	api := &API{Dependencies: Dependencies{
+		DB: &ticketDB{tickets: map[string]string{"ticket": "session"}},
+	}}
+	var session string
+	handler := api.sessionFromTicket(func(c echo.Context) error {
+		session = c.Request().Header.Get("Authorization")
+		return nil
+	})
+	open := func(method, target string) error {
+		session = ""
+		req := httptest.NewRequest(method, target, nil)
+		return handler(echo.New().NewContext(req, httptest.NewRecorder()))
+	}
+
+	if err := open(http.MethodPost, "/ticket?ticket=ticket"); err != nil || session != "" {
+		t.Fatalf("expected tickets to only open streams, got %q, %v", session, err)
+	}
+	if err := open(http.MethodGet, "/?ticket=ticket"); err != nil || session != "session" {
+		t.Fatalf("expected the ticket to stand in for the session, got %q, %v", session, err)
+	}
+	if err := open(http.MethodGet, "/?ticket=ticket"); err == nil {
+		t.Fatal("expected a ticket to only work once")
+	}
+	if err := open(http.MethodGet, "/?session=session"); err != nil || session != "" {
+		t.Fatalf("expected sessions not to be taken from the URL, got %q, %v", session, err)
+	}
+}
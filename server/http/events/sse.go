@@ -0,0 +1,116 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/google/uuid"
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// sseStream carries StreamEvents as Server-Sent Events. The client can't
+// send anything on the stream, so its requests come from the query string.
+// The stream starts with a stream event carrying its ID, which the client
+// acknowledges heartbeats with by posting it to the ack endpoint, so clients
+// that went away are closed by the missed heartbeat timeout like any other.
+type sseStream struct {
+	streamBase
+	id       string
+	userID   uint64
+	resp     *echo.Response
+	requests chan *chatv1.StreamEventsRequest
+	lock     sync.Mutex
+	ended    bool // guarded by lock
+}
+
+func newSSEStream(ctx context.Context, userID uint64, resp *echo.Response, requests []*chatv1.StreamEventsRequest) *sseStream {
+	s := &sseStream{
+		streamBase: streamBase{ctx: ctx},
+		id:         uuid.New().String(),
+		userID:     userID,
+		resp:       resp,
+		requests:   make(chan *chatv1.StreamEventsRequest, len(requests)+1),
+	}
+	for _, req := range requests {
+		s.requests <- req
+	}
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.WriteHeader(http.StatusOK)
+	fmt.Fprintf(resp, "event: stream\ndata: %s\n\n", s.id)
+	resp.Flush()
+	return s
+}
+
+func (s *sseStream) Send(e *chatv1.Event) error {
+	data, err := protojson.Marshal(e)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.ended {
+		return io.ErrClosedPipe
+	}
+	if _, err := fmt.Fprintf(s.resp, "data: %s\n\n", data); err != nil {
+		return err
+	}
+	s.resp.Flush()
+	return nil
+}
+
+// ack acknowledges the heartbeats sent so far on behalf of the client. An
+// acknowledgement still waiting to be received covers this one as well.
+func (s *sseStream) ack() {
+	select {
+	case s.requests <- &chatv1.StreamEventsRequest{
+		Request: &chatv1.StreamEventsRequest_AckHeartbeat_{
+			AckHeartbeat: &chatv1.StreamEventsRequest_AckHeartbeat{},
+		},
+	}:
+	default:
+	}
+}
+
+func (s *sseStream) Recv() (*chatv1.StreamEventsRequest, error) {
+	select {
+	case req := <-s.requests:
+		return req, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+func (s *sseStream) SendMsg(m interface{}) error {
+	return s.Send(m.(*chatv1.Event))
+}
+
+func (s *sseStream) RecvMsg(m interface{}) error {
+	req, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(proto.Message), req)
+	return nil
+}
+
+// end tells the client why the stream ended, if it ended with an error,
+// and stops any further writes before the handler returns
+func (s *sseStream) end(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.ended = true
+	if err == nil {
+		return
+	}
+	fmt.Fprintf(s.resp, "event: error\ndata: %s\n\n", status.Convert(err).Message())
+	s.resp.Flush()
+}
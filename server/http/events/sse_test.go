@@ -0,0 +1,42 @@
+package events
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"github.com/labstack/echo/v4"
+)
+
+func TestSSEHeartbeatsNeedAcknowledging(t *testing.T) {
+	rec := httptest.NewRecorder()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := newSSEStream(ctx, 1, echo.NewResponse(rec, echo.New()), nil)
+
+	if !strings.HasPrefix(rec.Body.String(), "event: stream\ndata: "+s.id+"\n\n") {
+		t.Fatalf("expected the stream to start with its ID, got %q", rec.Body.String())
+	}
+	if err := s.Send(&chatv1.Event{
+		Event: &chatv1.Event_Heartbeat_{Heartbeat: &chatv1.Event_Heartbeat{}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case req := <-s.requests:
+		t.Fatalf("expected the heartbeat to wait for the client, got %v", req)
+	default:
+	}
+
+	s.ack()
+	s.ack()
+	req, err := s.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.GetAckHeartbeat() == nil {
+		t.Errorf("expected a heartbeat acknowledgement, got %v", req)
+	}
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"context"
+	"io"
+
+	"github.com/gorilla/websocket"
+	chatv1 "github.com/harmony-development/legato/gen/chat/v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// wsStream carries StreamEvents over a WebSocket, each text message is a
+// protojson encoded request or event
+type wsStream struct {
+	streamBase
+	conn *websocket.Conn
+}
+
+func newWSStream(ctx context.Context, conn *websocket.Conn) *wsStream {
+	return &wsStream{
+		streamBase: streamBase{ctx: ctx},
+		conn:       conn,
+	}
+}
+
+func (s *wsStream) Send(e *chatv1.Event) error {
+	data, err := protojson.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (s *wsStream) Recv() (*chatv1.StreamEventsRequest, error) {
+	_, data, err := s.conn.ReadMessage()
+	if err != nil {
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	req := new(chatv1.StreamEventsRequest)
+	if err := protojson.Unmarshal(data, req); err != nil {
+		// an empty request is reported back as invalid rather than
+		// tearing the whole stream down
+		return new(chatv1.StreamEventsRequest), nil
+	}
+	return req, nil
+}
+
+func (s *wsStream) SendMsg(m interface{}) error {
+	return s.Send(m.(*chatv1.Event))
+}
+
+func (s *wsStream) RecvMsg(m interface{}) error {
+	req, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(proto.Message), req)
+	return nil
+}
+
+// closeMessage reports why a stream ended, errors use the 4000-4999
+// private range offset by their gRPC code
+func closeMessage(err error) []byte {
+	if err == nil {
+		return websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	}
+	st := status.Convert(err)
+	return websocket.FormatCloseMessage(4000+int(st.Code()), st.Message())
+}
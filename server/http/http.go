@@ -10,6 +10,7 @@ import (
 	"github.com/harmony-development/legato/server/db"
 	"github.com/harmony-development/legato/server/http/attachments"
 	"github.com/harmony-development/legato/server/http/attachments/backend"
+	"github.com/harmony-development/legato/server/http/events"
 	"github.com/harmony-development/legato/server/http/hm"
 	"github.com/harmony-development/legato/server/http/routing"
 	"github.com/harmony-development/legato/server/http/webrtc"
@@ -29,6 +30,7 @@ type Dependencies struct {
 	Logger         logger.ILogger
 	Config         *config.Config
 	StorageBackend backend.AttachmentBackend
+	Streamer       events.Streamer
 }
 
 // New creates a new HTTP server instance
@@ -71,5 +73,14 @@ func New(deps Dependencies) *Server {
 		FileBackend: s.StorageBackend,
 	})
 
+	eventsGrp := harmony.Group("/events")
+	events.New(events.Dependencies{
+		APIGroup: eventsGrp,
+		Router:   s.Router,
+		DB:       s.DB,
+		Config:   s.Config,
+		Streamer: s.Streamer,
+	})
+
 	return s
 }
@@ -26,6 +26,7 @@ const (
 	MissingFiles           = "missing-files"
 	MissingFilename        = "missing-filename"
 	InternalServerError    = "internal-server-error"
+	StreamNotFound         = "events.stream-not-found"
 	TeaPot                 = "i-am-a-teapot-and-will-not-serve-coffee"
 	UnknownError           = "unknown"
 )
@@ -24,6 +24,21 @@ const (
 	BadLocationChannel     = "invalid-location-channel"
 	BadLocationMessage     = "invalid-location-message"
 	InternalServerError    = "internal-server-error"
+	StreamTooSlow          = "stream.too-slow"
+	HeartbeatMissed        = "stream.heartbeat-missed"
+	NotSubscribed          = "stream.not-subscribed"
+	NotLocal               = "user.not-local"
+	InvalidReaction        = "messages.invalid-reaction"
+	ThreadNotFound         = "messages.thread-not-found"
+	ThreadExists           = "messages.thread-exists"
+	ScheduledNotFound      = "messages.scheduled-not-found"
+	TooManyScheduled       = "messages.too-many-scheduled"
+	SendAtInPast           = "messages.send-at-in-past"
+	SlowModeCooldown       = "messages.slow-mode-cooldown"
+	AutomodBlocked         = "messages.automod-blocked"
+	TimedOut               = "guild.timed-out"
+	AutomodRuleNotFound    = "automod.rule-not-found"
+	TooManyAutomodRules    = "automod.too-many-rules"
 	TeaPot                 = "i-am-a-teapot-and-will-not-serve-coffee"
 	UnknownError           = "unknown"
 )
@@ -15,7 +15,10 @@ import (
 	"github.com/sony/sonyflake"
 
 	"github.com/harmony-development/legato/server/api"
+	v1 "github.com/harmony-development/legato/server/api/chat/v1"
 	"github.com/harmony-development/legato/server/api/chat/v1/permissions"
+	"github.com/harmony-development/legato/server/api/chat/v1/pubsub_backends/integrated"
+	"github.com/harmony-development/legato/server/api/chat/v1/pubsub_backends/postgres"
 	"github.com/harmony-development/legato/server/auth"
 	"github.com/harmony-development/legato/server/config"
 	"github.com/harmony-development/legato/server/db"
@@ -87,14 +90,43 @@ func (inst Instance) Start() {
 	default:
 		inst.Logger.Fatal(errors.New("Config backend is not valid; must be 'PureFlatfile' or 'DatabaseFlatfile'."))
 	}
+	perms := permissions.NewManager(inst.DB)
+	var pubSub v1.SubscriptionManager
+
+	switch inst.Config.Server.PubSubBackend {
+	case "Integrated":
+		pubSub = v1.SubscriptionManager{
+			Actions:    (&integrated.ActionState{}).Initialize(),
+			Guild:      (&integrated.GuildState{Visibility: perms}).Initialize(),
+			Homeserver: (&integrated.HomeserverEventState{}).Initialize(),
+		}
+	case "Postgres":
+		listener, err := postgres.New(postgres.Dependencies{
+			DB:         inst.DB,
+			Logger:     inst.Logger,
+			Config:     inst.Config,
+			Visibility: perms,
+		})
+		if err != nil {
+			inst.Logger.Fatal(err)
+		}
+		pubSub = v1.SubscriptionManager{
+			Actions:    listener.Actions(),
+			Guild:      listener.Guild(),
+			Homeserver: listener.Homeserver(),
+		}
+	default:
+		inst.Logger.Fatal(errors.New("Config pubsub backend is not valid; must be 'Integrated' or 'Postgres'."))
+	}
 	inst.API = api.New(api.Dependencies{
 		Logger:         inst.Logger,
 		DB:             inst.DB,
 		AuthManager:    inst.AuthManager,
 		Sonyflake:      inst.Sonyflake,
 		Config:         inst.Config,
-		Permissions:    permissions.NewManager(inst.DB),
+		Permissions:    perms,
 		StorageBackend: storageBackend,
+		PubSub:         pubSub,
 	})
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", inst.Config.Server.Host, inst.Config.Server.Port))
@@ -113,6 +145,7 @@ func (inst Instance) Start() {
 			Logger:         inst.Logger,
 			Config:         inst.Config,
 			StorageBackend: storageBackend,
+			Streamer:       inst.API.ChatSvc.V1,
 		})
 		err := (&stdlibHTTP.Server{
 			Handler: stdlibHTTP.HandlerFunc(func(resp stdlibHTTP.ResponseWriter, req *stdlibHTTP.Request) {
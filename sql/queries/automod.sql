@@ -0,0 +1,39 @@
+-- name: AddAutomodRule :exec
+INSERT INTO Automod_Rules (Rule_ID, Guild_ID, Data)
+VALUES ($1, $2, $3);
+
+-- name: UpdateAutomodRule :execrows
+UPDATE Automod_Rules
+SET Data = $1
+WHERE Rule_ID = $2
+    AND Guild_ID = $3;
+
+-- name: DeleteAutomodRule :execrows
+DELETE FROM Automod_Rules
+WHERE Rule_ID = $1
+    AND Guild_ID = $2;
+
+-- name: GetAutomodRules :many
+SELECT *
+FROM Automod_Rules
+WHERE Guild_ID = $1
+ORDER BY Rule_ID;
+
+-- name: TimeoutMember :exec
+INSERT INTO Member_Timeouts (Guild_ID, User_ID, Timed_Out_Until)
+VALUES ($1, $2, $3) ON CONFLICT (Guild_ID, User_ID) DO
+UPDATE
+SET Timed_Out_Until = GREATEST(
+        Member_Timeouts.Timed_Out_Until,
+        EXCLUDED.Timed_Out_Until
+    );
+
+-- name: GetMemberTimeout :one
+SELECT Timed_Out_Until
+FROM Member_Timeouts
+WHERE Guild_ID = $1
+    AND User_ID = $2;
+
+-- name: ExpireMemberTimeouts :exec
+DELETE FROM Member_Timeouts
+WHERE Timed_Out_Until < $1;
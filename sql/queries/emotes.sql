@@ -42,4 +42,13 @@ WHERE Pack_ID = $1
 -- name: GetPackOwner :one
 SELECT Pack_ID
 FROM Emote_Packs
-WHERE Pack_ID = $1;
\ No newline at end of file
+WHERE Pack_ID = $1;
+
+-- name: HasEquippedEmote :one
+SELECT EXISTS (
+		SELECT 1
+		FROM Emote_Pack_Emotes
+			INNER JOIN Acquired_Emote_Packs ON Acquired_Emote_Packs.Pack_ID = Emote_Pack_Emotes.Pack_ID
+		WHERE Acquired_Emote_Packs.User_ID = $1
+			AND Emote_Pack_Emotes.Image_ID = $2
+	);
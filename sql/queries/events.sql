@@ -0,0 +1,15 @@
+-- name: Notify :exec
+SELECT pg_notify(@Channel::text, @Payload::text);
+
+-- name: AddQueuedEvent :one
+INSERT INTO Event_Queue (Data, Created_At)
+VALUES ($1, NOW()) RETURNING Event_ID;
+
+-- name: GetQueuedEvent :one
+SELECT Data
+FROM Event_Queue
+WHERE Event_ID = $1;
+
+-- name: ExpireQueuedEvents :exec
+DELETE FROM Event_Queue
+WHERE Created_At < NOW() - INTERVAL '5 minutes';
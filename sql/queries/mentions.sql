@@ -0,0 +1,52 @@
+-- name: ResolveMentions :many
+SELECT User_ID
+FROM Guild_Members
+WHERE Guild_ID = @GuildID
+  AND (
+    @Everyone::BOOLEAN
+    OR User_ID = ANY(@Users::BIGINT[])
+    OR User_ID IN (
+      SELECT Roles_Members.Member_ID
+      FROM Roles_Members
+        INNER JOIN Roles ON Roles_Members.Role_ID = Roles.Role_ID
+      WHERE Roles_Members.Guild_ID = @GuildID
+        AND Roles_Members.Role_ID = ANY(@Roles::BIGINT[])
+        AND (
+          Roles.Pingable
+          OR @AnyRole::BOOLEAN
+        )
+    )
+  );
+
+-- name: RemoveStaleMentions :exec
+DELETE FROM Mentions
+WHERE Message_ID = @MessageID
+  AND NOT (User_ID = ANY(@Users::BIGINT[]));
+
+-- name: AddMentions :many
+INSERT INTO Mentions (
+    Message_ID,
+    User_ID,
+    Guild_ID,
+    Channel_ID,
+    Mentioned_At
+  )
+SELECT @MessageID::BIGINT,
+  UNNEST(@Users::BIGINT[]),
+  @GuildID::BIGINT,
+  @ChannelID::BIGINT,
+  NOW() ON CONFLICT DO NOTHING
+RETURNING User_ID;
+
+-- name: GetMentions :many
+SELECT Messages.*
+FROM Mentions
+  INNER JOIN Messages ON Mentions.Message_ID = Messages.Message_ID
+WHERE Mentions.User_ID = @UserID
+  AND Messages.Deleted_At IS NULL
+  AND (
+    @BeforeMessage::BIGINT = 0
+    OR Mentions.Message_ID < @BeforeMessage
+  )
+ORDER BY Mentions.Message_ID DESC
+LIMIT @Max;
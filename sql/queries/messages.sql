@@ -10,15 +10,89 @@ INSERT INTO Messages (
     Created_At,
     Reply_to_ID,
     Overrides,
-    Attachments
+    Attachments,
+    Thread_ID
   )
-VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), $8, $9, $10) RETURNING *;
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), $8, $9, $10, $11) RETURNING *;
 
 -- name: DeleteMessage :execrows
-DELETE FROM Messages
+UPDATE Messages
+SET Deleted_At = NOW(),
+  Deleted_By = @DeletedBy::BIGINT
+WHERE Message_ID = @MessageID
+  AND Channel_ID = @ChannelID
+  AND Guild_ID = @GuildID
+  AND Deleted_At IS NULL;
+
+-- name: RestoreMessage :execrows
+UPDATE Messages
+SET Deleted_At = NULL,
+  Deleted_By = NULL
 WHERE Message_ID = $1
   AND Channel_ID = $2
-  AND Guild_ID = $3;
+  AND Guild_ID = $3
+  AND Deleted_At IS NOT NULL;
+
+-- name: GetDeletedMessages :many
+SELECT *
+FROM Messages
+WHERE Guild_ID = @GuildID
+  AND Channel_ID = @ChannelID
+  AND Deleted_At IS NOT NULL
+  AND (
+    @BeforeMessage::BIGINT = 0
+    OR Message_ID < @BeforeMessage
+  )
+ORDER BY Message_ID DESC
+LIMIT @Max;
+
+-- name: PurgeDeletedMessages :many
+DELETE FROM Messages
+WHERE Message_ID IN (
+    SELECT Messages.Message_ID
+    FROM Messages
+    WHERE Messages.Deleted_At < @DeletedBefore::TIMESTAMP
+      AND NOT EXISTS (
+        SELECT 1
+        FROM Messages AS Replies
+        WHERE Replies.Thread_ID = Messages.Message_ID
+      )
+    LIMIT @Max FOR UPDATE OF Messages SKIP LOCKED
+  )
+RETURNING Message_ID,
+  Attachments;
+
+-- name: BulkDeleteMessages :many
+UPDATE Messages
+SET Deleted_At = NOW(),
+  Deleted_By = @DeletedBy::BIGINT
+WHERE Guild_ID = @GuildID
+  AND Channel_ID = @ChannelID
+  AND Message_ID = ANY(@MessageIDs::BIGINT[])
+  AND Deleted_At IS NULL
+RETURNING Message_ID;
+
+-- name: BulkDeleteFilteredMessages :many
+UPDATE Messages
+SET Deleted_At = NOW(),
+  Deleted_By = @DeletedBy::BIGINT
+WHERE Message_ID IN (
+    SELECT Message_ID
+    FROM Messages
+    WHERE Guild_ID = @GuildID
+      AND Channel_ID = @ChannelID
+      AND Deleted_At IS NULL
+      AND (
+        @AuthorID::BIGINT = 0
+        OR User_ID = @AuthorID
+      )
+      AND Created_At >= @After::TIMESTAMP
+      AND Created_At < @Before::TIMESTAMP
+    ORDER BY Created_At DESC,
+      Message_ID DESC
+    LIMIT @Max
+  )
+RETURNING Message_ID;
 
 -- name: GetMessageAuthor :one
 SELECT User_ID
@@ -30,18 +104,49 @@ SELECT *
 FROM Messages
 WHERE Message_ID = $1;
 
--- name: GetMessageDate :one
-SELECT Created_At
+-- name: GetMessages :many
+SELECT *
 FROM Messages
-WHERE Message_ID = $1;
+WHERE Guild_ID = @GuildID
+  AND Channel_ID = @ChannelID
+  AND Thread_ID IS NULL
+  AND Deleted_At IS NULL
+ORDER BY Created_At DESC,
+  Message_ID DESC
+LIMIT @Max;
 
--- name: GetMessages :many
+-- name: GetMessagesBefore :many
+SELECT *
+FROM Messages
+WHERE Guild_ID = @GuildID
+  AND Channel_ID = @ChannelID
+  AND Thread_ID IS NULL
+  AND Deleted_At IS NULL
+  AND (Created_At, Message_ID) < (
+    SELECT Created_At,
+      Message_ID
+    FROM Messages
+    WHERE Message_ID = @Cursor
+  )
+ORDER BY Created_At DESC,
+  Message_ID DESC
+LIMIT @Max;
+
+-- name: GetMessagesAfter :many
 SELECT *
 FROM Messages
 WHERE Guild_ID = @GuildID
   AND Channel_ID = @ChannelID
-  AND Created_At < @Before
-ORDER BY Created_At DESC
+  AND Thread_ID IS NULL
+  AND Deleted_At IS NULL
+  AND (Created_At, Message_ID) > (
+    SELECT Created_At,
+      Message_ID
+    FROM Messages
+    WHERE Message_ID = @Cursor
+  )
+ORDER BY Created_At ASC,
+  Message_ID ASC
 LIMIT @Max;
 
 -- name: UpdateMessageContent :one
@@ -75,6 +180,13 @@ UPDATE Messages
 SET Attachments = $1
 WHERE Message_ID = $2;
 
+-- name: SetMessageEmbeds :execrows
+UPDATE Messages
+SET Embeds = $2
+WHERE Message_ID = $1
+  AND Content = $3
+  AND Deleted_At IS NULL;
+
 -- name: MessageWithIDExists :one
 SELECT EXISTS (
     SELECT 1
@@ -82,4 +194,36 @@ SELECT EXISTS (
     WHERE Guild_ID = $1
       AND Channel_ID = $2
       AND Message_ID = $3
-  );
\ No newline at end of file
+      AND Deleted_At IS NULL
+  );
+
+-- name: SearchMessages :many
+SELECT *
+FROM Messages
+WHERE Guild_ID = @GuildID
+  AND Channel_ID = ANY(@Channels::BIGINT[])
+  AND Deleted_At IS NULL
+  AND (
+    @Query::TEXT = ''
+    OR to_tsvector('english', Content) @@ plainto_tsquery('english', @Query)
+  )
+  AND (
+    @AuthorID::BIGINT = 0
+    OR User_ID = @AuthorID
+  )
+  AND Created_At >= @After::TIMESTAMP
+  AND Created_At < @Before::TIMESTAMP
+  AND (
+    NOT @HasAttachment::BOOLEAN
+    OR cardinality(Attachments) > 0
+  )
+  AND (
+    @ReplyTo::BIGINT = 0
+    OR Reply_To_ID = @ReplyTo
+  )
+  AND (
+    @BeforeMessage::BIGINT = 0
+    OR Message_ID < @BeforeMessage
+  )
+ORDER BY Message_ID DESC
+LIMIT @Max;
@@ -0,0 +1,21 @@
+-- name: PinMessage :execrows
+INSERT INTO Pinned_Messages (Channel_ID, Message_ID, Pinned_By, Pinned_At)
+VALUES ($1, $2, $3, NOW()) ON CONFLICT DO NOTHING;
+
+-- name: UnpinMessage :execrows
+DELETE FROM Pinned_Messages
+WHERE Channel_ID = $1
+  AND Message_ID = $2;
+
+-- name: UnpinMessages :exec
+DELETE FROM Pinned_Messages
+WHERE Channel_ID = @ChannelID
+  AND Message_ID = ANY(@MessageIDs::BIGINT[]);
+
+-- name: GetPinnedMessages :many
+SELECT Messages.*
+FROM Pinned_Messages
+  INNER JOIN Messages ON Pinned_Messages.Message_ID = Messages.Message_ID
+WHERE Pinned_Messages.Channel_ID = $1
+  AND Messages.Deleted_At IS NULL
+ORDER BY Pinned_Messages.Pinned_At DESC;
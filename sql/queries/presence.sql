@@ -0,0 +1,41 @@
+-- name: AddPresenceInstance :exec
+INSERT INTO Presence_Instances (Instance_ID, Seen_At)
+VALUES ($1, NOW()) ON CONFLICT (Instance_ID) DO
+UPDATE
+SET Seen_At = EXCLUDED.Seen_At;
+
+-- name: TouchPresenceInstance :execrows
+UPDATE Presence_Instances
+SET Seen_At = NOW()
+WHERE Instance_ID = $1;
+
+-- name: SetPresenceStreams :exec
+INSERT INTO Presence_Streams (Instance_ID, User_ID, Streams)
+VALUES ($1, $2, $3) ON CONFLICT (Instance_ID, User_ID) DO
+UPDATE
+SET Streams = EXCLUDED.Streams;
+
+-- name: RemovePresenceStreams :exec
+DELETE FROM Presence_Streams
+WHERE Instance_ID = $1
+  AND User_ID = $2;
+
+-- name: UserHasStreams :one
+SELECT EXISTS (
+    SELECT 1
+    FROM Presence_Streams
+    WHERE User_ID = $1
+  );
+
+-- name: ExpirePresenceStreams :many
+DELETE FROM Presence_Streams
+WHERE Instance_ID IN (
+    SELECT Instance_ID
+    FROM Presence_Instances
+    WHERE Seen_At < $1
+  )
+RETURNING User_ID;
+
+-- name: ExpirePresenceInstances :exec
+DELETE FROM Presence_Instances
+WHERE Seen_At < $1;
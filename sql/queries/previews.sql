@@ -0,0 +1,32 @@
+-- name: UsePreviewImage :one
+UPDATE Preview_Images
+SET Used_At = @Now::TIMESTAMP
+WHERE URL = @URL::TEXT
+RETURNING File_ID;
+
+-- name: AddPreviewImage :one
+INSERT INTO Preview_Images (URL, File_ID, Used_At)
+VALUES (@URL::TEXT, @FileID::TEXT, @Now::TIMESTAMP) ON CONFLICT (URL) DO
+UPDATE
+SET Used_At = EXCLUDED.Used_At
+RETURNING File_ID;
+
+-- name: AddMessagePreviewImages :exec
+INSERT INTO Message_Preview_Images (Message_ID, File_ID)
+SELECT @MessageID::BIGINT,
+  UNNEST(@FileIDs::TEXT[]) ON CONFLICT DO NOTHING;
+
+-- name: ExpirePreviewImages :many
+DELETE FROM Preview_Images
+WHERE File_ID IN (
+    SELECT Preview_Images.File_ID
+    FROM Preview_Images
+    WHERE Preview_Images.Used_At < @UsedBefore::TIMESTAMP
+      AND NOT EXISTS (
+        SELECT 1
+        FROM Message_Preview_Images
+        WHERE Message_Preview_Images.File_ID = Preview_Images.File_ID
+      )
+    LIMIT @Max FOR UPDATE SKIP LOCKED
+  )
+RETURNING File_ID;
@@ -0,0 +1,29 @@
+-- name: AddReaction :execrows
+INSERT INTO Reactions (Message_ID, User_ID, Emoji, Emote, Reacted_At)
+VALUES ($1, $2, $3, $4, NOW()) ON CONFLICT DO NOTHING;
+
+-- name: RemoveReaction :execrows
+DELETE FROM Reactions
+WHERE Message_ID = $1
+  AND User_ID = $2
+  AND Emoji = $3
+  AND Emote = $4;
+
+-- name: CountReaction :one
+SELECT COUNT(*)
+FROM Reactions
+WHERE Message_ID = $1
+  AND Emoji = $2
+  AND Emote = $3;
+
+-- name: GetReactions :many
+SELECT Message_ID,
+  Emoji,
+  Emote,
+  COUNT(*) AS Count
+FROM Reactions
+WHERE Message_ID = ANY(@MessageIDs::BIGINT[])
+GROUP BY Message_ID,
+  Emoji,
+  Emote
+ORDER BY MIN(Reacted_At);
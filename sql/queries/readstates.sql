@@ -0,0 +1,39 @@
+-- name: AckChannel :exec
+INSERT INTO Read_States (
+    User_ID,
+    Guild_ID,
+    Channel_ID,
+    Last_Read_ID,
+    Read_At
+  )
+VALUES ($1, $2, $3, $4, NOW()) ON CONFLICT (User_ID, Channel_ID) DO
+UPDATE
+SET Last_Read_ID = EXCLUDED.Last_Read_ID,
+  Read_At = EXCLUDED.Read_At;
+
+-- name: GetReadStates :many
+SELECT Channels.Channel_ID,
+  COALESCE(Read_States.Last_Read_ID, 0)::BIGINT AS Last_Read_ID,
+  (
+    SELECT COUNT(*)
+    FROM Messages
+    WHERE Messages.Channel_ID = Channels.Channel_ID
+      AND Messages.Thread_ID IS NULL
+      AND Messages.Deleted_At IS NULL
+      AND Messages.User_ID <> @UserID
+      AND Messages.Message_ID > COALESCE(Read_States.Last_Read_ID, 0)
+  ) AS Unread_Count,
+  (
+    SELECT COUNT(*)
+    FROM Mentions
+      INNER JOIN Messages ON Mentions.Message_ID = Messages.Message_ID
+    WHERE Mentions.User_ID = @UserID
+      AND Mentions.Channel_ID = Channels.Channel_ID
+      AND Mentions.Message_ID > COALESCE(Read_States.Last_Read_ID, 0)
+      AND Messages.Deleted_At IS NULL
+  ) AS Mention_Count
+FROM Channels
+  LEFT JOIN Read_States ON Read_States.Channel_ID = Channels.Channel_ID
+  AND Read_States.User_ID = @UserID
+WHERE Channels.Guild_ID = @GuildID
+  AND NOT Channels.Category;
@@ -0,0 +1,47 @@
+-- name: SetGuildRetention :exec
+INSERT INTO Guild_Retention (Guild_ID, Max_Age)
+VALUES ($1, $2) ON CONFLICT (Guild_ID) DO
+UPDATE
+SET Max_Age = EXCLUDED.Max_Age;
+
+-- name: GetGuildRetention :one
+SELECT Max_Age
+FROM Guild_Retention
+WHERE Guild_ID = $1;
+
+-- name: SetChannelRetention :exec
+INSERT INTO Channel_Retention (Channel_ID, Guild_ID, Max_Age)
+VALUES ($1, $2, $3) ON CONFLICT (Channel_ID) DO
+UPDATE
+SET Max_Age = EXCLUDED.Max_Age;
+
+-- name: ClearChannelRetention :exec
+DELETE FROM Channel_Retention
+WHERE Channel_ID = $1;
+
+-- name: GetChannelRetentions :many
+SELECT *
+FROM Channel_Retention
+WHERE Guild_ID = $1;
+
+-- name: PruneExpiredMessages :many
+DELETE FROM Messages
+WHERE Message_ID IN (
+    SELECT Messages.Message_ID
+    FROM Messages
+      LEFT JOIN Channel_Retention ON Messages.Channel_ID = Channel_Retention.Channel_ID
+      LEFT JOIN Guild_Retention ON Messages.Guild_ID = Guild_Retention.Guild_ID
+    WHERE COALESCE(Channel_Retention.Max_Age, Guild_Retention.Max_Age, 0) > 0
+      AND Messages.Created_At < @Now::TIMESTAMP - COALESCE(Channel_Retention.Max_Age, Guild_Retention.Max_Age) * INTERVAL '1 second'
+      AND NOT EXISTS (
+        SELECT 1
+        FROM Messages AS Replies
+        WHERE Replies.Thread_ID = Messages.Message_ID
+      )
+    LIMIT @Max FOR UPDATE OF Messages SKIP LOCKED
+  )
+RETURNING Message_ID,
+  Guild_ID,
+  Channel_ID,
+  Thread_ID,
+  Attachments;
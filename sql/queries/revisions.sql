@@ -0,0 +1,42 @@
+-- name: AddMessageRevision :exec
+INSERT INTO Message_Revisions (
+    Message_ID,
+    Content,
+    Embeds,
+    Actions,
+    Overrides,
+    Attachments,
+    Written_At,
+    Replaced_At
+  )
+SELECT Message_ID,
+  Content,
+  Embeds,
+  Actions,
+  Overrides,
+  Attachments,
+  COALESCE(Edited_At, Created_At),
+  NOW()
+FROM Messages
+WHERE Message_ID = $1;
+
+-- name: TrimMessageRevisions :exec
+DELETE FROM Message_Revisions
+WHERE Message_ID = $1
+  AND Revision_ID NOT IN (
+    SELECT Revision_ID
+    FROM Message_Revisions
+    WHERE Message_ID = $1
+    ORDER BY Revision_ID DESC
+    LIMIT $2
+  );
+
+-- name: GetMessageRevisions :many
+SELECT *
+FROM Message_Revisions
+WHERE Message_ID = $1
+ORDER BY Revision_ID DESC;
+
+-- name: ExpireMessageRevisions :exec
+DELETE FROM Message_Revisions
+WHERE Replaced_At < $1;
@@ -0,0 +1,79 @@
+-- name: AddScheduledMessage :exec
+INSERT INTO Scheduled_Messages (
+    Scheduled_ID,
+    Guild_ID,
+    Channel_ID,
+    User_ID,
+    Send_At,
+    Request
+  )
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: CountScheduledMessages :one
+SELECT COUNT(*)
+FROM Scheduled_Messages
+WHERE Guild_ID = $1
+  AND User_ID = $2;
+
+-- name: GetScheduledMessage :one
+SELECT *
+FROM Scheduled_Messages
+WHERE Scheduled_ID = $1;
+
+-- name: GetScheduledMessages :many
+SELECT *
+FROM Scheduled_Messages
+WHERE Guild_ID = $1
+  AND User_ID = $2
+ORDER BY Send_At;
+
+-- name: UpdateScheduledMessage :execrows
+UPDATE Scheduled_Messages
+SET Send_At = $3,
+  Request = $4
+WHERE Scheduled_ID = $1
+  AND User_ID = $2;
+
+-- name: CancelScheduledMessage :execrows
+DELETE FROM Scheduled_Messages
+WHERE Scheduled_ID = $1
+  AND User_ID = $2;
+
+-- name: ClaimDueScheduledMessages :many
+UPDATE Scheduled_Messages
+SET Claimed_Until = @LeaseUntil::TIMESTAMP,
+  Attempts = Attempts + 1
+WHERE Scheduled_ID IN (
+    SELECT Scheduled_ID
+    FROM Scheduled_Messages
+    WHERE Send_At <= @Now::TIMESTAMP
+      AND (
+        Claimed_Until IS NULL
+        OR Claimed_Until <= @Now::TIMESTAMP
+      )
+    ORDER BY Send_At
+    LIMIT @Max FOR UPDATE SKIP LOCKED
+  )
+RETURNING *;
+
+-- name: RetryScheduledMessage :exec
+UPDATE Scheduled_Messages
+SET Send_At = $2,
+  Claimed_Until = NULL
+WHERE Scheduled_ID = $1;
+
+-- name: SetScheduledMessageID :exec
+UPDATE Scheduled_Messages
+SET Message_ID = $2
+WHERE Scheduled_ID = $1;
+
+-- name: ScheduledMessageSent :one
+SELECT EXISTS (
+    SELECT 1
+    FROM Messages
+    WHERE Message_ID = $1
+  );
+
+-- name: FinishScheduledMessage :exec
+DELETE FROM Scheduled_Messages
+WHERE Scheduled_ID = $1;
@@ -13,4 +13,23 @@ VALUES ($1, $2, $3);
 -- name: ExpireSessions :exec
 DELETE
 FROM Sessions
-WHERE Expiration <= $1;
\ No newline at end of file
+WHERE Expiration <= $1;
+
+-- name: AddStreamTicket :exec
+INSERT INTO Stream_Tickets
+(Ticket,
+ Session,
+ Expiration)
+VALUES ($1, $2, $3);
+
+-- name: RedeemStreamTicket :one
+DELETE
+FROM Stream_Tickets
+WHERE Ticket = @Ticket
+  AND Expiration > @Now::BIGINT
+RETURNING Session;
+
+-- name: ExpireStreamTickets :exec
+DELETE
+FROM Stream_Tickets
+WHERE Expiration <= $1;
@@ -0,0 +1,33 @@
+-- name: SetChannelSlowMode :execrows
+UPDATE Channels
+SET Slow_Mode = $1
+WHERE Channel_ID = $2
+    AND Guild_ID = $3;
+
+-- name: GetChannelSlowMode :one
+SELECT Slow_Mode
+FROM Channels
+WHERE Channel_ID = $1;
+
+-- name: ClaimSlowModeCooldown :execrows
+INSERT INTO Slow_Mode_Cooldowns (Channel_ID, User_ID, Last_Sent)
+VALUES (@ChannelID, @UserID, @Now::TIMESTAMP) ON CONFLICT (Channel_ID, User_ID) DO
+UPDATE
+SET Last_Sent = EXCLUDED.Last_Sent
+WHERE Slow_Mode_Cooldowns.Last_Sent <= @Now::TIMESTAMP - @Interval::BIGINT * INTERVAL '1 second';
+
+-- name: ReleaseSlowModeCooldown :exec
+DELETE FROM Slow_Mode_Cooldowns
+WHERE Channel_ID = @ChannelID
+    AND User_ID = @UserID
+    AND Last_Sent = @ClaimedAt::TIMESTAMP;
+
+-- name: GetSlowModeCooldown :one
+SELECT Last_Sent
+FROM Slow_Mode_Cooldowns
+WHERE Channel_ID = $1
+    AND User_ID = $2;
+
+-- name: ClearSlowModeCooldowns :exec
+DELETE FROM Slow_Mode_Cooldowns
+WHERE Channel_ID = $1;
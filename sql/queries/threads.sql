@@ -0,0 +1,49 @@
+-- name: CreateThread :one
+INSERT INTO Threads (
+    Thread_ID,
+    Guild_ID,
+    Channel_ID,
+    Name,
+    Created_By,
+    Created_At,
+    Last_Message_At
+  )
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW()) RETURNING *;
+
+-- name: GetThread :one
+SELECT *
+FROM Threads
+WHERE Thread_ID = $1;
+
+-- name: GetActiveThreads :many
+SELECT *
+FROM Threads
+WHERE Channel_ID = $1
+  AND NOT Archived
+ORDER BY Last_Message_At DESC;
+
+-- name: TouchThread :exec
+UPDATE Threads
+SET Last_Message_At = NOW(),
+  Archived = false
+WHERE Thread_ID = $1;
+
+-- name: ArchiveInactiveThreads :many
+UPDATE Threads
+SET Archived = true
+WHERE NOT Archived
+  AND Last_Message_At < $1 RETURNING Thread_ID,
+  Guild_ID,
+  Channel_ID;
+
+-- name: GetThreadMessages :many
+SELECT *
+FROM Messages
+WHERE Thread_ID = @ThreadID::BIGINT
+  AND Deleted_At IS NULL
+  AND (
+    @BeforeMessage::BIGINT = 0
+    OR Message_ID < @BeforeMessage
+  )
+ORDER BY Message_ID DESC
+LIMIT @Max;
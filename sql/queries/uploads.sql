@@ -20,4 +20,42 @@ VALUES ($1, $2);
 
 -- name: DeleteFileMetadata :exec
 DELETE FROM Files
-WHERE File_ID = $1;
\ No newline at end of file
+WHERE File_ID = $1;
+
+-- name: DeleteFileHashes :exec
+DELETE FROM Hashes
+WHERE File_ID = $1;
+
+-- name: FileInUse :one
+SELECT (
+    EXISTS (
+      SELECT 1
+      FROM Messages
+      WHERE @FileID::TEXT = ANY(Attachments)
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Message_Revisions
+      WHERE @FileID::TEXT = ANY(Attachments)
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Profiles
+      WHERE Avatar LIKE '%' || @FileID::TEXT
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Guilds
+      WHERE Picture_URL LIKE '%' || @FileID::TEXT
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Emote_Pack_Emotes
+      WHERE Image_ID = @FileID::TEXT
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM Preview_Images
+      WHERE File_ID = @FileID::TEXT
+    )
+  ) AS In_Use;
\ No newline at end of file
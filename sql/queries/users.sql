@@ -68,6 +68,21 @@ UPDATE Profiles
 SET Status = $1
 WHERE User_ID = $2;
 
+-- name: GetChosenStatus :one
+SELECT Chosen_Status
+FROM Profiles
+WHERE User_ID = $1;
+
+-- name: SetChosenStatus :exec
+UPDATE Profiles
+SET Chosen_Status = $1
+WHERE User_ID = $2;
+
+-- name: ResetStatuses :exec
+UPDATE Profiles
+SET Status = $1
+WHERE Status <> $1;
+
 -- name: GetUserMetadata :one
 SELECT Metadata
 FROM User_Metadata
@@ -33,6 +33,28 @@ CREATE TABLE IF NOT EXISTS Profiles (
     FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE
 );
 
+-- the status the user picked, Status is derived from it and their connections
+ALTER TABLE Profiles ADD COLUMN IF NOT EXISTS Chosen_Status SMALLINT NOT NULL DEFAULT 0;
+
+-- instances serving event streams, they're forgotten along with their
+-- streams once they stop checking in
+CREATE TABLE IF NOT EXISTS Presence_Instances (
+    Instance_ID TEXT NOT NULL,
+    Seen_At TIMESTAMP NOT NULL,
+    PRIMARY KEY (Instance_ID)
+);
+
+-- how many event streams of a user each instance has open, a user is online
+-- while any instance has a row for them
+CREATE TABLE IF NOT EXISTS Presence_Streams (
+    Instance_ID TEXT NOT NULL,
+    User_ID BIGSERIAL NOT NULL,
+    Streams INTEGER NOT NULL,
+    FOREIGN KEY (Instance_ID) REFERENCES Presence_Instances (Instance_ID) ON DELETE CASCADE,
+    FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Instance_ID, User_ID)
+);
+
 CREATE TABLE IF NOT EXISTS Guild_List (
     User_ID BIGSERIAL NOT NULL,
     Guild_ID BIGSERIAL NOT NULL,
@@ -56,6 +78,13 @@ CREATE TABLE IF NOT EXISTS Sessions (
     FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE
 );
 
+CREATE TABLE IF NOT EXISTS Stream_Tickets (
+    Ticket TEXT PRIMARY KEY NOT NULL,
+    Session TEXT NOT NULL,
+    Expiration BIGINT NOT NULL,
+    FOREIGN KEY (Session) REFERENCES Sessions (Session) ON DELETE CASCADE
+);
+
 CREATE TABLE IF NOT EXISTS Guilds (
     Guild_ID BIGSERIAL PRIMARY KEY NOT NULL,
     Owner_ID BIGSERIAL NOT NULL,
@@ -95,6 +124,9 @@ CREATE TABLE IF NOT EXISTS Channels (
     FOREIGN KEY (Guild_ID) REFERENCES Guilds (Guild_ID) ON DELETE CASCADE
 );
 
+-- seconds users have to wait between messages, 0 disables slow mode
+ALTER TABLE Channels ADD COLUMN IF NOT EXISTS Slow_Mode INTEGER NOT NULL DEFAULT 0;
+
 CREATE TABLE IF NOT EXISTS Permissions (
     Guild_ID BIGSERIAL NOT NULL,
     Channel_ID BIGINT,
@@ -138,6 +170,171 @@ CREATE TABLE IF NOT EXISTS Messages (
     FOREIGN KEY (Channel_ID) REFERENCES Channels (Channel_ID) ON DELETE CASCADE
 );
 
+CREATE INDEX IF NOT EXISTS Messages_Content_Search ON Messages USING GIN (to_tsvector('english', Content));
+
+-- the previous versions of edited messages
+CREATE TABLE IF NOT EXISTS Message_Revisions (
+    Revision_ID BIGSERIAL NOT NULL,
+    Message_ID BIGSERIAL NOT NULL,
+    Content TEXT NOT NULL,
+    Embeds jsonb,
+    Actions jsonb,
+    Overrides bytea,
+    Attachments text [],
+    Written_At TIMESTAMP NOT NULL,
+    Replaced_At TIMESTAMP NOT NULL,
+    FOREIGN KEY (Message_ID) REFERENCES Messages (Message_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Revision_ID)
+);
+
+CREATE INDEX IF NOT EXISTS Message_Revisions_Message ON Message_Revisions (Message_ID, Revision_ID);
+
+CREATE TABLE IF NOT EXISTS Pinned_Messages (
+    Channel_ID BIGSERIAL NOT NULL,
+    Message_ID BIGSERIAL NOT NULL,
+    Pinned_By BIGSERIAL NOT NULL,
+    Pinned_At TIMESTAMP NOT NULL,
+    FOREIGN KEY (Channel_ID) REFERENCES Channels (Channel_ID) ON DELETE CASCADE,
+    FOREIGN KEY (Message_ID) REFERENCES Messages (Message_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Channel_ID, Message_ID)
+);
+
+CREATE TABLE IF NOT EXISTS Reactions (
+    Message_ID BIGSERIAL NOT NULL,
+    User_ID BIGSERIAL NOT NULL,
+    Emoji TEXT NOT NULL,
+    Emote TEXT NOT NULL,
+    Reacted_At TIMESTAMP NOT NULL,
+    FOREIGN KEY (Message_ID) REFERENCES Messages (Message_ID) ON DELETE CASCADE,
+    FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Message_ID, Emoji, Emote, User_ID)
+);
+
+-- a thread has the ID of the message it branches off
+CREATE TABLE IF NOT EXISTS Threads (
+    Thread_ID BIGSERIAL NOT NULL,
+    Guild_ID BIGSERIAL NOT NULL,
+    Channel_ID BIGSERIAL NOT NULL,
+    Name TEXT NOT NULL,
+    Created_By BIGSERIAL NOT NULL,
+    Created_At TIMESTAMP NOT NULL,
+    Last_Message_At TIMESTAMP NOT NULL,
+    Archived BOOLEAN NOT NULL DEFAULT false,
+    FOREIGN KEY (Thread_ID) REFERENCES Messages (Message_ID) ON DELETE CASCADE,
+    FOREIGN KEY (Channel_ID) REFERENCES Channels (Channel_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Thread_ID)
+);
+
+CREATE INDEX IF NOT EXISTS Threads_Active ON Threads (Channel_ID, Last_Message_At) WHERE NOT Archived;
+
+-- NULL for messages posted directly in the channel
+ALTER TABLE Messages ADD COLUMN IF NOT EXISTS Thread_ID BIGINT REFERENCES Threads (Thread_ID) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS Messages_Thread ON Messages (Thread_ID, Message_ID) WHERE Thread_ID IS NOT NULL;
+
+-- deleted messages are kept as tombstones until they are purged
+ALTER TABLE Messages ADD COLUMN IF NOT EXISTS Deleted_At TIMESTAMP;
+
+ALTER TABLE Messages ADD COLUMN IF NOT EXISTS Deleted_By BIGINT;
+
+CREATE INDEX IF NOT EXISTS Messages_Deleted ON Messages (Deleted_At) WHERE Deleted_At IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS Mentions (
+    Message_ID BIGSERIAL NOT NULL,
+    User_ID BIGSERIAL NOT NULL,
+    Guild_ID BIGSERIAL NOT NULL,
+    Channel_ID BIGSERIAL NOT NULL,
+    Mentioned_At TIMESTAMP NOT NULL,
+    FOREIGN KEY (Message_ID) REFERENCES Messages (Message_ID) ON DELETE CASCADE,
+    FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE,
+    PRIMARY KEY (User_ID, Message_ID)
+);
+
+CREATE INDEX IF NOT EXISTS Mentions_Message ON Mentions (Message_ID);
+
+-- Last_Read_ID is the last message of the channel the user has read
+CREATE TABLE IF NOT EXISTS Read_States (
+    User_ID BIGSERIAL NOT NULL,
+    Guild_ID BIGSERIAL NOT NULL,
+    Channel_ID BIGSERIAL NOT NULL,
+    Last_Read_ID BIGINT NOT NULL,
+    Read_At TIMESTAMP NOT NULL,
+    FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE,
+    FOREIGN KEY (Guild_ID) REFERENCES Guilds (Guild_ID) ON DELETE CASCADE,
+    FOREIGN KEY (Channel_ID) REFERENCES Channels (Channel_ID) ON DELETE CASCADE,
+    PRIMARY KEY (User_ID, Channel_ID)
+);
+
+-- Data is the serialized AutomodRule
+CREATE TABLE IF NOT EXISTS Automod_Rules (
+    Rule_ID BIGINT NOT NULL,
+    Guild_ID BIGSERIAL NOT NULL,
+    Data BYTEA NOT NULL,
+    FOREIGN KEY (Guild_ID) REFERENCES Guilds (Guild_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Rule_ID)
+);
+
+CREATE INDEX IF NOT EXISTS Automod_Rules_Guild ON Automod_Rules (Guild_ID);
+
+-- members can't send messages in the guild until Timed_Out_Until
+CREATE TABLE IF NOT EXISTS Member_Timeouts (
+    Guild_ID BIGSERIAL NOT NULL,
+    User_ID BIGSERIAL NOT NULL,
+    Timed_Out_Until TIMESTAMP NOT NULL,
+    FOREIGN KEY (Guild_ID) REFERENCES Guilds (Guild_ID) ON DELETE CASCADE,
+    FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Guild_ID, User_ID)
+);
+
+-- Last_Sent is when the user last sent a message in a slow mode channel
+CREATE TABLE IF NOT EXISTS Slow_Mode_Cooldowns (
+    Channel_ID BIGSERIAL NOT NULL,
+    User_ID BIGSERIAL NOT NULL,
+    Last_Sent TIMESTAMP NOT NULL,
+    FOREIGN KEY (Channel_ID) REFERENCES Channels (Channel_ID) ON DELETE CASCADE,
+    FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Channel_ID, User_ID)
+);
+
+CREATE INDEX IF NOT EXISTS Mentions_Channel ON Mentions (User_ID, Channel_ID, Message_ID);
+
+-- Max_Age is in seconds, 0 keeps messages forever. Channels without a row
+-- use the retention of their guild.
+CREATE TABLE IF NOT EXISTS Guild_Retention (
+    Guild_ID BIGSERIAL NOT NULL,
+    Max_Age BIGINT NOT NULL,
+    FOREIGN KEY (Guild_ID) REFERENCES Guilds (Guild_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Guild_ID)
+);
+
+CREATE TABLE IF NOT EXISTS Channel_Retention (
+    Channel_ID BIGSERIAL NOT NULL,
+    Guild_ID BIGSERIAL NOT NULL,
+    Max_Age BIGINT NOT NULL,
+    FOREIGN KEY (Channel_ID) REFERENCES Channels (Channel_ID) ON DELETE CASCADE,
+    FOREIGN KEY (Guild_ID) REFERENCES Guilds (Guild_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Channel_ID)
+);
+
+-- Request is the serialized SendMessageRequest sent once Send_At passes
+CREATE TABLE IF NOT EXISTS Scheduled_Messages (
+    Scheduled_ID BIGSERIAL NOT NULL,
+    Guild_ID BIGSERIAL NOT NULL,
+    Channel_ID BIGSERIAL NOT NULL,
+    User_ID BIGSERIAL NOT NULL,
+    Send_At TIMESTAMP NOT NULL,
+    Request BYTEA NOT NULL,
+    Claimed_Until TIMESTAMP,
+    Attempts INTEGER NOT NULL DEFAULT 0,
+    Message_ID BIGINT,
+    FOREIGN KEY (Guild_ID) REFERENCES Guilds (Guild_ID) ON DELETE CASCADE,
+    FOREIGN KEY (Channel_ID) REFERENCES Channels (Channel_ID) ON DELETE CASCADE,
+    FOREIGN KEY (User_ID) REFERENCES Users (User_ID) ON DELETE CASCADE,
+    PRIMARY KEY (Scheduled_ID)
+);
+
+CREATE INDEX IF NOT EXISTS Scheduled_Messages_Send_At ON Scheduled_Messages (Send_At);
+
 CREATE TABLE IF NOT EXISTS Rate_Limit_Whitelist_IP (IP TEXT NOT NULL PRIMARY KEY);
 
 CREATE TABLE IF NOT EXISTS Rate_Limit_Whitelist_User (
@@ -181,4 +378,34 @@ CREATE TABLE IF NOT EXISTS Hashes (
     File_ID TEXT NOT NULL,
     FOREIGN KEY (File_ID) REFERENCES Files (File_ID),
     PRIMARY KEY (Hash)
-);
\ No newline at end of file
+);
+
+-- Events too large for a NOTIFY payload, relayed between instances by the
+-- Postgres pubsub backend
+CREATE TABLE IF NOT EXISTS Event_Queue (
+    Event_ID BIGSERIAL NOT NULL,
+    Data BYTEA NOT NULL,
+    Created_At TIMESTAMP NOT NULL,
+    PRIMARY KEY (Event_ID)
+);
+
+-- Link preview images stored on the server, by the link they were fetched
+-- from so every preview of a link shares one copy
+CREATE TABLE IF NOT EXISTS Preview_Images (
+    URL TEXT NOT NULL,
+    File_ID TEXT NOT NULL UNIQUE,
+    Used_At TIMESTAMP NOT NULL,
+    PRIMARY KEY (URL)
+);
+
+-- The preview images shown by a message; images no message shows are
+-- deleted once the previews using them have left the cache
+CREATE TABLE IF NOT EXISTS Message_Preview_Images (
+    Message_ID BIGINT NOT NULL,
+    File_ID TEXT NOT NULL,
+    FOREIGN KEY (Message_ID) REFERENCES Messages (Message_ID) ON DELETE CASCADE,
+    FOREIGN KEY (File_ID) REFERENCES Preview_Images (File_ID),
+    PRIMARY KEY (Message_ID, File_ID)
+);
+
+CREATE INDEX IF NOT EXISTS Message_Preview_Images_File ON Message_Preview_Images (File_ID);